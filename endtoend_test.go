@@ -1,3 +1,4 @@
+//go:build !android
 // +build !android
 
 package main
@@ -17,7 +18,8 @@ import (
 // after generating the mocks for its interface. The rule is that for
 // testdata/x.go we run `charlatan -dir=testdata X` and then compile
 // and run the testdata/x.go program. The resulting binary panics if the mock
-// structs are broken, including for error cases.
+// structs are broken, including for error cases. Each program is compiled
+// along with testdata/ete/recorder.go, which holds the helpers they share.
 
 type endToEndTest struct {
 	exe  string
@@ -35,6 +37,22 @@ func (e *endToEndTest) compileAndRun(t *testing.T) {
 	base := strings.TrimSuffix(path.Base(e.file), "_ete.go")
 	interfaceName := strings.Title(base)
 
+	// N.B. - charlatan loads packages in module mode, and the fakes import
+	// this module's fake package
+	root, err := filepath.Abs(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod := "module ete\n\nrequire github.com/percolate/charlatan v0.0.0\n\nreplace github.com/percolate/charlatan => " + root + "\n"
+	err = ioutil.WriteFile(filepath.Join(tempdir, "go.mod"), []byte(goMod), 0644)
+	if err != nil {
+		t.Fatalf("writing go.mod to temporary directory: %s", err)
+	}
+	err = copy(filepath.Join(tempdir, "go.sum"), "go.sum")
+	if err != nil {
+		t.Fatalf("copying go.sum to temporary directory: %s", err)
+	}
+
 	sourceDef := filepath.Join(tempdir, base+"_def.go")
 	err = copy(sourceDef, filepath.Join("testdata/"+base, base+"_def.go"))
 	if err != nil {
@@ -42,8 +60,12 @@ func (e *endToEndTest) compileAndRun(t *testing.T) {
 	}
 
 	charlatanSource := filepath.Join(tempdir, interfaceName+"_charlatan.go")
+	// N.B. - the temporary module needs the checksums of the go.mod files
+	// of charlatan's dependencies, which its tidy go.sum leaves out
+	env := append(os.Environ(), "GOFLAGS=-mod=mod")
+
 	// Run charlatan in temporary directory.
-	err = run(e.exe, "-dir", tempdir, "-output", charlatanSource, "-package", "main", interfaceName)
+	err = runEnv(env, e.exe, "-dir", tempdir, "-output", charlatanSource, "-package", "main", interfaceName)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -54,8 +76,15 @@ func (e *endToEndTest) compileAndRun(t *testing.T) {
 		t.Fatalf("copying end-to-end test file to temporary directory: %s", err)
 	}
 
-	// Run the binary in the temporary directory.
-	err = run("go", "run", charlatanSource, sourceDef, source)
+	helper := filepath.Join(tempdir, "recorder.go")
+	err = copy(helper, "testdata/ete/recorder.go")
+	if err != nil {
+		t.Fatalf("copying end-to-end test helper to temporary directory: %s", err)
+	}
+
+	// Run the binary in the temporary directory, under the race detector
+	// since fakes may be called concurrently.
+	err = runEnv(env, "go", "run", "-race", charlatanSource, sourceDef, source, helper)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -104,7 +133,15 @@ func copy(to, from string) error {
 // run runs a single command and returns an error if it does not succeed.
 // os/exec should have this function, to be honest.
 func run(name string, arg ...string) error {
+	return runEnv(nil, name, arg...)
+}
+
+// runEnv runs a single command with the given environment, or the
+// environment of the test if nil, and returns an error if it does not
+// succeed.
+func runEnv(env []string, name string, arg ...string) error {
 	cmd := exec.Command(name, arg...)
+	cmd.Env = env
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
@@ -0,0 +1,67 @@
+package fake
+
+import "reflect"
+
+// CopyParameter returns a copy of a parameter of a call to a fake that
+// shares no slices, maps or pointed-to values with it.  The copies of
+// pointers already seen, in this or another parameter of the call, are
+// reused.
+func CopyParameter[T any](v T, seen map[uintptr]reflect.Value) T {
+	var c T
+	reflect.ValueOf(&c).Elem().Set(copyValue(reflect.ValueOf(&v).Elem(), seen))
+	return c
+}
+
+// copyValue deeply copies slices, maps, arrays, pointers and the exported
+// fields of structs.  Interface values, channels, functions and unexported
+// struct fields are shared with the original.
+func copyValue(v reflect.Value, seen map[uintptr]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := seen[v.Pointer()]; ok && c.Type() == v.Type() {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[v.Pointer()] = c
+		c.Elem().Set(copyValue(v.Elem(), seen))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i), seen))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value(), seen))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i), seen))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(copyValue(v.Field(i), seen))
+			}
+		}
+		return c
+	}
+
+	return v
+}
@@ -0,0 +1,66 @@
+package fake
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type node struct {
+	Value  int
+	Next   *node
+	Tags   []string
+	hidden []string
+}
+
+func TestCopyParameter(t *testing.T) {
+	tags := []string{"a"}
+	m := map[string][]int{"k": {1}}
+	arr := [1][]int{{1}}
+	seen := make(map[uintptr]reflect.Value)
+
+	tagsCopy := CopyParameter(tags, seen)
+	mCopy := CopyParameter(m, seen)
+	arrCopy := CopyParameter(arr, seen)
+	tags[0], m["k"][0], arr[0][0] = "b", 2, 2
+
+	assert.Equal(t, []string{"a"}, tagsCopy)
+	assert.Equal(t, map[string][]int{"k": {1}}, mCopy)
+	assert.Equal(t, [1][]int{{1}}, arrCopy)
+	assert.Nil(t, CopyParameter([]int(nil), seen))
+}
+
+func TestCopyParameterCycle(t *testing.T) {
+	n := &node{Value: 1, Tags: []string{"a"}, hidden: []string{"h"}}
+	n.Next = &node{Value: 2, Next: n}
+
+	c := CopyParameter(n, make(map[uintptr]reflect.Value))
+	n.Value, n.Tags[0] = 3, "b"
+
+	assert.NotSame(t, n, c)
+	assert.Same(t, c, c.Next.Next)
+	assert.Equal(t, 1, c.Value)
+	assert.Equal(t, []string{"a"}, c.Tags)
+	// N.B. - unexported fields are shared
+	assert.Equal(t, n.hidden, c.hidden)
+}
+
+func TestCopyParameterAliases(t *testing.T) {
+	shared := &node{Value: 1}
+	pair := [2]*node{shared, shared}
+	seen := make(map[uintptr]reflect.Value)
+
+	c := CopyParameter(pair, seen)
+	other := CopyParameter(shared, seen)
+
+	assert.NotSame(t, shared, c[0])
+	assert.Same(t, c[0], c[1])
+	// N.B. - pointers are aliased across the parameters of a call too
+	assert.Same(t, c[0], other)
+
+	// a pointer to the first field of a struct has the same address as the
+	// struct, but not the same type
+	value := CopyParameter(&shared.Value, seen)
+	assert.Equal(t, 1, *value)
+}
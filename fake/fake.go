@@ -0,0 +1,15 @@
+// Package fake holds the parts of the fakes generated by charlatan that do
+// not depend on the interface being faked.  Generated code imports it; tests
+// normally use it through the names generated alongside each fake.
+package fake
+
+import "sync/atomic"
+
+var sequence atomic.Int64
+
+// NextSequence returns the next number in the sequence stamped on the calls
+// of all charlatan fakes in the program, including fakes generated
+// separately, so that their calls can be ordered against each other
+func NextSequence() int64 {
+	return sequence.Add(1)
+}
@@ -0,0 +1,102 @@
+package fake
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Matcher matches a parameter of a call to a fake
+type Matcher[T any] interface {
+	Match(T) bool
+}
+
+// MatcherFunc is a Matcher implemented by a predicate function
+type MatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m MatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// matcher is a Matcher that describes the values it matches in failure messages
+type matcher[T any] struct {
+	match       func(T) bool
+	description string
+}
+
+func (m *matcher[T]) Match(v T) bool {
+	return m.match(v)
+}
+
+func (m *matcher[T]) String() string {
+	return m.description
+}
+
+// Matches returns true if the given matcher is nil, which matches any
+// value, or matches v
+func Matches[T any](m interface{ Match(T) bool }, v T) bool {
+	return m == nil || m.Match(v)
+}
+
+// Describe describes the values matched by a matcher for failure messages.
+// Matchers may implement fmt.Stringer to describe themselves, and nil
+// matchers match any value.
+func Describe(m any) string {
+	if m == nil {
+		return "any value"
+	}
+	if s, ok := m.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("a value matched by %T", m)
+}
+
+// Format formats a parameter of a call for failure messages.  Taking the
+// value as an interface lets parameters of any type, including functions,
+// be formatted.
+func Format(v any) string {
+	return fmt.Sprintf("%#v", v)
+}
+
+// Any returns a Matcher that matches any value
+func Any[T any]() Matcher[T] {
+	return &matcher[T]{func(T) bool { return true }, "any value"}
+}
+
+// Eq returns a Matcher that matches values deeply equal to want
+func Eq[T any](want T) Matcher[T] {
+	return &matcher[T]{func(v T) bool { return reflect.DeepEqual(want, v) }, fmt.Sprintf("%#v", want)}
+}
+
+// Not returns a Matcher that matches the values the given matcher does not.
+// A nil matcher matches any value, so Not(nil) matches none.
+//
+// N.B. - matchers are accepted by their method, so that those of the
+// generated Matcher types can be passed without converting them
+func Not[T any](m interface{ Match(T) bool }) Matcher[T] {
+	return &matcher[T]{func(v T) bool { return !Matches(m, v) }, "not " + Describe(m)}
+}
+
+// Pred returns a Matcher that matches values for which the given predicate
+// returns true
+func Pred[T any](pred func(T) bool) Matcher[T] {
+	return &matcher[T]{pred, "a value accepted by the predicate"}
+}
+
+// AnyOfType returns a Matcher that matches values whose dynamic type is, or
+// implements, U
+func AnyOfType[T, U any]() Matcher[T] {
+	return &matcher[T]{func(v T) bool {
+		_, ok := any(v).(U)
+		return ok
+	}, "any " + reflect.TypeOf((*U)(nil)).Elem().String()}
+}
+
+// MatchParameter matches a parameter with the given matcher, or compares it
+// to want if the matcher is nil
+func MatchParameter[T any](m interface{ Match(T) bool }, want, v T) bool {
+	if m == nil {
+		return reflect.DeepEqual(want, v)
+	}
+	return m.Match(v)
+}
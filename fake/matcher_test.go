@@ -0,0 +1,39 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNilMatchers(t *testing.T) {
+	var m Matcher[string]
+
+	assert.True(t, Matches(m, "a"))
+	assert.True(t, MatchParameter(m, "a", "a"))
+	assert.False(t, MatchParameter(m, "a", "b"))
+	assert.Equal(t, "any value", Describe(m))
+
+	not := Not(m)
+	assert.False(t, not.Match("a"))
+	assert.Equal(t, "not any value", Describe(not))
+}
+
+func TestMatchers(t *testing.T) {
+	assert.True(t, Any[int]().Match(1))
+	assert.True(t, Eq([]int{1}).Match([]int{1}))
+	assert.False(t, Eq([]int{1}).Match([]int{2}))
+	assert.Equal(t, "[]int{1}", Describe(Eq([]int{1})))
+	assert.True(t, Not(Eq(1)).Match(2))
+	assert.Equal(t, "not 1", Describe(Not(Eq(1))))
+	assert.True(t, Pred(func(v int) bool { return v > 0 }).Match(1))
+	assert.True(t, AnyOfType[any, error]().Match(assert.AnError))
+	assert.False(t, AnyOfType[any, error]().Match("a"))
+	assert.Equal(t, "any error", Describe(AnyOfType[any, error]()))
+	assert.Equal(t, "a value matched by fake.MatcherFunc[int]", Describe(MatcherFunc[int](func(int) bool { return true })))
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, `"a"`, Format("a"))
+	assert.Contains(t, Format(func() {}), "(func())")
+}
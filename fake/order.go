@@ -0,0 +1,141 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TestingT represents the methods of "testing".T used by charlatan Fakes.
+// It avoids importing the testing package.
+type TestingT interface {
+	Error(...interface{})
+	Errorf(string, ...interface{})
+	Fatal(...interface{})
+	Helper()
+	Cleanup(func())
+}
+
+// CallMatcher selects calls of a method of a fake for InOrder and Unordered.
+// It only refers to predeclared types, so the matchers of fakes for
+// different interfaces can be used together.
+type CallMatcher interface {
+	// RecordedCalls returns descriptions of all recorded calls of the
+	// method by sequence number, and the ordered sequence numbers of those
+	// selected
+	RecordedCalls() (calls map[int64]string, matching []int64)
+	String() string
+}
+
+// callMatcher implements CallMatcher with a function
+type callMatcher struct {
+	description string
+	recorded    func() (map[int64]string, []int64)
+}
+
+func (m *callMatcher) RecordedCalls() (map[int64]string, []int64) {
+	return m.recorded()
+}
+
+func (m *callMatcher) String() string {
+	return m.description
+}
+
+// NewCallMatcher returns a CallMatcher with the given description, whose
+// RecordedCalls method calls recorded
+func NewCallMatcher(description string, recorded func() (map[int64]string, []int64)) CallMatcher {
+	return &callMatcher{description, recorded}
+}
+
+// InOrder calls t.Error unless calls were made matching each of the given
+// matchers in the order given.  Other calls may have been made before,
+// between and after them.
+func InOrder(t TestingT, calls ...CallMatcher) {
+	t.Helper()
+	var last int64
+	for i, c := range calls {
+		_, matching := c.RecordedCalls()
+		found := false
+		for _, sequence := range matching {
+			if sequence > last {
+				last, found = sequence, true
+				break
+			}
+		}
+
+		if !found {
+			if i == 0 {
+				t.Errorf("calls not made in order: no call of %s\n%s", c, describeCalls(calls))
+			} else {
+				t.Errorf("calls not made in order: no call of %s after %s\n%s", c, calls[i-1], describeCalls(calls))
+			}
+			return
+		}
+	}
+}
+
+// Unordered calls t.Error unless a distinct call was made matching each of
+// the given matchers, in any order.  Other calls may also have been made.
+func Unordered(t TestingT, calls ...CallMatcher) {
+	t.Helper()
+	candidates := make([][]int64, len(calls))
+	for i, c := range calls {
+		_, candidates[i] = c.RecordedCalls()
+	}
+
+	// find a call for each matcher in turn, moving the calls found for
+	// earlier matchers to others they match when needed
+	owner := make(map[int64]int)
+	var assign func(i int, seen map[int64]bool) bool
+	assign = func(i int, seen map[int64]bool) bool {
+		for _, sequence := range candidates[i] {
+			if seen[sequence] {
+				continue
+			}
+			seen[sequence] = true
+			if o, taken := owner[sequence]; !taken || assign(o, seen) {
+				owner[sequence] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	for i, c := range calls {
+		if !assign(i, make(map[int64]bool)) {
+			t.Errorf("calls not made: no distinct call of %s\n%s", c, describeCalls(calls))
+			return
+		}
+	}
+}
+
+// describeCalls lists the given matchers and the recorded calls of the
+// methods they select, in sequence
+func describeCalls(calls []CallMatcher) string {
+	var b strings.Builder
+	b.WriteString("expected:\n")
+	recorded := make(map[int64]string)
+	for _, c := range calls {
+		fmt.Fprintf(&b, "\t%s\n", c)
+		all, _ := c.RecordedCalls()
+		for sequence, description := range all {
+			recorded[sequence] = description
+		}
+	}
+
+	sequences := make([]int64, 0, len(recorded))
+	for sequence := range recorded {
+		sequences = append(sequences, sequence)
+	}
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	b.WriteString("actual:")
+	if len(sequences) == 0 {
+		b.WriteString(" no calls")
+	}
+	for _, sequence := range sequences {
+		fmt.Fprintf(&b, "\n\t%d: %s", sequence, recorded[sequence])
+	}
+
+	return b.String()
+}
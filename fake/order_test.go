@@ -0,0 +1,74 @@
+package fake
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder is a TestingT that records the errors reported to it
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Error(args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatal(args ...interface{}) {
+	r.Error(args...)
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Cleanup(func()) {}
+
+// calls returns a CallMatcher selecting the given calls among the recorded ones
+func calls(description string, recorded map[int64]string, matching ...int64) CallMatcher {
+	return NewCallMatcher(description, func() (map[int64]string, []int64) {
+		return recorded, matching
+	})
+}
+
+func TestInOrder(t *testing.T) {
+	recorded := map[int64]string{1: "Open()", 2: "Read()", 3: "Close()"}
+	open := calls("Open()", recorded, 1)
+	read := calls("Read()", recorded, 2)
+	close := calls("Close()", recorded, 3)
+
+	r := new(recorder)
+	InOrder(r, open, read, close)
+	InOrder(r, open, close)
+	assert.Empty(t, r.errors)
+
+	InOrder(r, close, open)
+	if assert.Len(t, r.errors, 1) {
+		assert.Equal(t, "calls not made in order: no call of Open() after Close()\nexpected:\n\tClose()\n\tOpen()\nactual:\n\t1: Open()\n\t2: Read()\n\t3: Close()", r.errors[0])
+	}
+}
+
+func TestUnordered(t *testing.T) {
+	recorded := map[int64]string{1: "Get(a)", 2: "Get(b)"}
+
+	// N.B. - the first call matches both matchers, and must be left to the
+	// one it alone matches
+	r := new(recorder)
+	Unordered(r, calls("Get(...)", recorded, 1, 2), calls("Get(a)", recorded, 1))
+	assert.Empty(t, r.errors)
+
+	Unordered(r, calls("Get(a)", recorded, 1), calls("Get(...)", recorded, 1, 2), calls("Get(b)", recorded, 2))
+	if assert.Len(t, r.errors, 1) {
+		assert.Contains(t, r.errors[0], "calls not made: no distinct call of Get(b)\n")
+	}
+
+	r = new(recorder)
+	Unordered(r, calls("Get(a)", map[int64]string{}))
+	if assert.Len(t, r.errors, 1) {
+		assert.Equal(t, "calls not made: no distinct call of Get(a)\nexpected:\n\tGet(a)\nactual: no calls", r.errors[0])
+	}
+}
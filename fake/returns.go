@@ -0,0 +1,85 @@
+package fake
+
+// Exhausted selects how a method of a fake behaves once the results given to
+// its SetXReturnsSequence method are used up
+type Exhausted int
+
+const (
+	// RepeatLast returns the last results in the sequence from all later calls
+	RepeatLast Exhausted = iota
+	// PanicAfterSequence panics on all later calls
+	PanicAfterSequence
+	// HookAfterSequence calls the method's hook on all later calls
+	HookAfterSequence
+)
+
+// Returns holds the results configured for particular calls of a method of a
+// fake, by index into its calls, and the sequence of results configured for
+// the calls from a given one on.  The zero value has no results configured.
+type Returns[R any] struct {
+	onCall    map[int]R
+	sequenced bool
+	first     int // the call given the first results of the sequence
+	sequence  []R
+	exhausted Exhausted
+}
+
+// Set configures the results of the given call, which take precedence over
+// those given to Sequence before
+func (r *Returns[R]) Set(call int, results R) {
+	if r.onCall == nil {
+		r.onCall = make(map[int]R)
+	}
+	r.onCall[call] = results
+}
+
+// Sequence configures the results of the calls from next on, in order, and
+// how the calls after them behave.  It replaces any previous sequence, and
+// the results given to Set for the calls it covers.
+func (r *Returns[R]) Sequence(next int, exhausted Exhausted, results []R) {
+	for i := range results {
+		delete(r.onCall, next+i)
+	}
+	r.sequenced = true
+	r.first = next
+	r.sequence = append([]R(nil), results...)
+	r.exhausted = exhausted
+}
+
+// Rebase renumbers the configured results when the first calls of the
+// method are forgotten, so that the given call becomes call 0.  Results
+// configured for calls not yet made stay with those calls.
+func (r *Returns[R]) Rebase(call int) {
+	onCall := make(map[int]R, len(r.onCall))
+	for i, results := range r.onCall {
+		if i >= call {
+			onCall[i-call] = results
+		}
+	}
+	r.onCall = onCall
+	// N.B. - the sequence may have started before the given call, and its
+	// last results may still be repeated
+	r.first -= call
+}
+
+// Lookup returns the results configured for the given call, if any, and
+// whether the call should panic
+func (r *Returns[R]) Lookup(call int) (results R, found bool, panics bool) {
+	if results, found = r.onCall[call]; found || !r.sequenced || call < r.first {
+		return results, found, false
+	}
+	if i := call - r.first; i < len(r.sequence) {
+		return r.sequence[i], true, false
+	}
+
+	switch r.exhausted {
+	case RepeatLast:
+		if len(r.sequence) > 0 {
+			results, found = r.sequence[len(r.sequence)-1], true
+		}
+	case PanicAfterSequence:
+		panics = true
+	}
+
+	return results, found, panics
+}
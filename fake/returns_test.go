@@ -0,0 +1,80 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnsSequence(t *testing.T) {
+	for _, test := range []struct {
+		exhausted Exhausted
+		results   string
+		found     bool
+		panics    bool
+	}{
+		{RepeatLast, "b", true, false},
+		{PanicAfterSequence, "", false, true},
+		{HookAfterSequence, "", false, false},
+	} {
+		var r Returns[string]
+		r.Sequence(1, test.exhausted, []string{"a", "b"})
+
+		results, found, panics := r.Lookup(0)
+		assert.Equal(t, []interface{}{"", false, false}, []interface{}{results, found, panics}, "before the sequence")
+		results, found, panics = r.Lookup(2)
+		assert.Equal(t, []interface{}{"b", true, false}, []interface{}{results, found, panics}, "at the end of the sequence")
+		for _, call := range []int{3, 10} {
+			results, found, panics = r.Lookup(call)
+			assert.Equal(t, []interface{}{test.results, test.found, test.panics}, []interface{}{results, found, panics}, "past the end of the sequence")
+		}
+	}
+}
+
+func TestReturnsSet(t *testing.T) {
+	var r Returns[string]
+	r.Sequence(0, PanicAfterSequence, []string{"a"})
+	r.Set(3, "c")
+
+	results, found, panics := r.Lookup(3)
+	assert.Equal(t, []interface{}{"c", true, false}, []interface{}{results, found, panics})
+	results, found, panics = r.Lookup(2)
+	assert.Equal(t, []interface{}{"", false, true}, []interface{}{results, found, panics})
+}
+
+func TestReturnsSequenceTwice(t *testing.T) {
+	var r Returns[string]
+	r.Sequence(0, PanicAfterSequence, []string{"a", "b", "c", "d"})
+	r.Set(5, "e")
+	r.Sequence(1, PanicAfterSequence, []string{"x"})
+
+	results, found, panics := r.Lookup(1)
+	assert.Equal(t, []interface{}{"x", true, false}, []interface{}{results, found, panics})
+	for _, call := range []int{2, 3} {
+		results, found, panics = r.Lookup(call)
+		assert.Equal(t, []interface{}{"", false, true}, []interface{}{results, found, panics}, "call %d", call)
+	}
+	// N.B. - results set for particular calls outside the sequence are kept
+	results, found, panics = r.Lookup(5)
+	assert.Equal(t, []interface{}{"e", true, false}, []interface{}{results, found, panics})
+
+	r.Sequence(2, RepeatLast, []string{"y"})
+	r.Set(2, "z")
+	results, _, _ = r.Lookup(2)
+	assert.Equal(t, "z", results)
+	results, _, _ = r.Lookup(3)
+	assert.Equal(t, "y", results)
+}
+
+func TestReturnsRebase(t *testing.T) {
+	var r Returns[string]
+	r.Sequence(0, RepeatLast, []string{"a", "b"})
+	r.Set(5, "c")
+	r.Rebase(3)
+
+	for call, expected := range []string{"b", "b", "c", "b"} {
+		results, found, _ := r.Lookup(call)
+		assert.True(t, found)
+		assert.Equal(t, expected, results, "call %d", call)
+	}
+}
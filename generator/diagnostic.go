@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Diagnostic is a problem found in the package declaring the interfaces,
+// positioned in its source when possible
+type Diagnostic struct {
+	File      string `json:"file,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Column    int    `json:"column,omitempty"`
+	Interface string `json:"interface,omitempty"` // the interface concerned, if any
+	Method    string `json:"method,omitempty"`    // the method concerned, if any
+	Message   string `json:"message"`
+	// Blocking is set for problems that prevent generation.  Errors in
+	// parts of the package the fakes don't depend on are not blocking.
+	Blocking bool `json:"blocking"`
+}
+
+// Error formats the diagnostic as "file:line:column: Interface.Method: message"
+func (d *Diagnostic) Error() string {
+	var b strings.Builder
+	if d.File != "" {
+		b.WriteString(d.File)
+		if d.Line > 0 {
+			fmt.Fprintf(&b, ":%d", d.Line)
+		}
+		if d.Column > 0 {
+			fmt.Fprintf(&b, ":%d", d.Column)
+		}
+		b.WriteString(": ")
+	}
+	if d.Interface != "" {
+		b.WriteString(d.Interface)
+		if d.Method != "" {
+			b.WriteString("." + d.Method)
+		}
+		b.WriteString(": ")
+	}
+	b.WriteString(d.Message)
+
+	return b.String()
+}
+
+// setPosition sets the file, line and column of the diagnostic
+func (d *Diagnostic) setPosition(position token.Position) {
+	d.File, d.Line, d.Column = position.Filename, position.Line, position.Column
+}
+
+// contains returns true if the diagnostic is positioned between start and end
+func (d *Diagnostic) contains(start, end token.Position) bool {
+	if d.File == "" || d.File != start.Filename {
+		return false
+	}
+	if d.Line < start.Line || d.Line == start.Line && d.Column < start.Column {
+		return false
+	}
+
+	return d.Line < end.Line || d.Line == end.Line && d.Column <= end.Column
+}
+
+// Diagnostics are the problems that prevented a generation, which are
+// returned together as its error
+type Diagnostics []*Diagnostic
+
+// Error lists the diagnostics, one per line
+func (d Diagnostics) Error() string {
+	lines := make([]string, len(d))
+	for i, diag := range d {
+		lines[i] = diag.Error()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// blocking returns the given diagnostics as the error of a failed
+// generation, which they all block
+func blocking(diags []*Diagnostic) Diagnostics {
+	blocked := make(Diagnostics, len(diags))
+	for i, d := range diags {
+		copied := *d
+		copied.Blocking = true
+		blocked[i] = &copied
+	}
+
+	return blocked
+}
+
+// packageDiagnostics returns the errors found loading the given packages
+// and their dependencies, which are not blocking until they are found to
+// concern the interfaces being faked
+func packageDiagnostics(pkgs []*packages.Package) []*Diagnostic {
+	var diags []*Diagnostic
+	seen := make(map[string]bool)
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		typeErrors := false
+		for _, err := range pkg.Errors {
+			typeErrors = typeErrors || err.Kind == packages.TypeError
+		}
+		for _, err := range pkg.Errors {
+			// N.B. - the go command's summary of the compiler's errors
+			// repeats the type errors without positions
+			if typeErrors && err.Kind == packages.ListError && strings.HasPrefix(err.Msg, "# ") {
+				continue
+			}
+			d := &Diagnostic{Message: err.Msg}
+			d.File, d.Line, d.Column = splitPosition(err.Pos)
+			// N.B. - test variants of a package report the same errors
+			if !seen[d.Error()] {
+				seen[d.Error()] = true
+				diags = append(diags, d)
+			}
+		}
+	})
+
+	return diags
+}
+
+// splitPosition splits a position formatted as "file:line:column",
+// "file:line" or "file"
+func splitPosition(pos string) (string, int, int) {
+	if pos == "" || pos == "-" {
+		return "", 0, 0
+	}
+
+	var numbers []int
+	for len(numbers) < 2 {
+		i := strings.LastIndexByte(pos, ':')
+		if i < 0 {
+			break
+		}
+		n, err := strconv.Atoi(pos[i+1:])
+		if err != nil {
+			break
+		}
+		numbers = append([]int{n}, numbers...)
+		pos = pos[:i]
+	}
+
+	switch len(numbers) {
+	case 2:
+		return pos, numbers[0], numbers[1]
+	case 1:
+		return pos, numbers[0], 0
+	}
+	return pos, 0, 0
+}
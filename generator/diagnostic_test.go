@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnosticError(t *testing.T) {
+	d := &Diagnostic{File: "store.go", Line: 12, Column: 2, Interface: "Store", Method: "Get", Message: "undefined: Key"}
+	assert.Equal(t, "store.go:12:2: Store.Get: undefined: Key", d.Error())
+
+	d = &Diagnostic{File: "store.go", Line: 3, Message: "expected declaration"}
+	assert.Equal(t, "store.go:3: expected declaration", d.Error())
+
+	d = &Diagnostic{Interface: "Store", Message: "interface not found"}
+	assert.Equal(t, "Store: interface not found", d.Error())
+
+	diags := Diagnostics{{Message: "first"}, {Message: "second"}}
+	assert.Equal(t, "first\nsecond", diags.Error())
+}
+
+func TestSplitPosition(t *testing.T) {
+	cases := []struct {
+		pos          string
+		file         string
+		line, column int
+	}{
+		{"/src/store.go:12:2", "/src/store.go", 12, 2},
+		{"/src/store.go:12", "/src/store.go", 12, 0},
+		{"/src/store.go", "/src/store.go", 0, 0},
+		{`C:\src\store.go:12:2`, `C:\src\store.go`, 12, 2},
+		{"-", "", 0, 0},
+		{"", "", 0, 0},
+	}
+
+	for _, c := range cases {
+		file, line, column := splitPosition(c.pos)
+		assert.Equal(t, c.file, file, c.pos)
+		assert.Equal(t, c.line, line, c.pos)
+		assert.Equal(t, c.column, column, c.pos)
+	}
+}
@@ -0,0 +1,852 @@
+// Package generator writes fake implementations of Go interfaces for
+// testing.  It is the library behind the charlatan command.
+package generator // import "github.com/percolate/charlatan/generator"
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Generator holds the state of the analysis
+type Generator struct {
+	// PackageOverride can be set to control the package for the output file.  The default is the same package as the input interface(s).
+	PackageOverride string
+	// OutputDir is the directory the output file is written to.  Types declared in the input package are qualified unless the output is written to the input package, in its directory.  The default is the directory of the input package.
+	OutputDir string
+	// CommandLine is recorded in the header of the output file, so that it can be regenerated.  The default is the charlatan command with the interface names.
+	CommandLine string
+	// CommandDir is recorded in the header of the output file after CommandLine, when its relative paths cannot be resolved from the -output path alone.  It is the directory the command was run in, relative to the directory of the output file, quoted if needed.
+	CommandDir string
+	// Warn is called with the problems that don't prevent generation, such as errors in files of the package unrelated to the interfaces.  The default logs them.
+	Warn       func(*Diagnostic)
+	external   bool   // the output is never written to the input package
+	dir        string // the directory of the input package
+	imports    *ImportSet
+	interfaces map[string]*declaration
+	declared   []string
+	docs       map[*types.Func]string // doc comments of the methods of the interfaces declared in the package
+	fset       *token.FileSet
+	problems   []*Diagnostic          // errors found loading the package
+	ambiguous  map[string]*Diagnostic // names declared in both the package and its external test package
+}
+
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedTypes | packages.NeedSyntax | packages.NeedForTest
+
+// LoadPackageDir parses a package in the given directory.
+//
+// The package is loaded with go/packages, so the build configuration
+// of the enclosing module or workspace is honored: go.mod and its
+// replace directives, vendor directories, go.work and GOFLAGS.
+func LoadPackageDir(directory string) (*Generator, error) {
+	return loadPackageDir(context.Background(), directory, false)
+}
+
+// LoadPackageDirWithTests parses a package in the given directory
+// including its _test.go files, so interfaces declared only for tests,
+// in the package itself or in its external test package, are found.
+func LoadPackageDirWithTests(directory string) (*Generator, error) {
+	return loadPackageDir(context.Background(), directory, true)
+}
+
+func loadPackageDir(ctx context.Context, directory string, tests bool) (*Generator, error) {
+	pkgs, err := loadPackages(ctx, directory, ".", tests)
+	if err != nil {
+		return nil, fmt.Errorf("cannot process directory %s: %s", directory, err)
+	}
+
+	// N.B. - when tests are loaded the package also appears as a test
+	// variant that includes its _test.go files, which supersedes it
+	var pkg, xtest *packages.Package
+	for _, p := range pkgs {
+		switch {
+		case p.ForTest == "" && !strings.HasSuffix(p.PkgPath, ".test"):
+			if pkg == nil {
+				pkg = p
+			}
+		case p.PkgPath == p.ForTest:
+			pkg = p
+		case p.PkgPath == p.ForTest+"_test":
+			xtest = p
+		}
+	}
+	problems := packageDiagnostics(pkgs)
+	if pkg == nil || len(pkg.Syntax) == 0 {
+		if len(problems) > 0 {
+			return nil, blocking(problems)
+		}
+		return nil, fmt.Errorf("error: no Go files found in %s", directory)
+	}
+
+	generator := newGenerator()
+	generator.fset = pkg.Fset
+	generator.problems = problems
+	generator.dir = pkg.Dir
+	if generator.dir == "" && len(pkg.GoFiles) > 0 {
+		generator.dir = filepath.Dir(pkg.GoFiles[0])
+	}
+	if err := generator.processPackage(pkg); err != nil {
+		return nil, err
+	}
+	if xtest != nil {
+		if err := generator.processPackage(xtest); err != nil {
+			return nil, err
+		}
+	}
+
+	return generator, nil
+}
+
+func (g *Generator) processPackage(pkg *packages.Package) error {
+	importer := make(packageImporter)
+	for _, imp := range pkg.Types.Imports() {
+		importer[imp.Path()] = imp
+	}
+	for path, imp := range pkg.Imports {
+		if dep, ok := importer[imp.ID]; ok {
+			importer[path] = dep
+		} else if dep, ok := importer[imp.PkgPath]; ok {
+			importer[path] = dep
+		}
+	}
+
+	for _, file := range pkg.Syntax {
+		if err := g.processFile(file, pkg.Types, importer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadPackage loads the package with the given import path, resolved
+// from the given directory.  Types declared in the package are
+// qualified with the package name so the fakes can be written to a
+// different package.
+func LoadPackage(directory, path string) (*Generator, error) {
+	return loadExternalPackage(context.Background(), directory, path)
+}
+
+func loadExternalPackage(ctx context.Context, directory, path string) (*Generator, error) {
+	pkg, err := loadPackage(ctx, directory, path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load package %s: %s", path, err)
+	}
+
+	problems := packageDiagnostics([]*packages.Package{pkg})
+	if len(pkg.Syntax) == 0 && len(problems) > 0 {
+		return nil, blocking(problems)
+	}
+
+	generator := newGenerator()
+	generator.external = true
+	generator.fset = pkg.Fset
+	generator.problems = problems
+	if err := generator.processPackage(pkg); err != nil {
+		return nil, err
+	}
+
+	return generator, nil
+}
+
+func loadPackage(ctx context.Context, directory, pattern string) (*packages.Package, error) {
+	pkgs, err := loadPackages(ctx, directory, pattern, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("found %d packages", len(pkgs))
+	}
+
+	return pkgs[0], nil
+}
+
+func loadPackages(ctx context.Context, directory, pattern string, tests bool) ([]*packages.Package, error) {
+	config := &packages.Config{
+		Context: ctx,
+		Mode:    loadMode,
+		Dir:     directory,
+		Tests:   tests,
+	}
+	// N.B. - errors in the packages are reported by Generate once it is
+	// known whether they concern the interfaces being faked
+	return packages.Load(config, pattern)
+}
+
+// packageNameForDir returns the name of the package in the given
+// directory, or the directory's base name if it has no Go files yet
+func packageNameForDir(ctx context.Context, directory string) string {
+	config := &packages.Config{
+		Context: ctx,
+		Mode:    packages.NeedName,
+		Dir:     directory,
+	}
+	pkgs, err := packages.Load(config, ".")
+	if err == nil && len(pkgs) == 1 && pkgs[0].Name != "" {
+		return pkgs[0].Name
+	}
+
+	abs, err := filepath.Abs(directory)
+	if err != nil {
+		abs = directory
+	}
+	return strings.Replace(filepath.Base(abs), "-", "_", -1)
+}
+
+func newGenerator() *Generator {
+	return &Generator{
+		imports:    new(ImportSet),
+		interfaces: make(map[string]*declaration),
+		docs:       make(map[*types.Func]string),
+		ambiguous:  make(map[string]*Diagnostic),
+	}
+}
+
+// GeneratedHeader matches the first line of a file generated by charlatan,
+// which records the command line that generated it and, if needed, the
+// directory it was run in relative to the file's directory
+var GeneratedHeader = regexp.MustCompile(`^// generated by "(charlatan(?: .*?)?)"(?: in ([^ "]+|"(?:[^"\\]|\\.)*"))?\.  DO NOT EDIT\.$`)
+
+// isGenerated returns true if the file starts with the header of a file
+// generated by charlatan
+func isGenerated(file *ast.File) bool {
+	if len(file.Comments) == 0 || file.Comments[0].Pos() > file.Package {
+		return false
+	}
+	return GeneratedHeader.MatchString(file.Comments[0].List[0].Text)
+}
+
+func (g *Generator) processFile(file *ast.File, pkg *types.Package, importer types.Importer) error {
+	// N.B. - the fakes generated into the package declare interfaces of
+	// their own, which must not be faked in turn
+	if isGenerated(file) {
+		return nil
+	}
+	if err := g.processImports(file, importer); err != nil {
+		return err
+	}
+	return g.processInterfaces(file, pkg)
+}
+
+// packageImporter resolves import paths to packages already loaded by go/packages
+type packageImporter map[string]*types.Package
+
+// Import implements types.Importer
+func (p packageImporter) Import(path string) (*types.Package, error) {
+	if pkg := p[path]; pkg != nil {
+		return pkg, nil
+	}
+	return nil, fmt.Errorf("cannot find package %q", path)
+}
+
+func (g *Generator) processImports(file *ast.File, importer types.Importer) error {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return err
+		}
+		if path == "C" {
+			continue
+		}
+		pkg, err := importer.Import(path)
+		if err != nil {
+			// N.B. - the failed import is one of the package's problems
+			continue
+		}
+
+		g.processImport(spec, pkg)
+	}
+
+	return nil
+}
+
+func (g *Generator) processImport(spec *ast.ImportSpec, pkg *types.Package) {
+	decl := &Import{
+		Name: pkg.Name(),
+		Path: spec.Path.Value,
+	}
+
+	if spec.Name == nil {
+		g.imports.Add(decl)
+		return
+	}
+
+	switch spec.Name.Name {
+	case "_", ".":
+		// N.B. - the output refers to blank and dot imports by package name
+		break
+	default:
+		decl.Alias = spec.Name.Name
+	}
+
+	g.imports.Add(decl)
+}
+
+// declaration is an interface type declared in the input
+type declaration struct {
+	spec *ast.TypeSpec
+	obj  *types.TypeName // nil for the blank identifier
+	doc  string
+}
+
+func (d *declaration) underlying() *types.Interface {
+	return d.obj.Type().Underlying().(*types.Interface)
+}
+
+func (g *Generator) processInterfaces(file *ast.File, pkg *types.Package) error {
+	for _, node := range file.Decls {
+		gen, ok := node.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, s := range gen.Specs {
+			spec := s.(*ast.TypeSpec)
+			if _, ok := spec.Type.(*ast.InterfaceType); !ok {
+				continue
+			}
+
+			decl := &declaration{spec: spec}
+			if spec.Doc != nil {
+				decl.doc = spec.Doc.Text()
+			} else if gen.Doc != nil && !gen.Lparen.IsValid() {
+				decl.doc = gen.Doc.Text()
+			}
+			if obj, ok := pkg.Scope().Lookup(spec.Name.Name).(*types.TypeName); ok {
+				decl.obj = obj
+				if !decl.underlying().IsMethodSet() {
+					// N.B. - type constraints cannot be faked
+					continue
+				}
+				g.processMethodDocs(decl)
+			}
+
+			// N.B. - the external test package may redeclare a name of the
+			// package, which is then ambiguous
+			if first, ok := g.interfaces[spec.Name.Name]; ok && first.obj != nil && decl.obj != nil {
+				d := g.declarationDiagnostic(decl, fmt.Sprintf("interface declared in both package %s and package %s", first.obj.Pkg().Name(), pkg.Name()))
+				d.Blocking = true
+				g.ambiguous[spec.Name.Name] = d
+				continue
+			}
+			g.interfaces[spec.Name.Name] = decl
+			g.declared = append(g.declared, spec.Name.Name)
+		}
+	}
+
+	return nil
+}
+
+// processMethodDocs records the doc comments of the interface's explicitly
+// declared methods, or their line comments if they have none
+func (g *Generator) processMethodDocs(d *declaration) {
+	ifType := d.underlying()
+	for _, field := range d.spec.Type.(*ast.InterfaceType).Methods.List {
+		if _, ok := field.Type.(*ast.FuncType); !ok {
+			continue
+		}
+		comment := field.Doc
+		if comment == nil {
+			comment = field.Comment
+		}
+		if comment == nil {
+			continue
+		}
+		for i := 0; i < ifType.NumExplicitMethods(); i++ {
+			if m := ifType.ExplicitMethod(i); m.Name() == field.Names[0].Name {
+				g.docs[m] = comment.Text()
+			}
+		}
+	}
+}
+
+// buildInterface extracts the methods of the declared interface through
+// its type.  Embedded methods come first, in the order they're embedded,
+// followed by the explicitly declared methods in source order.  Methods
+// are listed once, even if they are embedded more than once.  Methods keep
+// the doc comments found in the package, which don't include those of
+// interfaces embedded from other packages.  The spy constructor is left
+// out of fakes of unexported interfaces written to another package.
+func (g *Generator) buildInterface(d *declaration, imports *ImportSet) (*Interface, error) {
+	decl := &Interface{
+		Name:      d.obj.Name(),
+		Qualifier: imports.Qualify(d.obj.Pkg()),
+		Doc:       d.doc,
+		// N.B. - an unexported interface can still be faked outside its
+		// package, but not referred to
+		Spy: d.obj.Exported() || d.obj.Pkg().Path() == imports.Local,
+	}
+	if named, ok := d.obj.Type().(*types.Named); ok {
+		decl.TypeParams = extractTypeParamsFromList(named.TypeParams(), imports)
+	}
+
+	ifType := d.underlying()
+	explicit := make(map[string]*types.Func, ifType.NumExplicitMethods())
+	for i := 0; i < ifType.NumExplicitMethods(); i++ {
+		m := ifType.ExplicitMethod(i)
+		explicit[m.Name()] = m
+	}
+
+	methods := make([]*types.Func, 0, ifType.NumMethods())
+	for i := 0; i < ifType.NumEmbeddeds(); i++ {
+		embed := ifType.EmbeddedType(i).Underlying().(*types.Interface)
+		for j := 0; j < embed.NumMethods(); j++ {
+			m := embed.Method(j)
+			if explicit[m.Name()] == nil && !containsFunc(methods, m.Name()) {
+				methods = append(methods, m)
+			}
+		}
+	}
+	for _, field := range d.spec.Type.(*ast.InterfaceType).Methods.List {
+		if _, ok := field.Type.(*ast.FuncType); ok {
+			methods = append(methods, explicit[field.Names[0].Name])
+		}
+	}
+
+	for _, m := range methods {
+		if !m.Exported() && m.Pkg().Path() != imports.Local {
+			return nil, Diagnostics{g.methodDiagnostic(decl.Name, m, fmt.Sprintf("unexported method cannot be faked outside package %s", m.Pkg().Name()))}
+		}
+		if obj := unexportedObject(m.Type(), imports.Local); obj != nil {
+			return nil, Diagnostics{g.methodDiagnostic(decl.Name, m, fmt.Sprintf("refers to unexported %s.%s and cannot be faked outside package %s", obj.Pkg().Name(), obj.Name(), obj.Pkg().Name()))}
+		}
+		if err := decl.addMethodFromType(m, imports); err != nil {
+			return nil, Diagnostics{g.methodDiagnostic(decl.Name, m, err.Error())}
+		}
+		decl.Methods[len(decl.Methods)-1].Doc = g.docs[m.Origin()]
+	}
+
+	return decl, nil
+}
+
+// runtimePackages are the packages used by the generated code itself, which
+// the template refers to by package name
+var runtimePackages = []*types.Package{
+	types.NewPackage("context", "context"),
+	types.NewPackage("github.com/percolate/charlatan/fake", "fake"),
+	types.NewPackage("fmt", "fmt"),
+	types.NewPackage("reflect", "reflect"),
+	types.NewPackage("sort", "sort"),
+	types.NewPackage("strings", "strings"),
+	types.NewPackage("sync", "sync"),
+	types.NewPackage("time", "time"),
+}
+
+// setFriendlyValues sets the values returned by friendly fakes: empty
+// slices and maps, and friendly fakes of the other interfaces generated
+// alongside, if they are not generic
+func setFriendlyValues(found []*declaration, decls []*Interface) {
+	fakes := make(map[*types.TypeName]string)
+	for i, d := range found {
+		if len(decls[i].TypeParams) == 0 {
+			fakes[d.obj] = fmt.Sprintf("NewFake%sDefaultFriendly", decls[i].Name)
+		}
+	}
+
+	for _, decl := range decls {
+		for _, m := range decl.Methods {
+			for _, r := range m.Results {
+				t := types.Unalias(r.typ)
+				if named, ok := t.(*types.Named); ok && named.TypeArgs().Len() == 0 {
+					if fake, ok := fakes[named.Obj()]; ok {
+						r.FriendlyFake = fake
+						continue
+					}
+				}
+				switch t.Underlying().(type) {
+				case *types.Slice:
+					r.FriendlyValue = fmt.Sprintf("make(%s, 0)", r.ValueType.FieldFormat())
+				case *types.Map:
+					r.FriendlyValue = fmt.Sprintf("make(%s)", r.ValueType.FieldFormat())
+				}
+			}
+		}
+	}
+}
+
+// identifierPattern matches the identifiers in declaration syntax
+var identifierPattern = regexp.MustCompile(`[\pL_][\pL\pN_]*`)
+
+// reserveLocalNames records the names the local variables of the generated
+// functions must avoid: the identifiers of each method's signature, which
+// are in scope alongside them, and the packages used by the output.  All of
+// the fake's methods share one receiver, which only avoids the names their
+// signatures refer to; parameters and results named like it are renamed in
+// the fake's own methods, which doesn't change the interface they implement.
+func reserveLocalNames(decls []*Interface, packages map[string]string) {
+	for _, decl := range decls {
+		referenced := make(map[string]bool)
+		for _, name := range packages {
+			referenced[name] = true
+		}
+		syntax := []string{decl.TypeParams.Declaration()}
+		for _, m := range decl.Methods {
+			syntax = append(syntax, m.ParametersSignature(), m.ResultsSignature())
+			for _, r := range m.Results {
+				syntax = append(syntax, r.FriendlyValue)
+			}
+		}
+		for _, s := range syntax {
+			for _, name := range identifierPattern.FindAllString(s, -1) {
+				referenced[name] = true
+			}
+		}
+		decl.receiver = uniqueLocal("f", referenced)
+
+		decl.shared = map[string]bool{decl.receiver: true}
+		for _, name := range packages {
+			decl.shared[name] = true
+		}
+		for _, name := range identifierPattern.FindAllString(decl.TypeParams.Declaration(), -1) {
+			decl.shared[name] = true
+		}
+
+		decl.reserved = make(map[string]bool)
+		for _, name := range packages {
+			decl.reserved[name] = true
+		}
+		decl.reserved[decl.receiver] = true
+		for _, m := range decl.Methods {
+			m.receiver = decl.receiver
+			m.reserved = make(map[string]bool)
+			for _, name := range packages {
+				m.reserved[name] = true
+			}
+			m.reserved[m.receiver] = true
+			renameIdentifiers(m, m.receiver)
+
+			syntax := []string{m.TypeParams.Declaration(), m.ParametersDeclaration(), m.ResultsDeclaration()}
+			for _, r := range m.Results {
+				syntax = append(syntax, r.FriendlyValue)
+			}
+			for _, s := range syntax {
+				for _, name := range identifierPattern.FindAllString(s, -1) {
+					m.reserved[name] = true
+					decl.reserved[name] = true
+				}
+			}
+		}
+	}
+}
+
+// renameIdentifiers renames the method's parameters and results with the
+// given name, which avoid the others' names and the types they refer to
+func renameIdentifiers(m *Method, name string) {
+	taken := map[string]bool{name: true}
+	for _, s := range []string{m.TypeParams.Declaration(), m.ParametersSignature(), m.ResultsSignature()} {
+		for _, ident := range identifierPattern.FindAllString(s, -1) {
+			taken[ident] = true
+		}
+	}
+	idents := append(append([]*Identifier{}, m.Parameters...), m.Results...)
+	for _, ident := range idents {
+		taken[ident.Name] = true
+		if ident.FriendlyValue != "" {
+			for _, s := range identifierPattern.FindAllString(ident.FriendlyValue, -1) {
+				taken[s] = true
+			}
+		}
+	}
+	for _, ident := range idents {
+		if ident.Name == name {
+			ident.rename(uniqueLocal(name, taken))
+			taken[ident.Name] = true
+		}
+	}
+}
+
+// reserveIdentifiers keeps the names of the interface's type parameters,
+// parameters and results from being used to refer to imports, which they
+// would shadow in the generated code
+func reserveIdentifiers(d *declaration, imports *ImportSet) {
+	if named, ok := d.obj.Type().(*types.Named); ok {
+		for i := 0; i < named.TypeParams().Len(); i++ {
+			imports.Reserve(named.TypeParams().At(i).Obj().Name())
+		}
+	}
+
+	ifType := d.underlying()
+	for i := 0; i < ifType.NumMethods(); i++ {
+		sig := ifType.Method(i).Type().(*types.Signature)
+		for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+			for j := 0; j < tuple.Len(); j++ {
+				if name := tuple.At(j).Name(); name != "" && name != "_" {
+					imports.Reserve(name)
+				}
+			}
+		}
+	}
+}
+
+func containsFunc(funcs []*types.Func, name string) bool {
+	for _, f := range funcs {
+		if f.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkProblems reports the problems found loading the package.  Those
+// positioned in the declaration of one of the given interfaces block
+// generation, as do those that cannot be positioned and, failing those,
+// methods of the interfaces referring to types that failed to type check.
+// The others, in unrelated declarations and files, are passed to warn.
+func (g *Generator) checkProblems(found []*declaration) error {
+	var problems Diagnostics
+	blocked := make(map[*declaration]bool)
+	for _, p := range g.problems {
+		d := *p
+		d.Blocking = d.File == ""
+		for _, decl := range found {
+			if !d.contains(g.fset.Position(decl.spec.Pos()), g.fset.Position(decl.spec.End())) {
+				continue
+			}
+			d.Blocking = true
+			d.Interface = decl.obj.Name()
+			blocked[decl] = true
+			for _, field := range decl.spec.Type.(*ast.InterfaceType).Methods.List {
+				if len(field.Names) > 0 && d.contains(g.fset.Position(field.Pos()), g.fset.Position(field.End())) {
+					d.Method = field.Names[0].Name
+				}
+			}
+		}
+		if d.Blocking {
+			problems = append(problems, &d)
+		} else {
+			g.warn(&d)
+		}
+	}
+
+	for _, decl := range found {
+		if blocked[decl] {
+			continue
+		}
+		ifType := decl.underlying()
+		for i := 0; i < ifType.NumMethods(); i++ {
+			if m := ifType.Method(i); hasInvalidType(m.Type(), 0) {
+				problems = append(problems, g.methodDiagnostic(decl.obj.Name(), m, "refers to types that failed to type check"))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// hasInvalidType returns true if the type refers to a type that failed to type check
+func hasInvalidType(t types.Type, depth int) bool {
+	// N.B. - invalid types are found near the surface, and literal types may nest deeply
+	if depth > 16 {
+		return false
+	}
+	depth++
+
+	switch actual := t.(type) {
+	case *types.Basic:
+		return actual.Kind() == types.Invalid
+	case *types.Pointer:
+		return hasInvalidType(actual.Elem(), depth)
+	case *types.Slice:
+		return hasInvalidType(actual.Elem(), depth)
+	case *types.Array:
+		return hasInvalidType(actual.Elem(), depth)
+	case *types.Chan:
+		return hasInvalidType(actual.Elem(), depth)
+	case *types.Map:
+		return hasInvalidType(actual.Key(), depth) || hasInvalidType(actual.Elem(), depth)
+	case *types.Signature:
+		for _, tuple := range []*types.Tuple{actual.Params(), actual.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if hasInvalidType(tuple.At(i).Type(), depth) {
+					return true
+				}
+			}
+		}
+	case *types.Struct:
+		for i := 0; i < actual.NumFields(); i++ {
+			if hasInvalidType(actual.Field(i).Type(), depth) {
+				return true
+			}
+		}
+	case *types.Named:
+		for i := 0; i < actual.TypeArgs().Len(); i++ {
+			if hasInvalidType(actual.TypeArgs().At(i), depth) {
+				return true
+			}
+		}
+	case *types.Alias:
+		return hasInvalidType(types.Unalias(actual), depth)
+	}
+
+	return false
+}
+
+// methodDiagnostic returns a blocking diagnostic positioned at the declaration of the method
+func (g *Generator) methodDiagnostic(iface string, m *types.Func, message string) *Diagnostic {
+	d := &Diagnostic{Interface: iface, Method: m.Name(), Message: message, Blocking: true}
+	if g.fset != nil && m.Pos().IsValid() {
+		d.setPosition(g.fset.Position(m.Pos()))
+	}
+	return d
+}
+
+// declarationDiagnostic returns a diagnostic positioned at the declaration of the interface
+func (g *Generator) declarationDiagnostic(decl *declaration, message string) *Diagnostic {
+	d := &Diagnostic{Interface: decl.spec.Name.Name, Message: message}
+	if g.fset != nil {
+		d.setPosition(g.fset.Position(decl.spec.Pos()))
+	}
+	return d
+}
+
+// warn reports a problem that doesn't prevent generation
+func (g *Generator) warn(d *Diagnostic) {
+	if g.Warn != nil {
+		g.Warn(d)
+		return
+	}
+	log.Printf("warning: %s", d)
+}
+
+// outputToPackageDir returns true if the output is written to the directory
+// of the input package.  A package of the same name in another directory is
+// a different package.
+func (g *Generator) outputToPackageDir() bool {
+	if g.OutputDir == "" {
+		return true
+	}
+	output, err := os.Stat(g.OutputDir)
+	if err != nil {
+		return false
+	}
+	input, err := os.Stat(g.dir)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(output, input)
+}
+
+// InterfaceNames returns the names of the interfaces declared in the
+// package in declaration order.  Empty interfaces are skipped, as are
+// names that don't match include or do match exclude, when given.
+func (g *Generator) InterfaceNames(include, exclude *regexp.Regexp) []string {
+	names := make([]string, 0, len(g.declared))
+	for _, name := range g.declared {
+		decl := g.interfaces[name]
+		if decl.obj == nil || decl.underlying().NumMethods() == 0 {
+			continue
+		}
+		if g.external && !decl.obj.Exported() {
+			continue
+		}
+		if include != nil && !include.MatchString(name) {
+			continue
+		}
+		if exclude != nil && exclude.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// Generate produces the charlatan source file data for the named interfaces.
+func (g *Generator) Generate(interfaceNames []string) ([]byte, error) {
+	found := make([]*declaration, 0, len(interfaceNames))
+	for _, name := range interfaceNames {
+		decl, ok := g.interfaces[name]
+		if !ok {
+			return nil, Diagnostics{{Interface: name, Message: "interface not found", Blocking: true}}
+		}
+		if d, ok := g.ambiguous[name]; ok {
+			return nil, Diagnostics{d}
+		}
+		if decl.obj == nil {
+			g.warn(g.declarationDiagnostic(decl, `ignoring interface named "_"`))
+			continue
+		}
+		if decl.underlying().NumMethods() == 0 {
+			g.warn(g.declarationDiagnostic(decl, "ignoring empty interface"))
+			continue
+		}
+		found = append(found, decl)
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("error: no valid interface names provided")
+	}
+	// N.B. - a package cannot import its external test package, so their
+	// fakes are written to separate files
+	for _, decl := range found[1:] {
+		if decl.obj.Pkg() != found[0].obj.Pkg() {
+			d := g.declarationDiagnostic(decl, fmt.Sprintf("declared in package %s, while %s is declared in package %s; generate their fakes separately", decl.obj.Pkg().Name(), found[0].obj.Name(), found[0].obj.Pkg().Name()))
+			d.Blocking = true
+			return nil, Diagnostics{d}
+		}
+	}
+	if err := g.checkProblems(found); err != nil {
+		return nil, err
+	}
+
+	// N.B. - fakes of interfaces declared in an external test package
+	// belong in that package
+	packageName := found[0].obj.Pkg().Name()
+	if g.PackageOverride != "" {
+		packageName = g.PackageOverride
+	}
+
+	// N.B. - types declared in the input package are qualified when the
+	// output is written to a different package
+	imports := g.imports.clone()
+	for _, d := range found {
+		reserveIdentifiers(d, imports)
+	}
+	// N.B. - imports unused by the output are removed when it is formatted
+	packages := make(map[string]string, len(runtimePackages))
+	for _, pkg := range runtimePackages {
+		packages[pkg.Name()] = imports.Qualify(pkg)
+	}
+	decls := make([]*Interface, len(found))
+	for i, d := range found {
+		imports.Local = ""
+		if !g.external && d.obj.Pkg().Name() == packageName && g.outputToPackageDir() {
+			imports.Local = d.obj.Pkg().Path()
+		}
+		decl, err := g.buildInterface(d, imports)
+		if err != nil {
+			return nil, err
+		}
+		decls[i] = decl
+	}
+	setFriendlyValues(found, decls)
+	reserveLocalNames(decls, packages)
+
+	commandLine := g.CommandLine
+	if commandLine == "" {
+		commandLine = "charlatan " + strings.Join(interfaceNames, " ")
+	}
+	tmpl := charlatanTemplate{
+		CommandLine: commandLine,
+		CommandDir:  g.CommandDir,
+		PackageName: packageName,
+		Packages:    packages,
+		Imports:     imports.GetRequired(),
+		Interfaces:  decls,
+	}
+
+	return tmpl.execute()
+}
@@ -0,0 +1,278 @@
+package generator
+
+import (
+	"errors"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadPackageDir(t *testing.T) {
+	g, err := LoadPackageDir(".")
+
+	assert.Equal(t, err, nil)
+	assert.IsType(t, Generator{}, *g)
+}
+
+func TestLoadPackage(t *testing.T) {
+	g, err := LoadPackage(".", "io")
+	if err != nil {
+		t.Fatalf("LoadPackage error: %s", err)
+	}
+	g.PackageOverride = "fakes"
+
+	src, err := g.Generate([]string{"ReadCloser"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "package fakes")
+	assert.Contains(t, string(src), "ReadHook  func([]byte) (int, error)")
+	assert.Contains(t, string(src), "CloseHook func() error")
+	assert.Contains(t, string(src), "io.ReadCloser) *FakeReadCloser {")
+}
+
+func TestInterfaceNames(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/embedder")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	assert.Equal(t, []string{"Embedder", "Embeddable"}, g.InterfaceNames(nil, nil))
+	assert.Equal(t, []string{"Embeddable"}, g.InterfaceNames(regexp.MustCompile("^Embeddable$"), nil))
+	assert.Equal(t, []string{"Embedder"}, g.InterfaceNames(nil, regexp.MustCompile("able$")))
+
+	g, err = LoadPackageDir("../testdata/emptier")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	assert.Empty(t, g.InterfaceNames(nil, nil))
+}
+
+func TestLoadPackageDirWithTests(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/tested")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	assert.Equal(t, []string{"Source"}, g.InterfaceNames(nil, nil))
+
+	g, err = LoadPackageDirWithTests("../testdata/tested")
+	if err != nil {
+		t.Fatalf("LoadPackageDirWithTests error: %s", err)
+	}
+
+	assert.Equal(t, []string{"Source", "Clock", "Sink"}, g.InterfaceNames(nil, nil))
+
+	src, err := g.Generate([]string{"Sink"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "package tested_test")
+	assert.Contains(t, string(src), "DrainHook func(tested.Source) error")
+
+	// N.B. - the package cannot import its external test package
+	_, err = g.Generate([]string{"Clock", "Sink"})
+	var diags Diagnostics
+	if assert.True(t, errors.As(err, &diags)) && assert.Len(t, diags, 1) {
+		assert.Equal(t, "tested_x_test.go", filepath.Base(diags[0].File))
+		assert.Equal(t, "Sink", diags[0].Interface)
+		assert.Contains(t, diags[0].Message, "generate their fakes separately")
+	}
+
+	_, err = g.Generate([]string{"Source"})
+	if assert.True(t, errors.As(err, &diags)) && assert.Len(t, diags, 1) {
+		assert.Equal(t, "tested_x_test.go", filepath.Base(diags[0].File))
+		assert.Equal(t, "interface declared in both package tested and package tested_test", diags[0].Message)
+	}
+}
+
+func TestGenerateExternalPackage(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/exported")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+	g.PackageOverride = "fakes"
+
+	src, err := g.Generate([]string{"Store"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "package fakes")
+	assert.Contains(t, string(src), "/testdata/exported\"")
+	assert.Contains(t, string(src), "GetHook func(exported.Key) (*exported.Value, time.Duration)")
+	assert.Contains(t, string(src), "PutHook func(map[exported.Key]exported.Value) error")
+	assert.Contains(t, string(src), "func NewFakeStoreSpy(real exported.Store) *FakeStore {")
+
+	// N.B. - unexported interfaces cannot be referred to by their spies
+	src, err = g.Generate([]string{"flusher"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "type Fakeflusher struct")
+	assert.NotContains(t, string(src), "Spy")
+
+	_, err = g.Generate([]string{"Hider"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "secret")
+	}
+}
+
+func TestGenerateOtherPackageDir(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/exported")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Store"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "GetHook func(Key) (*Value, time.Duration)")
+
+	// N.B. - a package of the same name in another directory is a different package
+	g.OutputDir = t.TempDir()
+	src, err = g.Generate([]string{"Store"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "package exported")
+	assert.Contains(t, string(src), "GetHook func(exported.Key) (*exported.Value, time.Duration)")
+}
+
+func TestGenerateImportCollisions(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/collider")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Renderer", "Executor"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "\"html/template\"")
+	assert.Contains(t, string(src), "template2 \"text/template\"")
+	assert.Contains(t, string(src), "RenderHook func(*template.Template) error")
+	assert.Contains(t, string(src), "ExecuteHook func(*template2.Template) error")
+}
+
+func TestGenerateFriendlyFakes(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/friend")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Friend", "Circle"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "sync.OnceValue(NewFakeFriendDefaultFriendly)")
+	assert.Contains(t, string(src), "ident1 = make([]Friend, 0)")
+
+	src, err = g.Generate([]string{"Circle"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.NotContains(t, string(src), "NewFakeFriendDefaultFriendly")
+}
+
+func TestGenerateLocalNames(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/shadower")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Shadower"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	// N.B. - the fake's methods share one receiver, parameters named like it are renamed
+	assert.Contains(t, string(src), "func (f *FakeShadower) Apply(f2 func(int) int, t int) (ident1 int) {")
+	assert.Contains(t, string(src), "invocation.Parameters.F = f2")
+	assert.Contains(t, string(src), "func (f *FakeShadower) AssertApplyCalledWith(t2 ShadowerTestingT, f2 ShadowerMatcher[func(int) int], t ShadowerMatcher[int]) {")
+	assert.Contains(t, string(src), "func (f *FakeShadower) Plain(n int) (ident1 int) {")
+	assert.Contains(t, string(src), "func (f *FakeShadower) Reset() {")
+	assert.NotContains(t, string(src), "func (f2 *FakeShadower)")
+	assert.Contains(t, string(src), "func (e2 *ShadowerWaitExpectation) Times(n int) *ShadowerWaitExpectation {")
+	assert.Contains(t, string(src), "func (e *ShadowerPlainExpectation) Times(n2 int) *ShadowerPlainExpectation {")
+	assert.Contains(t, string(src), "invocation2 := new(ShadowerMatchInvocation)")
+	// N.B. - locals only avoid the names of their own method
+	assert.Contains(t, string(src), "invocation := new(ShadowerApplyInvocation)")
+	assert.NotContains(t, string(src), "_sym")
+}
+
+func TestGenerateDocComments(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/documenter")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+
+	src, err := g.Generate([]string{"Documenter"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+
+	assert.Contains(t, string(src), "\nDocumenter stores documents by name.\n")
+	assert.Contains(t, string(src), "\t// Get returns the named document.\n\tGetHook func(string) ([]byte, error)\n")
+	assert.Contains(t, string(src), "// Put stores the named document.\nfunc (f *FakeDocumenter) Put(")
+	assert.Contains(t, string(src), "// Calling Close more than once has no effect.\nfunc (f *FakeDocumenter) Close(")
+	assert.Contains(t, string(src), "// SetDeleteStub configures Documenter.Delete to always return the given values\n//\n// Deprecated: documents are kept forever, use Put with an empty body.\n")
+	assert.NotContains(t, string(src), "// SetGetStub configures Documenter.Get to always return the given values\n//")
+}
+
+func TestGenerateDiagnostics(t *testing.T) {
+	g, err := LoadPackageDir("../testdata/diagnoser")
+	if err != nil {
+		t.Fatalf("LoadPackageDir error: %s", err)
+	}
+	var warnings []*Diagnostic
+	g.Warn = func(d *Diagnostic) { warnings = append(warnings, d) }
+
+	// errors in unrelated declarations and files don't block generation
+	src, err := g.Generate([]string{"Good"})
+	if err != nil {
+		t.Fatalf("Generator.Generate error: %s", err)
+	}
+	assert.Contains(t, string(src), "type FakeGood struct")
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, "diagnoser.go", filepath.Base(warnings[0].File))
+		assert.Equal(t, "broken.go", filepath.Base(warnings[1].File))
+		assert.Equal(t, 4, warnings[1].Line)
+		assert.Equal(t, 14, warnings[1].Column)
+		assert.False(t, warnings[1].Blocking)
+	}
+
+	warnings = nil
+	_, err = g.Generate([]string{"Bad", "Empty"})
+	var diags Diagnostics
+	if assert.True(t, errors.As(err, &diags)) && assert.Len(t, diags, 1) {
+		assert.Equal(t, "diagnoser.go", filepath.Base(diags[0].File))
+		assert.Equal(t, 10, diags[0].Line)
+		assert.Equal(t, 12, diags[0].Column)
+		assert.Equal(t, "Bad", diags[0].Interface)
+		assert.Equal(t, "Put", diags[0].Method)
+		assert.Equal(t, "undefined: Undefined", diags[0].Message)
+		assert.True(t, diags[0].Blocking)
+	}
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, "Empty", warnings[0].Interface)
+		assert.Equal(t, "ignoring empty interface", warnings[0].Message)
+	}
+
+	_, err = g.Generate([]string{"Missing"})
+	if assert.True(t, errors.As(err, &diags)) {
+		assert.Equal(t, "Missing: interface not found", err.Error())
+	}
+}
@@ -0,0 +1,20 @@
+package generator
+
+import (
+	"fmt"
+)
+
+type symbolGenerator struct {
+	Prefix string
+	Suffix string
+	count  uint64
+}
+
+func (s *symbolGenerator) next() string {
+	s.count++
+	return fmt.Sprintf("%s%d%s", s.Prefix, s.count, s.Suffix)
+}
+
+func (s *symbolGenerator) reset() {
+	s.count = 0
+}
@@ -1,16 +1,10 @@
-package main
+package generator
 
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
-func TestGensym(t *testing.T) {
-	g := gensym()
-
-	assert.Equal(t, g, "G1")
-}
-
 func TestSymbolGenerator_Next(t *testing.T) {
 	s := symbolGenerator{
 		Prefix: "A",
@@ -1,8 +1,9 @@
-package main
+package generator
 
 import (
 	"fmt"
 	"io/ioutil"
+	"os/exec"
 	"path"
 	"strings"
 	"testing"
@@ -15,17 +16,35 @@ var (
 	golden = []string{
 		"Array",
 		"Channeler",
+		"Collider",
+		"Copier",
+		"Crowder",
+		"Differ",
+		"Documenter",
 		"Embedder",
+		"Expecter",
+		"Failer",
+		"Friend",
 		"Funcer",
+		"Grouper",
 		"Identifier",
 		"Interfacer",
 		"Importer",
 		"Mapper",
 		"Multireturner",
 		"Namedvaluer",
+		"Notifier",
+		"Overlapper",
+		"Paginator",
 		"Pointer",
 		"Qualifier",
+		"Racer",
+		"Repository",
+		"Sequencer",
+		"Shadower",
 		"Structer",
+		"Summarizer",
+		"Transactor",
 		"Variadic",
 		"Voider",
 	}
@@ -45,11 +64,9 @@ func TestUnsupported(t *testing.T) {
 func CheckOneUnsupported(t *testing.T) {
 	name := path.Base(t.Name())
 	lname := strings.ToLower(name)
-	inputFilename := fmt.Sprintf("testdata/%s/%s_def.go", lname, lname)
-
-	g, err := parsePackage("testdata/"+lname, []string{inputFilename})
+	g, err := LoadPackageDir("../testdata/" + lname)
 	if err != nil {
-		t.Fatalf("parsePackage error: %s", err)
+		t.Fatalf("LoadPackageDir error: %s", err)
 	}
 	got, err := g.Generate([]string{name})
 
@@ -71,20 +88,16 @@ func CheckOneGolden(t *testing.T) {
 	name := path.Base(t.Name())
 	lname := strings.ToLower(name)
 
-	symGen.reset()
-	identSymGen.reset()
-
-	inputFilename := fmt.Sprintf("./testdata/%s/%s_def.go", lname, lname)
-	outputFilename := fmt.Sprintf("./testdata/%s/%s.go", lname, lname)
+	outputFilename := fmt.Sprintf("../testdata/%s/%s.go", lname, lname)
 
 	outputFile, err := ioutil.ReadFile(outputFilename)
 	if err != nil {
 		outputFile = []byte{}
 	}
 
-	g, err := parsePackage("testdata/"+lname, []string{inputFilename})
+	g, err := LoadPackageDir("../testdata/" + lname)
 	if err != nil {
-		t.Fatalf("parsePackage error: %s", err)
+		t.Fatalf("LoadPackageDir error: %s", err)
 	}
 	got, err := g.Generate([]string{name})
 	if err != nil {
@@ -107,3 +120,17 @@ func CheckOneGolden(t *testing.T) {
 
 	assert.Equal(t, readableOutput[outputStart:], readableResult[resultStart:], dmp.DiffPrettyText(diffs))
 }
+
+// TestGoldenVet runs go vet over the golden files, which go test also vets
+// in the packages holding the fakes
+func TestGoldenVet(t *testing.T) {
+	args := []string{"vet"}
+	for _, name := range golden {
+		args = append(args, "../testdata/"+strings.ToLower(name))
+	}
+
+	out, err := exec.Command("go", args...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("go vet failed: %s\n%s", err, out)
+	}
+}
@@ -0,0 +1,1130 @@
+package generator
+
+import (
+	"fmt"
+	"go/doc"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Import represents a declared import
+type Import struct {
+	Name     string // the package's name
+	Alias    string // the local alias for the package name
+	Path     string // import path for the package
+	Required bool   // is the import required in the charlatan output?
+}
+
+// LocalName returns the name the output uses to refer to the package
+func (i *Import) LocalName() string {
+	if i.Alias != "" {
+		return i.Alias
+	}
+	return i.Name
+}
+
+// ImportSet contains all the import declarations encountered, keyed by
+// import path
+type ImportSet struct {
+	Local    string // import path of the package whose types need no qualifier
+	imports  []*Import
+	reserved map[string]bool
+}
+
+// Add inserts the given value into the set if its path isn't already present
+func (r *ImportSet) Add(value *Import) {
+	if !r.Contains(value) {
+		r.imports = append(r.imports, value)
+	}
+}
+
+// Contains returns true if an import of the given value's path is in the set
+func (r *ImportSet) Contains(value *Import) bool {
+	for _, i := range r.imports {
+		if i.Path == value.Path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRequired returns all imports referenced by the target interface, ordered by path
+func (r *ImportSet) GetRequired() []*Import {
+	result := make([]*Import, 0, len(r.imports))
+	for _, imp := range r.imports {
+		if imp.Required {
+			result = append(result, imp)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Path < result[j].Path })
+	return result
+}
+
+// clone returns a copy of the set with no imports marked as required
+func (r *ImportSet) clone() *ImportSet {
+	c := &ImportSet{Local: r.Local}
+	for _, imp := range r.imports {
+		i := *imp
+		i.Required = false
+		c.imports = append(c.imports, &i)
+	}
+	return c
+}
+
+// Reserve prevents the given identifier from being used to refer to an import
+func (r *ImportSet) Reserve(name string) {
+	if r.reserved == nil {
+		r.reserved = make(map[string]bool)
+	}
+	r.reserved[name] = true
+}
+
+// Qualify returns the name the output uses to refer to the given
+// package and marks its import as required.  Packages that were not
+// imported by the input are added to the set.  Each required import is
+// given a local name distinct from those of the other required imports
+// and from any reserved identifier.
+func (r *ImportSet) Qualify(pkg *types.Package) string {
+	if pkg.Path() == r.Local {
+		return ""
+	}
+
+	value := &Import{Name: pkg.Name(), Path: strconv.Quote(pkg.Path())}
+	r.Add(value)
+	for _, imp := range r.imports {
+		if imp.Path != value.Path {
+			continue
+		}
+		if !imp.Required {
+			imp.Alias = r.uniqueName(imp.LocalName())
+			if imp.Alias == imp.Name {
+				imp.Alias = ""
+			}
+			imp.Required = true
+		}
+		return imp.LocalName()
+	}
+
+	panic("internal error: import not added to set")
+}
+
+func (r *ImportSet) uniqueName(name string) string {
+	taken := func(candidate string) bool {
+		if r.reserved[candidate] {
+			return true
+		}
+		for _, imp := range r.imports {
+			if imp.Required && imp.LocalName() == candidate {
+				return true
+			}
+		}
+		return false
+	}
+
+	format := "%s%d"
+	if last := name[len(name)-1]; '0' <= last && last <= '9' {
+		format = "%s_%d"
+	}
+	candidate := name
+	for n := 2; taken(candidate); n++ {
+		candidate = fmt.Sprintf(format, name, n)
+	}
+
+	return candidate
+}
+
+// Interface represents a declared interface.
+type Interface struct {
+	Name       string
+	Qualifier  string // the name the output uses for the declaring package, if not the output package
+	TypeParams TypeParams
+	Methods    []*Method
+	Doc        string // the text of the interface's doc comment
+	Spy        bool   // whether the output can refer to the interface type, which the spy constructor takes
+	receiver   string
+	reserved   map[string]bool // names local variables of the interface's functions must avoid
+	shared     map[string]bool // names local variables of the fake's methods shared by the interface's methods must avoid
+}
+
+// Summary returns the first sentence of the interface's doc comment, for the fake's doc comment
+func (i *Interface) Summary() string {
+	// N.B. - the fake's doc comment is a block comment, in which the summary
+	// continues the first paragraph so that gofmt cannot take it for a heading
+	return strings.ReplaceAll(new(doc.Package).Synopsis(i.Doc), "*/", "* /")
+}
+
+// Deprecated returns the deprecation notice in the interface's doc comment, if any
+func (i *Interface) Deprecated() string {
+	return strings.ReplaceAll(deprecation(i.Doc), "*/", "* /")
+}
+
+// DeprecatedComment returns the deprecation notice in the interface's doc comment as comment lines, if any
+func (i *Interface) DeprecatedComment() string {
+	return commentLines(deprecation(i.Doc))
+}
+
+// Receiver returns the name of the receiver of the fake's methods that are
+// not generated for one of the interface's methods
+func (i *Interface) Receiver() string {
+	return i.receiver
+}
+
+// Local returns the name of a local variable of a function shared by the
+// interface's methods, which doesn't shadow any of their identifiers
+func (i *Interface) Local(name string) string {
+	return uniqueLocal(name, i.reserved)
+}
+
+// SharedLocal returns the name of a local variable of one of the fake's
+// methods shared by the interface's methods, which only refer to its type
+// parameters and the packages
+func (i *Interface) SharedLocal(name string) string {
+	return uniqueLocal(name, i.shared)
+}
+
+// TypeReference returns the syntax to refer to the interface type, e.g. `io.Reader` or `Repository[K, V]`
+func (i *Interface) TypeReference() string {
+	if i.Qualifier == "" {
+		return i.Name + i.TypeParams.Reference()
+	}
+
+	return fmt.Sprintf("%s.%s%s", i.Qualifier, i.Name, i.TypeParams.Reference())
+}
+
+// HasResults returns true if any of the interface's methods have results
+func (i *Interface) HasResults() bool {
+	for _, m := range i.Methods {
+		if len(m.Results) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasParameters returns true if any of the interface's methods have parameters
+func (i *Interface) HasParameters() bool {
+	for _, m := range i.Methods {
+		if len(m.Parameters) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasErrorResults returns true if any of the interface's methods have
+// error as their last result, and so are configured by FailAll
+func (i *Interface) HasErrorResults() bool {
+	for _, m := range i.Methods {
+		if m.ReturnsError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TypeParam is a type parameter of a generic interface
+type TypeParam struct {
+	Name       string
+	Constraint string
+}
+
+// TypeParams is the type parameter list of a generic interface
+type TypeParams []*TypeParam
+
+// Declaration returns the syntax to declare the type parameters, e.g. `[K comparable, V any]`
+func (p TypeParams) Declaration() string {
+	if len(p) == 0 {
+		return ""
+	}
+	params := make([]string, len(p))
+	for i, param := range p {
+		params[i] = fmt.Sprintf("%s %s", param.Name, param.Constraint)
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(params, ", "))
+}
+
+// Reference returns the syntax to instantiate a generic type with the type parameters, e.g. `[K, V]`
+func (p TypeParams) Reference() string {
+	if len(p) == 0 {
+		return ""
+	}
+	names := make([]string, len(p))
+	for i, param := range p {
+		names[i] = param.Name
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(names, ", "))
+}
+
+func extractTypeParamsFromList(list *types.TypeParamList, imports *ImportSet) TypeParams {
+	if list.Len() == 0 {
+		return nil
+	}
+
+	params := make(TypeParams, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		param := list.At(i)
+		params[i] = &TypeParam{
+			Name:       param.Obj().Name(),
+			Constraint: types.TypeString(param.Constraint(), imports.Qualify),
+		}
+	}
+
+	return params
+}
+
+func (i *Interface) addMethodFromType(f *types.Func, imports *ImportSet) error {
+	method := &Method{
+		Interface:  i.Name,
+		TypeParams: i.TypeParams,
+		Name:       f.Name(),
+	}
+
+	// N.B. - unnamed parameters and results are numbered within each method
+	idents := &symbolGenerator{Prefix: "ident"}
+	sig := f.Type().(*types.Signature)
+	parameters, err := extractIdentifiersFromTuple(sig.Params(), imports, idents)
+	if err != nil {
+		return err
+	}
+	if sig.Variadic() {
+		last := parameters[len(parameters)-1]
+		if avt, ok := last.ValueType.(*Array); ok {
+			last.ValueType = &Ellipsis{subType: avt.subType}
+		} else {
+			last.ValueType = &Ellipsis{subType: last.ValueType}
+		}
+	}
+	method.Parameters = append(method.Parameters, parameters...)
+
+	results, err := extractIdentifiersFromTuple(sig.Results(), imports, idents)
+	if err != nil {
+		return err
+	}
+	method.Results = append(method.Results, results...)
+	if n := sig.Results().Len(); n > 0 {
+		last := sig.Results().At(n - 1).Type()
+		if types.Implements(last, errorType.Underlying().(*types.Interface)) {
+			method.ErrorResult = method.Results[n-1]
+			method.ReturnsError = types.Identical(last, errorType)
+		}
+	}
+
+	i.Methods = append(i.Methods, method)
+
+	return nil
+}
+
+func extractIdentifiersFromTuple(tuple *types.Tuple, imports *ImportSet, names *symbolGenerator) ([]*Identifier, error) {
+	if 0 == tuple.Len() {
+		return nil, nil
+	}
+
+	idents := make([]*Identifier, tuple.Len())
+	for i := 0; i < tuple.Len(); i++ {
+		p := tuple.At(i)
+		identifierType, err := unwrapType(p.Type(), imports)
+		if err != nil {
+			return nil, err
+		}
+		ident := &Identifier{
+			Name:      p.Name(),
+			ValueType: identifierType,
+			typ:       p.Type(),
+		}
+		if "" == ident.Name {
+			ident.Name = names.next()
+		}
+		idents[i] = ident
+	}
+
+	return idents, nil
+}
+
+func unwrapType(t types.Type, imports *ImportSet) (r Type, err error) {
+	switch actual := t.(type) {
+	case *types.Array:
+		var subType Type
+		subType, err = unwrapType(actual.Elem(), imports)
+		if err != nil {
+			return
+		}
+		a := &Array{subType: subType}
+		if actual.Len() != 0 {
+			a.scale = strconv.FormatInt(actual.Len(), 10)
+		}
+		r = a
+	case *types.Slice:
+		var subType Type
+		subType, err = unwrapType(actual.Elem(), imports)
+		if err != nil {
+			return
+		}
+		r = &Array{subType: subType}
+	case *types.Map:
+		var keyType Type
+		keyType, err = unwrapType(actual.Key(), imports)
+		if err != nil {
+			return
+		}
+		var subType Type
+		subType, err = unwrapType(actual.Elem(), imports)
+		if err != nil {
+			return
+		}
+		r = &Map{keyType: keyType, subType: subType}
+	case *types.Chan:
+		var subType Type
+		subType, err = unwrapType(actual.Elem(), imports)
+		if err != nil {
+			return
+		}
+		switch actual.Dir() {
+		case types.SendOnly:
+			r = &SendChannel{subType: subType}
+		case types.RecvOnly:
+			r = &ReceiveChannel{subType: subType}
+		case types.SendRecv:
+			r = &Channel{subType: subType}
+		}
+	case *types.Pointer:
+		var subType Type
+		subType, err = unwrapType(actual.Elem(), imports)
+		if err != nil {
+			return nil, err
+		}
+		r = &Pointer{subType: subType}
+	case *types.Interface, *types.Struct, *types.Signature:
+		r = &BasicType{Name: types.TypeString(actual, imports.Qualify)}
+	case *types.Named:
+		r, err = unwrapTypeName(actual.Obj(), actual.TypeArgs(), imports)
+	case *types.Alias:
+		// N.B. - keep the alias name, e.g. `any`, as it was declared
+		r, err = unwrapTypeName(actual.Obj(), actual.TypeArgs(), imports)
+	case *types.Basic:
+		r = &BasicType{Name: actual.Name()}
+	case *types.TypeParam:
+		r = &BasicType{Name: actual.Obj().Name()}
+	default:
+		err = fmt.Errorf("unsupported type %s", types.TypeString(actual, imports.Qualify))
+	}
+
+	return
+}
+
+// unexportedObject returns the first object referenced by the given
+// type that cannot be referred to outside its own package, unless that
+// package is local
+func unexportedObject(t types.Type, local string) types.Object {
+	foreign := func(obj types.Object) bool {
+		return obj.Pkg() != nil && !obj.Exported() && obj.Pkg().Path() != local
+	}
+
+	switch actual := t.(type) {
+	case *types.Array:
+		return unexportedObject(actual.Elem(), local)
+	case *types.Slice:
+		return unexportedObject(actual.Elem(), local)
+	case *types.Chan:
+		return unexportedObject(actual.Elem(), local)
+	case *types.Pointer:
+		return unexportedObject(actual.Elem(), local)
+	case *types.Map:
+		if obj := unexportedObject(actual.Key(), local); obj != nil {
+			return obj
+		}
+		return unexportedObject(actual.Elem(), local)
+	case *types.Tuple:
+		for i := 0; i < actual.Len(); i++ {
+			if obj := unexportedObject(actual.At(i).Type(), local); obj != nil {
+				return obj
+			}
+		}
+	case *types.Signature:
+		if obj := unexportedObject(actual.Params(), local); obj != nil {
+			return obj
+		}
+		return unexportedObject(actual.Results(), local)
+	case *types.Struct:
+		for i := 0; i < actual.NumFields(); i++ {
+			field := actual.Field(i)
+			if foreign(field) {
+				return field
+			}
+			if obj := unexportedObject(field.Type(), local); obj != nil {
+				return obj
+			}
+		}
+	case *types.Interface:
+		for i := 0; i < actual.NumMethods(); i++ {
+			m := actual.Method(i)
+			if foreign(m) {
+				return m
+			}
+			if obj := unexportedObject(m.Type(), local); obj != nil {
+				return obj
+			}
+		}
+	case *types.Named:
+		if foreign(actual.Obj()) {
+			return actual.Obj()
+		}
+		return unexportedTypeArg(actual.TypeArgs(), local)
+	case *types.Alias:
+		if foreign(actual.Obj()) {
+			return actual.Obj()
+		}
+		return unexportedTypeArg(actual.TypeArgs(), local)
+	}
+
+	return nil
+}
+
+func unexportedTypeArg(typeArgs *types.TypeList, local string) types.Object {
+	for i := 0; i < typeArgs.Len(); i++ {
+		if obj := unexportedObject(typeArgs.At(i), local); obj != nil {
+			return obj
+		}
+	}
+
+	return nil
+}
+
+func unwrapTypeName(obj *types.TypeName, typeArgs *types.TypeList, imports *ImportSet) (Type, error) {
+	b := &BasicType{Name: obj.Name()}
+	if obj.Pkg() != nil {
+		b.Qualifier = imports.Qualify(obj.Pkg())
+	}
+	if typeArgs.Len() == 0 {
+		return b, nil
+	}
+
+	args := make([]Type, typeArgs.Len())
+	for i := range args {
+		var err error
+		args[i], err = unwrapType(typeArgs.At(i), imports)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Instance{genericType: b, typeArgs: args}, nil
+}
+
+// errorType is the predeclared error interface
+var errorType = types.Universe.Lookup("error").Type()
+
+// Method represents a method in an interface's method set
+type Method struct {
+	Interface             string
+	TypeParams            TypeParams
+	Name                  string
+	Parameters            []*Identifier
+	Results               []*Identifier
+	ErrorResult           *Identifier // the last result, if its type implements error
+	ReturnsError          bool        // whether the last result is of type error itself
+	Doc                   string      // the text of the method's doc comment
+	receiver              string
+	reserved              map[string]bool // names local variables of the method's functions must avoid
+	parametersDeclaration string
+	matchersDeclaration   string
+	resultsDeclaration    string
+	parametersCall        string
+	resultsCall           string
+	parametersSignature   string
+	resultsSignature      string
+}
+
+// DocComment returns the method's doc comment as comment lines, if any
+func (m *Method) DocComment() string {
+	return commentLines(m.Doc)
+}
+
+// DeprecatedComment returns the deprecation notice in the method's doc
+// comment as comment lines, if any, so that the fake's methods configuring
+// the deprecated method are deprecated too
+func (m *Method) DeprecatedComment() string {
+	return commentLines(deprecation(m.Doc))
+}
+
+// deprecation returns the paragraph of the given doc comment text that
+// begins with "Deprecated: ", if any
+func deprecation(text string) string {
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		if strings.HasPrefix(paragraph, "Deprecated: ") {
+			return strings.TrimSpace(paragraph)
+		}
+	}
+
+	return ""
+}
+
+// commentLines formats doc comment text as line comments
+func commentLines(text string) string {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = "//"
+		} else {
+			lines[i] = "// " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Receiver returns the name of the receiver of the fake's methods
+// generated for the method
+func (m *Method) Receiver() string {
+	return m.receiver
+}
+
+// ExpectationReceiver returns the name of the receiver of the methods of the
+// method's expectations
+func (m *Method) ExpectationReceiver() string {
+	return uniqueLocal("e", m.reserved)
+}
+
+// Local returns the name of a local variable of one of the functions
+// generated for the method, which doesn't shadow any of its identifiers.
+// Names only depend on the method's own signature, so changing one method
+// leaves the code generated for the others as it was.
+func (m *Method) Local(name string) string {
+	return uniqueLocal(name, m.reserved)
+}
+
+// uniqueLocal returns the given name, or the name numbered from 2 if reserved
+func uniqueLocal(name string, reserved map[string]bool) string {
+	candidate := name
+	for n := 2; reserved[candidate]; n++ {
+		candidate = fmt.Sprintf("%s%d", name, n)
+	}
+
+	return candidate
+}
+
+// ParametersDeclaration returns the formal declaration syntax for the method's parameters
+func (m *Method) ParametersDeclaration() string {
+	if len(m.Parameters) == 0 {
+		return ""
+	}
+	if m.parametersDeclaration == "" {
+		idents := make([]string, len(m.Parameters))
+		for i, ident := range m.Parameters {
+			idents[i] = ident.ParameterFormat()
+		}
+		m.parametersDeclaration = strings.Join(idents, ", ")
+	}
+
+	return m.parametersDeclaration
+}
+
+// MatchersDeclaration returns the formal declaration syntax for matchers of the method's parameters
+func (m *Method) MatchersDeclaration() string {
+	if len(m.Parameters) == 0 {
+		return ""
+	}
+	if m.matchersDeclaration == "" {
+		idents := make([]string, len(m.Parameters))
+		for i, ident := range m.Parameters {
+			idents[i] = fmt.Sprintf("%s %sMatcher[%s]", ident.Name, m.Interface, ident.ValueType.FieldFormat())
+		}
+		m.matchersDeclaration = strings.Join(idents, ", ")
+	}
+
+	return m.matchersDeclaration
+}
+
+// ResultsDeclaration returns the formal declaration syntax for the method's results
+func (m *Method) ResultsDeclaration() string {
+	if len(m.Results) == 0 {
+		return ""
+	}
+	if m.resultsDeclaration == "" {
+		idents := make([]string, len(m.Results))
+		for i, ident := range m.Results {
+			idents[i] = ident.ParameterFormat()
+		}
+		m.resultsDeclaration = strings.Join(idents, ", ")
+	}
+
+	return m.resultsDeclaration
+}
+
+// ParametersReference returns the sytax to reference the method's parameters
+func (m *Method) ParametersReference() string {
+	if len(m.Parameters) == 0 {
+		return ""
+	}
+	if m.parametersCall == "" {
+		idents := make([]string, len(m.Parameters))
+		for i, ident := range m.Parameters {
+			idents[i] = ident.ReferenceFormat()
+		}
+		m.parametersCall = strings.Join(idents, ", ")
+	}
+
+	return m.parametersCall
+}
+
+// ResultsReference returns the syntax to reference the method's results
+func (m *Method) ResultsReference() string {
+	if len(m.Results) == 0 {
+		return ""
+	}
+	if m.resultsCall == "" {
+		idents := make([]string, len(m.Results))
+		for i, ident := range m.Results {
+			idents[i] = ident.ReferenceFormat()
+		}
+		m.resultsCall = strings.Join(idents, ", ")
+	}
+
+	return m.resultsCall
+}
+
+// ParametersSignature returns the type declaration syntax for the methods parameters
+func (m *Method) ParametersSignature() string {
+	if len(m.Parameters) == 0 {
+		return ""
+	}
+	if m.parametersSignature == "" {
+		idents := make([]string, len(m.Parameters))
+		for i, ident := range m.Parameters {
+			idents[i] = ident.Signature()
+		}
+		m.parametersSignature = strings.Join(idents, ", ")
+	}
+
+	return m.parametersSignature
+}
+
+// ResultsSignature returns the type declaration syntax for the methods results
+func (m *Method) ResultsSignature() string {
+	if len(m.Results) == 0 {
+		return ""
+	}
+	if m.resultsSignature == "" {
+		idents := make([]string, len(m.Results))
+		for i, ident := range m.Results {
+			idents[i] = ident.Signature()
+		}
+		m.resultsSignature = strings.Join(idents, ", ")
+	}
+
+	return m.resultsSignature
+}
+
+// Identifier is a declared identifier
+type Identifier struct {
+	Name            string
+	ValueType       Type
+	FriendlyValue   string // the expression returned by friendly fakes, if not the zero value
+	FriendlyFake    string // the constructor of the friendly fake returned by friendly fakes, if any
+	typ             types.Type
+	titleCase       string
+	parameterFormat string
+	referenceFormat string
+	fieldFormat     string
+	signature       string
+}
+
+// TitleCase returns the identifier's name in title case
+func (i *Identifier) TitleCase() string {
+	if i.titleCase == "" {
+		i.titleCase = strings.Title(i.Name)
+	}
+	return i.titleCase
+}
+
+// rename changes the identifier's name where it is declared and referred
+// to, leaving the name of its field as it was
+func (i *Identifier) rename(name string) {
+	i.TitleCase()
+	i.Name = name
+	i.parameterFormat = ""
+	i.referenceFormat = ""
+}
+
+// ParameterFormat returns the syntax to use the identifier as a parameter
+func (i *Identifier) ParameterFormat() string {
+	if i.parameterFormat == "" {
+		i.parameterFormat = fmt.Sprintf("%s %s", i.Name, i.ValueType.ParameterFormat())
+	}
+
+	return i.parameterFormat
+}
+
+// ReferenceFormat returns the syntax to refer to the identifier
+func (i *Identifier) ReferenceFormat() string {
+	if i.referenceFormat == "" {
+		i.referenceFormat = fmt.Sprintf("%s%s", i.Name, i.ValueType.ReferenceFormat())
+	}
+
+	return i.referenceFormat
+}
+
+// FieldFormat returns the syntax to use the identifier as a field
+func (i *Identifier) FieldFormat() string {
+	if i.fieldFormat == "" {
+		i.fieldFormat = fmt.Sprintf("%s %s", i.TitleCase(), i.ValueType.FieldFormat())
+	}
+
+	return i.fieldFormat
+}
+
+// Signature returns the syntax type signature syntax for the identifier
+func (i *Identifier) Signature() string {
+	if i.signature == "" {
+		i.signature = i.ValueType.ParameterFormat()
+	}
+
+	return i.signature
+}
+
+// Type is the interface for all built-in types
+type Type interface {
+	// ParameterFormat returns syntax for a parameter declaration
+	ParameterFormat() string
+	// ReferenceFormat returns the syntax for a reference
+	ReferenceFormat() string
+	// FieldFormat returns the syntax for a field declaration
+	FieldFormat() string
+}
+
+// Array is the built-in array type
+type Array struct {
+	subType         Type
+	scale           string
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *Array) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("[%s]%s", t.scale, t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *Array) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *Array) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("[%s]%s", t.scale, t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// Map is the built-in map type
+type Map struct {
+	keyType         Type
+	subType         Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *Map) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("map[%s]%s", t.keyType.ParameterFormat(), t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *Map) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *Map) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("map[%s]%s", t.keyType.FieldFormat(), t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// Ellipsis is the built-in vararg type
+type Ellipsis struct {
+	subType         Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *Ellipsis) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("...%s", t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *Ellipsis) ReferenceFormat() string {
+	return "..."
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *Ellipsis) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("[]%s", t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// Channel is the built-in bidirectional channel
+type Channel struct {
+	subType         Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *Channel) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("chan %s", t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *Channel) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *Channel) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("chan %s", t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// ReceiveChannel is the built-in receive-only channel
+type ReceiveChannel struct {
+	subType         Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *ReceiveChannel) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("<-chan %s", t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *ReceiveChannel) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *ReceiveChannel) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("<-chan %s", t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// SendChannel is the built-in send-only channel
+type SendChannel struct {
+	subType         Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *SendChannel) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("chan<- %s", t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *SendChannel) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *SendChannel) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("chan<- %s", t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// Pointer is the built-in pointer type
+type Pointer struct {
+	subType         Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *Pointer) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	t.parameterFormat = fmt.Sprintf("*%s", t.subType.ParameterFormat())
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *Pointer) ReferenceFormat() string {
+	return t.subType.ReferenceFormat()
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *Pointer) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	t.fieldFormat = fmt.Sprintf("*%s", t.subType.FieldFormat())
+
+	return t.fieldFormat
+}
+
+// BasicType represents all built-in simple types
+type BasicType struct {
+	Name            string
+	Qualifier       string
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *BasicType) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	if t.Qualifier != "" {
+		t.parameterFormat = fmt.Sprintf("%s.%s", t.Qualifier, t.Name)
+	} else {
+		t.parameterFormat = t.Name
+	}
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *BasicType) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *BasicType) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	if t.Qualifier != "" {
+		t.fieldFormat = fmt.Sprintf("%s.%s", t.Qualifier, t.Name)
+	} else {
+		t.fieldFormat = t.Name
+	}
+
+	return t.fieldFormat
+}
+
+// Instance is an instantiated generic type
+type Instance struct {
+	genericType     Type
+	typeArgs        []Type
+	parameterFormat string
+	fieldFormat     string
+}
+
+// ParameterFormat returns syntax for a parameter declaration
+func (t *Instance) ParameterFormat() string {
+	if t.parameterFormat != "" {
+		return t.parameterFormat
+	}
+
+	args := make([]string, len(t.typeArgs))
+	for i, arg := range t.typeArgs {
+		args[i] = arg.ParameterFormat()
+	}
+	t.parameterFormat = fmt.Sprintf("%s[%s]", t.genericType.ParameterFormat(), strings.Join(args, ", "))
+
+	return t.parameterFormat
+}
+
+// ReferenceFormat returns the syntax for a reference
+func (t *Instance) ReferenceFormat() string {
+	return ""
+}
+
+// FieldFormat returns the syntax for a field declaration
+func (t *Instance) FieldFormat() string {
+	if t.fieldFormat != "" {
+		return t.fieldFormat
+	}
+
+	args := make([]string, len(t.typeArgs))
+	for i, arg := range t.typeArgs {
+		args[i] = arg.FieldFormat()
+	}
+	t.fieldFormat = fmt.Sprintf("%s[%s]", t.genericType.FieldFormat(), strings.Join(args, ", "))
+
+	return t.fieldFormat
+}
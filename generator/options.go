@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// Options configure a generation by Generate
+type Options struct {
+	// Dir is the directory containing the package that declares the interfaces or, with Source, from which Source is resolved.  The default is the current directory.
+	Dir string
+	// Source is the import path of the package declaring the interfaces, if not the package in Dir.  Types it declares are qualified, so the fakes can be written to a different package.
+	Source string
+	// Tests includes the interfaces declared in the _test.go files of the package in Dir.  It is ignored with Source.
+	Tests bool
+	// InterfaceNames are the names of the interfaces to fake
+	InterfaceNames []string
+	// All fakes every interface declared in the package, in declaration order, instead of InterfaceNames
+	All bool
+	// Include and Exclude, if set, narrow the interfaces faked with All to those whose names match Include and do not match Exclude
+	Include, Exclude *regexp.Regexp
+	// PackageName is the package of the output.  The default is the package declaring the interfaces or, with Source, the package in OutputDir.
+	PackageName string
+	// OutputDir is the directory the output is written to.  Types declared in the input package are qualified unless the output is written to that package, in its directory.  With Source, it names the output package.  The default is the current directory with Source, and the directory of the input package otherwise.
+	OutputDir string
+	// Header is the command line recorded in the first line of the output, so that it can be regenerated.  The default is the charlatan command with the interface names.
+	Header string
+	// HeaderDir is recorded in the first line of the output after Header, when the relative paths of the command line cannot be resolved from the output path alone.  It is the directory the command line was run in, relative to the directory of the output, quoted if needed.
+	HeaderDir string
+	// Warn is called with the problems that don't prevent generation, such as errors in files of the package unrelated to the interfaces.  The default logs them.
+	Warn func(*Diagnostic)
+}
+
+// Generate returns the source of the fakes described by the given options.
+// It is safe to call concurrently.  Problems in the package that prevent
+// generation are returned as Diagnostics.  When the generated code is
+// invalid, which should not happen, it is returned along with the error.
+func Generate(ctx context.Context, opts Options) ([]byte, error) {
+	if len(opts.InterfaceNames) == 0 && !opts.All {
+		return nil, fmt.Errorf("error: no interface names provided")
+	}
+	if len(opts.InterfaceNames) != 0 && opts.All {
+		return nil, fmt.Errorf("error: interface names cannot be combined with All")
+	}
+
+	dir := opts.Dir
+	if dir == "" {
+		dir = "."
+	}
+
+	var g *Generator
+	var err error
+	if opts.Source != "" {
+		g, err = loadExternalPackage(ctx, dir, opts.Source)
+	} else {
+		g, err = loadPackageDir(ctx, dir, opts.Tests)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.PackageOverride = opts.PackageName
+	if opts.Source != "" && g.PackageOverride == "" {
+		outputDir := opts.OutputDir
+		if outputDir == "" {
+			outputDir = "."
+		}
+		g.PackageOverride = packageNameForDir(ctx, outputDir)
+	}
+	g.OutputDir = opts.OutputDir
+	g.CommandLine = opts.Header
+	g.CommandDir = opts.HeaderDir
+	g.Warn = opts.Warn
+
+	interfaceNames := opts.InterfaceNames
+	if opts.All {
+		interfaceNames = g.InterfaceNames(opts.Include, opts.Exclude)
+	}
+
+	return g.Generate(interfaceNames)
+}
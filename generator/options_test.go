@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateConcurrently(t *testing.T) {
+	options := []Options{
+		{Dir: "../testdata/repository", InterfaceNames: []string{"Repository"}},
+		{Dir: "../testdata/collider", InterfaceNames: []string{"Renderer", "Executor"}},
+		{Dir: "../testdata/friend", All: true, PackageName: "fakes", Header: "build-tool"},
+	}
+
+	want := make([][]byte, len(options))
+	for i, opts := range options {
+		src, err := Generate(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("Generate error: %s", err)
+		}
+		want[i] = src
+	}
+	assert.Contains(t, string(want[2]), "// generated by \"build-tool\".  DO NOT EDIT.")
+	assert.Contains(t, string(want[2]), "package fakes")
+	assert.Contains(t, string(want[2]), "type FakeCircle struct")
+
+	var wg sync.WaitGroup
+	for n := 0; n < 4; n++ {
+		for i, opts := range options {
+			wg.Add(1)
+			go func(i int, opts Options) {
+				defer wg.Done()
+				src, err := Generate(context.Background(), opts)
+				assert.Nil(t, err)
+				assert.Equal(t, string(want[i]), string(src))
+			}(i, opts)
+		}
+	}
+	wg.Wait()
+}
+
+func TestGenerateOptions(t *testing.T) {
+	_, err := Generate(context.Background(), Options{Dir: "../testdata/friend"})
+	assert.NotNil(t, err)
+
+	_, err = Generate(context.Background(), Options{Dir: "../testdata/friend", All: true, InterfaceNames: []string{"Friend"}})
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Generate(ctx, Options{Dir: "../testdata/friend", InterfaceNames: []string{"Friend"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,813 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"golang.org/x/tools/imports"
+)
+
+const sourceTemplate = `// generated by "{{.CommandLine}}"{{with .CommandDir}} in {{.}}{{end}}.  DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+{{range .Imports}}	{{if .Alias}}{{.Alias}} {{end}}{{.Path}}
+{{end}})
+{{range $i := .Interfaces}}{{range $m := .Methods}}
+// {{.Interface}}{{.Name}}Invocation represents a single call of Fake{{.Interface}}.{{.Name}}
+type {{.Interface}}{{.Name}}Invocation{{.TypeParams.Declaration}} struct {
+{{if .Parameters}}	Parameters struct {
+{{range .Parameters}}	{{.FieldFormat}}
+{{end}}
+	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with Set{{.Name}}Invocation
+	Matchers struct {
+{{range .Parameters}}	{{.TitleCase}} {{$m.Interface}}Matcher[{{.ValueType.FieldFormat}}]
+{{end}}
+	}{{end}}
+{{if .Results}}	Results {{.Interface}}{{.Name}}Results{{.TypeParams.Reference}}{{end}}
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *{{.Interface}}{{.Name}}Invocation{{.TypeParams.Reference}}) String() string {
+{{if .Parameters}}	return {{$.Packages.fmt}}.Sprintf("Fake{{.Interface}}.{{.Name}}%+v", i.Parameters)
+{{else}}	return "Fake{{.Interface}}.{{.Name}}()"
+{{end}}}
+{{if .Results}}
+// {{.Interface}}{{.Name}}Results holds the results of a single call of Fake{{.Interface}}.{{.Name}}
+type {{.Interface}}{{.Name}}Results{{.TypeParams.Declaration}} struct {
+{{range .Results}}	{{.FieldFormat}}
+{{end}}
+}
+{{end}}
+{{if and .Parameters .Results}}
+// New{{.Interface}}{{.Name}}Invocation creates a new instance of {{.Interface}}{{.Name}}Invocation
+func New{{.Interface}}{{.Name}}Invocation{{.TypeParams.Declaration}}({{range .Parameters}}{{.Name}} {{.ValueType.FieldFormat}}, {{end}}{{.ResultsDeclaration}}) *{{.Interface}}{{.Name}}Invocation{{.TypeParams.Reference}} {
+	{{$m.Local "invocation"}} := new({{.Interface}}{{.Name}}Invocation{{.TypeParams.Reference}})
+
+{{range .Parameters}} {{$m.Local "invocation"}}.Parameters.{{.TitleCase}} = {{.Name}}
+{{end}}
+{{range .Results}}{{$m.Local "invocation"}}.Results.{{.TitleCase}} = {{.Name}}
+{{end}}
+
+	return {{$m.Local "invocation"}}
+}{{end}}
+
+// {{.Interface}}{{.Name}}Expectation is a call of Fake{{.Interface}}.{{.Name}} expected by a test, created by Fake{{.Interface}}.Expect{{.Name}}
+type {{.Interface}}{{.Name}}Expectation{{.TypeParams.Declaration}} struct {
+	fake     *Fake{{.Interface}}{{.TypeParams.Reference}}
+	t        {{.Interface}}TestingT
+	times    int
+	count    int
+{{if .Parameters}}	matchers struct {
+{{range .Parameters}}	{{.TitleCase}} {{$m.Interface}}Matcher[{{.ValueType.FieldFormat}}]
+{{end}}
+	}
+{{end}}{{if .Results}}	returns  bool
+	results  {{.Interface}}{{.Name}}Results{{.TypeParams.Reference}}
+{{end}}}
+{{if .Parameters}}
+// With sets the matchers the parameters of the expected calls must match
+func ({{$m.ExpectationReceiver}} *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}}) With({{$m.MatchersDeclaration}}) *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}} {
+	{{$m.ExpectationReceiver}}.fake.mutex.Lock()
+	defer {{$m.ExpectationReceiver}}.fake.mutex.Unlock()
+{{range $m.Parameters}}	{{$m.ExpectationReceiver}}.matchers.{{.TitleCase}} = {{.Name}}
+{{end}}
+	return {{$m.ExpectationReceiver}}
+}
+
+func ({{$m.ExpectationReceiver}} *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}}) matches({{range $m.Parameters}}{{.Name}} {{.ValueType.FieldFormat}}, {{end}}) bool {
+	return {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$m.ExpectationReceiver}}.matchers.{{$p.TitleCase}}, {{$p.Name}}){{end}}
+}
+{{end}}{{/* end if .Parameters */}}
+// Times sets the number of expected calls, one by default
+func ({{$m.ExpectationReceiver}} *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}}) Times({{$m.Local "n"}} int) *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}} {
+	{{$m.ExpectationReceiver}}.fake.mutex.Lock()
+	defer {{$m.ExpectationReceiver}}.fake.mutex.Unlock()
+	{{$m.ExpectationReceiver}}.times = {{$m.Local "n"}}
+	return {{$m.ExpectationReceiver}}
+}
+{{if .Results}}
+// Return sets the values returned from the expected calls, rather than calling the hook
+func ({{$m.ExpectationReceiver}} *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}}) Return({{$m.ResultsDeclaration}}) *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}} {
+	{{$m.ExpectationReceiver}}.fake.mutex.Lock()
+	defer {{$m.ExpectationReceiver}}.fake.mutex.Unlock()
+	{{$m.ExpectationReceiver}}.returns = true
+	{{$m.ExpectationReceiver}}.results = {{$m.Interface}}{{$m.Name}}Results{{$m.TypeParams.Reference}}{ {{range $m.Results}}{{.TitleCase}}: {{.Name}}, {{end}} }
+	return {{$m.ExpectationReceiver}}
+}{{end}}{{/* end if .Results */}}
+{{end}}{{/* end range .Methods */}}
+
+// {{.Name}}TestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type {{.Name}}TestingT = {{$.Packages.fake}}.TestingT
+
+// {{.Name}}Matcher matches a parameter of a call to Fake{{.Name}}
+type {{.Name}}Matcher[T any] interface {
+	Match(T) bool
+}
+
+// {{.Name}}MatcherFunc is a {{.Name}}Matcher implemented by a predicate function
+type {{.Name}}MatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m {{.Name}}MatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// {{.Name}}Any returns a {{.Name}}Matcher that matches any value
+func {{.Name}}Any[T any]() {{.Name}}Matcher[T] {
+	return {{$.Packages.fake}}.Any[T]()
+}
+
+// {{.Name}}Eq returns a {{.Name}}Matcher that matches values deeply equal to want
+func {{.Name}}Eq[T any](want T) {{.Name}}Matcher[T] {
+	return {{$.Packages.fake}}.Eq(want)
+}
+
+// {{.Name}}Not returns a {{.Name}}Matcher that matches the values the given matcher does not
+func {{.Name}}Not[T any](m {{.Name}}Matcher[T]) {{.Name}}Matcher[T] {
+	return {{$.Packages.fake}}.Not[T](m)
+}
+
+// {{.Name}}Pred returns a {{.Name}}Matcher that matches values for which the given predicate returns true
+func {{.Name}}Pred[T any](pred func(T) bool) {{.Name}}Matcher[T] {
+	return {{$.Packages.fake}}.Pred(pred)
+}
+
+// {{.Name}}AnyOfType returns a {{.Name}}Matcher that matches values whose dynamic type is, or implements, U
+func {{.Name}}AnyOfType[T, U any]() {{.Name}}Matcher[T] {
+	return {{$.Packages.fake}}.AnyOfType[T, U]()
+}
+
+// {{.Name}}CallMatcher selects calls of a method of a fake for {{.Name}}InOrder and {{.Name}}Unordered
+// The matchers of fakes for other interfaces can be used alongside those of Fake{{.Name}}
+type {{.Name}}CallMatcher = {{$.Packages.fake}}.CallMatcher
+
+// {{.Name}}InOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func {{.Name}}InOrder(t {{.Name}}TestingT, calls ...{{.Name}}CallMatcher) {
+	t.Helper()
+	{{$.Packages.fake}}.InOrder(t, calls...)
+}
+
+// {{.Name}}Unordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func {{.Name}}Unordered(t {{.Name}}TestingT, calls ...{{.Name}}CallMatcher) {
+	t.Helper()
+	{{$.Packages.fake}}.Unordered(t, calls...)
+}
+
+{{if .HasResults}}
+// {{.Name}}Exhausted selects how a method of Fake{{.Name}} behaves once the results given to its SetXReturnsSequence method are used up
+type {{.Name}}Exhausted = {{$.Packages.fake}}.Exhausted
+
+const (
+	// {{.Name}}RepeatLast returns the last results in the sequence from all later calls
+	{{.Name}}RepeatLast = {{$.Packages.fake}}.RepeatLast
+	// {{.Name}}PanicAfterSequence panics on all later calls
+	{{.Name}}PanicAfterSequence = {{$.Packages.fake}}.PanicAfterSequence
+	// {{.Name}}HookAfterSequence calls the method's hook on all later calls
+	{{.Name}}HookAfterSequence = {{$.Packages.fake}}.HookAfterSequence
+)
+{{end}}
+
+/*
+Fake{{.Name}} is a mock implementation of {{.Name}} for testing.
+{{with .Summary}}{{.}}
+
+{{end}}{{if .Methods}}{{with $m := index .Methods 0}}Use it in your tests as in this example:
+
+	package example
+
+	func TestWith{{$m.Interface}}(t *testing.T) {
+		f := &{{$.PackageName}}.Fake{{$m.Interface}}{
+			{{$m.Name}}Hook: func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+				// ensure parameters meet expectations, signal errors using t, etc
+				return
+			},
+		}
+
+		// test code goes here ...
+
+		// assert state of Fake{{$m.Interface}} ...
+		f.Assert{{$m.Name}}CalledOnce(t)
+	}
+
+Create anonymous function implementations for only those interface methods that
+should be called in the code under test.  This will force a panic if any
+unexpected calls are made to Fake{{$m.Interface}}.
+{{end}}{{end}}
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
+{{with .Deprecated}}
+{{.}}
+{{end}}*/
+type Fake{{.Name}}{{.TypeParams.Declaration}} struct {
+{{range .Methods}}{{with .DocComment}}{{.}}
+{{end}} {{.Name}}Hook func({{.ParametersSignature}}) ({{.ResultsSignature}})
+{{end}}
+{{range .Methods}} {{.Name}}Calls []*{{.Interface}}{{.Name}}Invocation{{.TypeParams.Reference}}
+{{end}}
+{{range .Methods}}{{if .Results}}	returns{{.Name}} {{$.Packages.fake}}.Returns[{{.Interface}}{{.Name}}Results{{.TypeParams.Reference}}]
+{{end}}{{end}}{{range .Methods}}	expectations{{.Name}} []*{{.Interface}}{{.Name}}Expectation{{.TypeParams.Reference}}
+{{end}}	mutex {{$.Packages.sync}}.Mutex
+	recorded chan struct{} // closed when the next call is recorded, if waited for
+{{if .HasParameters}}	copyParameters bool
+{{end}}}
+
+// NewFake{{.Name}}DefaultPanic returns an instance of Fake{{.Name}} with all hooks configured to panic{{with .DeprecatedComment}}
+//
+{{.}}{{end}}
+func NewFake{{.Name}}DefaultPanic{{.TypeParams.Declaration}}() *Fake{{.Name}}{{.TypeParams.Reference}} {
+	return &Fake{{.Name}}{{.TypeParams.Reference}}{
+{{range .Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+			panic("Unexpected call to {{.Interface}}.{{.Name}}")
+		},
+{{end}}
+	}
+}
+
+// NewFake{{$i.Name}}DefaultFatal returns an instance of Fake{{$i.Name}} with all hooks configured to call t.Fatal{{with $i.DeprecatedComment}}
+//
+{{.}}{{end}}
+func NewFake{{$i.Name}}DefaultFatal{{$i.TypeParams.Declaration}}({{$i.Local "t"}} {{$i.Name}}TestingT) *Fake{{$i.Name}}{{$i.TypeParams.Reference}} {
+	return &Fake{{$i.Name}}{{$i.TypeParams.Reference}}{
+{{range $i.Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+			{{$i.Local "t"}}.Fatal("Unexpected call to {{.Interface}}.{{.Name}}")
+			return
+		},
+{{end}}
+	}
+}
+
+// NewFake{{$i.Name}}DefaultError returns an instance of Fake{{$i.Name}} with all hooks configured to call t.Error{{with $i.DeprecatedComment}}
+//
+{{.}}{{end}}
+func NewFake{{$i.Name}}DefaultError{{$i.TypeParams.Declaration}}({{$i.Local "t"}} {{$i.Name}}TestingT) *Fake{{$i.Name}}{{$i.TypeParams.Reference}} {
+	return &Fake{{$i.Name}}{{$i.TypeParams.Reference}}{
+{{range $i.Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+			{{$i.Local "t"}}.Error("Unexpected call to {{.Interface}}.{{.Name}}")
+			return
+		},
+{{end}}
+	}
+}
+
+// NewFake{{$i.Name}}DefaultZero returns an instance of Fake{{$i.Name}} with all hooks configured to return zero values{{with $i.DeprecatedComment}}
+//
+{{.}}{{end}}
+func NewFake{{$i.Name}}DefaultZero{{$i.TypeParams.Declaration}}() *Fake{{$i.Name}}{{$i.TypeParams.Reference}} {
+	return &Fake{{$i.Name}}{{$i.TypeParams.Reference}}{
+{{range $i.Methods}}		{{.Name}}Hook: func({{.ParametersSignature}}) ({{.ResultsDeclaration}}) {
+			return
+		},
+{{end}}
+	}
+}
+
+// NewFake{{$i.Name}}DefaultFriendly returns an instance of Fake{{$i.Name}} with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside Fake{{$i.Name}} are friendly fakes, one for each method and result, created on first use{{with $i.DeprecatedComment}}
+//
+{{.}}{{end}}
+func NewFake{{$i.Name}}DefaultFriendly{{$i.TypeParams.Declaration}}() *Fake{{$i.Name}}{{$i.TypeParams.Reference}} {
+{{range $m := $i.Methods}}{{range $m.Results}}{{if .FriendlyFake}}	{{$i.Local (printf "fake%s%s" $m.Name .TitleCase)}} := {{$.Packages.sync}}.OnceValue({{.FriendlyFake}})
+{{end}}{{end}}{{end}}
+	return &Fake{{$i.Name}}{{$i.TypeParams.Reference}}{
+{{range $m := $i.Methods}}		{{$m.Name}}Hook: func({{$m.ParametersSignature}}) ({{$m.ResultsDeclaration}}) {
+{{range $m.Results}}{{if .FriendlyFake}}			{{.Name}} = {{$i.Local (printf "fake%s%s" $m.Name .TitleCase)}}()
+{{else if .FriendlyValue}}			{{.Name}} = {{.FriendlyValue}}
+{{end}}{{end}}			return
+		},
+{{end}}
+	}
+}
+{{if $i.Spy}}
+// NewFake{{$i.Name}}Spy returns an instance of Fake{{$i.Name}} with all hooks configured to call the given implementation{{with $i.DeprecatedComment}}
+//
+{{.}}{{end}}
+func NewFake{{$i.Name}}Spy{{$i.TypeParams.Declaration}}({{$i.Local "real"}} {{$i.TypeReference}}) *Fake{{$i.Name}}{{$i.TypeParams.Reference}} {
+	return &Fake{{$i.Name}}{{$i.TypeParams.Reference}}{
+{{range $i.Methods}}		{{.Name}}Hook: {{$i.Local "real"}}.{{.Name}},
+{{end}}
+	}
+}
+{{end}}
+// Reset forgets all calls made to Fake{{.Name}}{{if .HasResults}}
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again{{end}}
+func ({{.Receiver}} *Fake{{.Name}}{{.TypeParams.Reference}}) Reset() {
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+{{range .Methods}}{{if .Results}}	{{$i.Receiver}}.returns{{.Name}}.Rebase(len({{$i.Receiver}}.{{.Name}}Calls))
+{{end}} {{$i.Receiver}}.{{.Name}}Calls = []*{{.Interface}}{{.Name}}Invocation{{.TypeParams.Reference}}{}
+{{end}}}
+{{if .HasParameters}}
+// SetCopyParameters configures Fake{{.Name}} to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func ({{.Receiver}} *Fake{{.Name}}{{.TypeParams.Reference}}) SetCopyParameters({{.SharedLocal "enabled"}} bool) {
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	{{.Receiver}}.copyParameters = {{.SharedLocal "enabled"}}
+}
+{{end}}
+{{if .HasErrorResults}}
+// FailAll configures every method of Fake{{.Name}} whose last result is of type error to return the given error, with zero values for its other results, as its SetXError method does
+// Calls given results by the SetXReturnsOnCall, SetXReturnsSequence and SetXErrorOnCall methods or by an expectation's Return method still return those, rather than calling the hook
+func ({{.Receiver}} *Fake{{.Name}}{{.TypeParams.Reference}}) FailAll({{.SharedLocal "err"}} error) {
+{{range .Methods}}{{if .ReturnsError}}	{{$i.Receiver}}.Set{{.Name}}Error({{$i.SharedLocal "err"}})
+{{end}}{{end}}}
+{{end}}
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func ({{.Receiver}} *Fake{{.Name}}{{.TypeParams.Reference}}) waitFor({{.SharedLocal "ctx"}} {{$.Packages.context}}.Context, {{.SharedLocal "done"}} func() bool) error {
+	for {
+		{{.Receiver}}.mutex.Lock()
+		if {{.SharedLocal "done"}}() {
+			{{.Receiver}}.mutex.Unlock()
+			return nil
+		}
+		if {{.Receiver}}.recorded == nil {
+			{{.Receiver}}.recorded = make(chan struct{})
+		}
+		{{.SharedLocal "recorded"}} := {{.Receiver}}.recorded
+		{{.Receiver}}.mutex.Unlock()
+
+		select {
+		case <-{{.SharedLocal "recorded"}}:
+		case <-{{.SharedLocal "ctx"}}.Done():
+			return {{.SharedLocal "ctx"}}.Err()
+		}
+	}
+}
+
+{{range $m := .Methods}}
+{{with $m.DocComment}}{{.}}
+{{end}}func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) {{$m.Name}}({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+	{{$m.Receiver}}.mutex.Lock()
+	{{$m.Local "hook"}} := {{$m.Receiver}}.{{$m.Name}}Hook
+	{{$m.Local "expectation"}}, {{$m.Local "t"}} := {{$m.Receiver}}.expected{{$m.Name}}({{range $m.Parameters}}{{.Name}}, {{end}})
+{{if $m.Results}}	var {{$m.Local "results"}} {{$m.Interface}}{{$m.Name}}Results{{$m.TypeParams.Reference}}
+	var {{$m.Local "found"}}, {{$m.Local "panics"}} bool
+	if {{$m.Local "expectation"}} != nil && {{$m.Local "expectation"}}.returns {
+		{{$m.Local "results"}}, {{$m.Local "found"}} = {{$m.Local "expectation"}}.results, true
+	} else {
+		{{$m.Local "results"}}, {{$m.Local "found"}}, {{$m.Local "panics"}} = {{$m.Receiver}}.returns{{$m.Name}}.Lookup(len({{$m.Receiver}}.{{$m.Name}}Calls))
+	}
+	if {{$m.Local "panics"}} {
+		{{$m.Receiver}}.mutex.Unlock()
+		panic("{{$m.Interface}}.{{$m.Name}}() called after the results given to Fake{{$m.Interface}}.Set{{$m.Name}}ReturnsSequence were used up")
+	}
+	if {{$m.Local "hook"}} == nil && !{{$m.Local "found"}} && {{$m.Local "t"}} == nil {
+{{else}}	if {{$m.Local "hook"}} == nil && {{$m.Local "t"}} == nil {
+{{end}}		{{$m.Receiver}}.mutex.Unlock()
+		panic("{{$m.Interface}}.{{$m.Name}}() called but Fake{{$m.Interface}}.{{$m.Name}}Hook is nil")
+	}
+
+	{{$m.Local "invocation"}} := new({{$m.Interface}}{{$m.Name}}Invocation{{$m.TypeParams.Reference}})
+	{{$m.Local "invocation"}}.Sequence = {{$.Packages.fake}}.NextSequence()
+	{{$m.Receiver}}.{{$m.Name}}Calls = append({{$m.Receiver}}.{{$m.Name}}Calls, {{$m.Local "invocation"}})
+
+{{if $m.Parameters}}{{range $m.Parameters}} {{$m.Local "invocation"}}.Parameters.{{.TitleCase}} = {{.Name}}
+{{end}}	if {{$m.Receiver}}.copyParameters {
+		{{$m.Local "seen"}} := make(map[uintptr]{{$.Packages.reflect}}.Value)
+{{range $m.Parameters}}		{{$m.Local "invocation"}}.Parameters.{{.TitleCase}} = {{$.Packages.fake}}.CopyParameter({{$m.Local "invocation"}}.Parameters.{{.TitleCase}}, {{$m.Local "seen"}})
+{{end}}	}
+{{end}}
+	if {{$m.Receiver}}.recorded != nil {
+		close({{$m.Receiver}}.recorded)
+		{{$m.Receiver}}.recorded = nil
+	}
+	{{$m.Receiver}}.mutex.Unlock()
+
+	if {{$m.Local "t"}} != nil && {{$m.Local "expectation"}} == nil {
+{{if $m.Parameters}}		{{$m.Local "t"}}.Errorf("Fake{{$m.Interface}}.{{$m.Name}} called with parameters matching no expectation: %+v", {{$m.Local "invocation"}}.Parameters)
+{{else}}		{{$m.Local "t"}}.Error("Fake{{$m.Interface}}.{{$m.Name}} called more times than expected")
+{{end}}	}
+
+{{if $m.Results}}	if {{$m.Local "found"}} {
+{{range $m.Results}}		{{.Name}} = {{$m.Local "results"}}.{{.TitleCase}}
+{{end}}	} else if {{$m.Local "hook"}} != nil {
+		{{$m.ResultsReference}} = {{$m.Local "hook"}}({{$m.ParametersReference}})
+	}
+
+	{{$m.Receiver}}.mutex.Lock()
+{{range $m.Results}}{{$m.Local "invocation"}}.Results.{{.TitleCase}} = {{.Name}}
+{{end}}	{{$m.Receiver}}.mutex.Unlock()
+{{else}}	if {{$m.Local "hook"}} != nil {
+		{{$m.Local "hook"}}({{$m.ParametersReference}})
+	}
+{{end}}
+	return
+}
+
+// expected{{$m.Name}} returns the first unsatisfied expectation of Fake{{$m.Interface}}.{{$m.Name}} matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) expected{{$m.Name}}({{range $m.Parameters}}{{.Name}} {{.ValueType.FieldFormat}}, {{end}}) (*{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}}, {{$m.Interface}}TestingT) {
+	if len({{$m.Receiver}}.expectations{{$m.Name}}) == 0 {
+		return nil, nil
+	}
+	for _, {{$m.Local "expectation"}} := range {{$m.Receiver}}.expectations{{$m.Name}} {
+		if {{$m.Local "expectation"}}.count < {{$m.Local "expectation"}}.times{{if $m.Parameters}} && {{$m.Local "expectation"}}.matches({{range $m.Parameters}}{{.Name}}, {{end}}){{end}} {
+			{{$m.Local "expectation"}}.count++
+			return {{$m.Local "expectation"}}, {{$m.Local "expectation"}}.t
+		}
+	}
+
+	return nil, {{$m.Receiver}}.expectations{{$m.Name}}[0].t
+}
+
+// Expect{{$m.Name}} expects calls of Fake{{$m.Interface}}.{{$m.Name}}, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Expect{{$m.Name}}(t {{$m.Interface}}TestingT) *{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}} {
+	t.Helper()
+	{{$m.Local "expectation"}} := &{{$m.Interface}}{{$m.Name}}Expectation{{$m.TypeParams.Reference}}{fake: {{$m.Receiver}}, t: t, times: 1}
+	{{$m.Receiver}}.mutex.Lock()
+	{{$m.Receiver}}.expectations{{$m.Name}} = append({{$m.Receiver}}.expectations{{$m.Name}}, {{$m.Local "expectation"}})
+	{{$m.Receiver}}.mutex.Unlock()
+
+	t.Cleanup(func() {
+		{{$m.Receiver}}.mutex.Lock()
+		defer {{$m.Receiver}}.mutex.Unlock()
+		if {{$m.Local "expectation"}}.count != {{$m.Local "expectation"}}.times {
+			t.Errorf("Fake{{$m.Interface}}.{{$m.Name}} called %d times matching an expectation, expected %d", {{$m.Local "expectation"}}.count, {{$m.Local "expectation"}}.times)
+		}
+	})
+
+	return {{$m.Local "expectation"}}
+}
+
+// Set{{.Name}}Hook configures {{.Interface}}.{{.Name}} to call the given function{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}Hook({{$m.Local "hook"}} func({{$m.ParametersSignature}}) ({{$m.ResultsSignature}})) {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	{{$m.Receiver}}.{{$m.Name}}Hook = {{$m.Local "hook"}}
+}
+{{if .Results}}
+// Set{{.Name}}Stub configures {{.Interface}}.{{.Name}} to always return the given values{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}Stub({{$m.ResultsDeclaration}}) {
+	{{$m.Receiver}}.Set{{$m.Name}}Hook(func({{$m.ParametersSignature}}) ({{$m.ResultsSignature}}) {
+		return {{range $idx, $r := $m.Results}}{{if $idx}}, {{end}}{{$r.Name}}{{end}}
+	})
+}
+
+// Set{{.Name}}ReturnsOnCall configures {{.Interface}}.{{.Name}} to return the given values from the call with the given index in {{.Name}}Calls, rather than calling the hook{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}ReturnsOnCall({{$m.Local "call"}} int, {{$m.ResultsDeclaration}}) {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	{{$m.Receiver}}.returns{{$m.Name}}.Set({{$m.Local "call"}}, {{$m.Interface}}{{$m.Name}}Results{{$m.TypeParams.Reference}}{ {{range $m.Results}}{{.TitleCase}}: {{.Name}}, {{end}} })
+}
+
+// Set{{.Name}}ReturnsSequence configures the following calls of {{.Interface}}.{{.Name}} to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}ReturnsSequence({{$m.Local "exhausted"}} {{$m.Interface}}Exhausted, {{$m.Local "results"}} ...{{$m.Interface}}{{$m.Name}}Results{{$m.TypeParams.Reference}}) {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	{{$m.Receiver}}.returns{{$m.Name}}.Sequence(len({{$m.Receiver}}.{{$m.Name}}Calls), {{$m.Local "exhausted"}}, {{$m.Local "results"}})
+}{{end}}{{/* end if .Results */}}
+{{with $e := .ErrorResult}}
+// Set{{$m.Name}}Error configures {{$m.Interface}}.{{$m.Name}} to always return the given error, with zero values for its other results{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}Error({{$m.Local "err"}} {{$e.ValueType.FieldFormat}}) {
+	{{$m.Receiver}}.Set{{$m.Name}}Hook(func({{$m.ParametersSignature}}) ({{$m.ResultsDeclaration}}) {
+		{{$e.Name}} = {{$m.Local "err"}}
+		return
+	})
+}
+
+// Set{{$m.Name}}ErrorOnCall configures {{$m.Interface}}.{{$m.Name}} to return the given error, with zero values for its other results, from the call with the given index in {{$m.Name}}Calls, rather than calling the hook{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}ErrorOnCall({{$m.Local "call"}} int, {{$m.Local "err"}} {{$e.ValueType.FieldFormat}}) {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	{{$m.Receiver}}.returns{{$m.Name}}.Set({{$m.Local "call"}}, {{$m.Interface}}{{$m.Name}}Results{{$m.TypeParams.Reference}}{ {{$e.TitleCase}}: {{$m.Local "err"}} })
+}{{end}}{{/* end with .ErrorResult */}}
+{{if and .Parameters .Results}}
+// Set{{.Name}}Invocation configures {{.Interface}}.{{.Name}} to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
+// If no match is found for an invocation the result(s) of the fallback function are returned{{with $m.DeprecatedComment}}
+//
+{{.}}{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Set{{$m.Name}}Invocation({{$m.Local "calls"}} []*{{$m.Interface}}{{$m.Name}}Invocation{{$m.TypeParams.Reference}}, {{$m.Local "fallback"}} func() ({{$m.ResultsSignature}})) {
+	{{$m.Receiver}}.Set{{$m.Name}}Hook(func({{$m.ParametersDeclaration}}) ({{$m.ResultsDeclaration}}) {
+		for _, {{$m.Local "call"}} := range {{$m.Local "calls"}} {
+			if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.MatchParameter({{$m.Local "call"}}.Matchers.{{$p.TitleCase}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}, {{$p.Name}}){{end}} {
+				{{range $m.Results}}{{.Name}} = {{$m.Local "call"}}.Results.{{.TitleCase}}
+				{{end}}
+				return
+			}
+		}
+
+		return {{$m.Local "fallback"}}()
+	})
+}{{end}}{{/* end if and .Parameters .Results */}}
+
+// {{.Name}}CallsSnapshot returns a copy of the calls made to Fake{{.Interface}}.{{.Name}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) {{$m.Name}}CallsSnapshot() []*{{$m.Interface}}{{$m.Name}}Invocation{{$m.TypeParams.Reference}} {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	{{$m.Local "calls"}} := make([]*{{$m.Interface}}{{$m.Name}}Invocation{{$m.TypeParams.Reference}}, len({{$m.Receiver}}.{{$m.Name}}Calls))
+	for {{$m.Local "i"}}, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		{{$m.Local "invocation"}} := *{{$m.Local "call"}}
+		{{$m.Local "calls"}}[{{$m.Local "i"}}] = &{{$m.Local "invocation"}}
+	}
+
+	return {{$m.Local "calls"}}
+}
+
+// {{.Name}}Call returns a {{.Interface}}CallMatcher selecting the calls of Fake{{.Interface}}.{{.Name}}{{if .Parameters}} with parameters matching the given matchers, any of which may be nil to match any value{{end}}
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) {{$m.Name}}Call({{$m.MatchersDeclaration}}) {{$m.Interface}}CallMatcher {
+	return {{$.Packages.fake}}.NewCallMatcher("Fake{{$m.Interface}}.{{$m.Name}}{{if $m.Parameters}}(...){{else}}(){{end}}", func() (map[int64]string, []int64) {
+		{{$m.Receiver}}.mutex.Lock()
+		{{$m.Local "snapshot"}} := append([]*{{$m.Interface}}{{$m.Name}}Invocation{{$m.TypeParams.Reference}}(nil), {{$m.Receiver}}.{{$m.Name}}Calls...)
+		{{$m.Receiver}}.mutex.Unlock()
+
+		{{$m.Local "calls"}} := make(map[int64]string, len({{$m.Local "snapshot"}}))
+		var {{$m.Local "matching"}} []int64
+		for _, {{$m.Local "call"}} := range {{$m.Local "snapshot"}} {
+			{{$m.Local "calls"}}[{{$m.Local "call"}}.Sequence] = {{$m.Local "call"}}.String()
+			{{if $m.Parameters}}if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+				{{$m.Local "matching"}} = append({{$m.Local "matching"}}, {{$m.Local "call"}}.Sequence)
+			}{{else}}{{$m.Local "matching"}} = append({{$m.Local "matching"}}, {{$m.Local "call"}}.Sequence){{end}}
+		}
+
+		return {{$m.Local "calls"}}, {{$m.Local "matching"}}
+	})
+}
+
+// {{.Name}}Called returns true if Fake{{.Interface}}.{{.Name}} was called
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) {{.Name}}Called() bool {
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	return len({{.Receiver}}.{{.Name}}Calls) != 0
+}
+
+// Assert{{.Name}}Called calls t.Error if Fake{{.Interface}}.{{.Name}} was not called
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) Assert{{.Name}}Called(t {{.Interface}}TestingT) {
+	t.Helper()
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	if len({{.Receiver}}.{{.Name}}Calls) == 0 {
+		t.Error("Fake{{.Interface}}.{{.Name}} not called, expected at least one")
+	}
+}
+
+// {{.Name}}NotCalled returns true if Fake{{.Interface}}.{{.Name}} was not called
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) {{.Name}}NotCalled() bool {
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	return len({{.Receiver}}.{{.Name}}Calls) == 0
+}
+
+// Assert{{.Name}}NotCalled calls t.Error if Fake{{.Interface}}.{{.Name}} was called
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) Assert{{.Name}}NotCalled(t {{.Interface}}TestingT) {
+	t.Helper()
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	if len({{.Receiver}}.{{.Name}}Calls) != 0 {
+		t.Error("Fake{{.Interface}}.{{.Name}} called, expected none")
+	}
+}
+
+// {{.Name}}CalledOnce returns true if Fake{{.Interface}}.{{.Name}} was called exactly once
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) {{.Name}}CalledOnce() bool {
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	return len({{.Receiver}}.{{.Name}}Calls) == 1
+}
+
+// Assert{{.Name}}CalledOnce calls t.Error if Fake{{.Interface}}.{{.Name}} was not called exactly once
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) Assert{{.Name}}CalledOnce(t {{.Interface}}TestingT) {
+	t.Helper()
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	if len({{.Receiver}}.{{.Name}}Calls) != 1 {
+		t.Errorf("Fake{{.Interface}}.{{.Name}} called %d times, expected 1", len({{.Receiver}}.{{.Name}}Calls))
+	}
+}
+
+// {{.Name}}CalledN returns true if Fake{{.Interface}}.{{.Name}} was called at least n times
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) {{.Name}}CalledN(n int) bool {
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	return len({{.Receiver}}.{{.Name}}Calls) >= n
+}
+
+// Assert{{.Name}}CalledN calls t.Error if Fake{{.Interface}}.{{.Name}} was called less than n times
+func ({{.Receiver}} *Fake{{.Interface}}{{.TypeParams.Reference}}) Assert{{.Name}}CalledN(t {{.Interface}}TestingT, n int) {
+	t.Helper()
+	{{.Receiver}}.mutex.Lock()
+	defer {{.Receiver}}.mutex.Unlock()
+	if len({{.Receiver}}.{{.Name}}Calls) < n {
+		t.Errorf("Fake{{.Interface}}.{{.Name}} called %d times, expected >= %d", len({{.Receiver}}.{{.Name}}Calls), n)
+	}
+}
+
+// WaitFor{{.Name}}Called blocks until Fake{{.Interface}}.{{.Name}} has been called, returning the error of ctx if it is done first
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) WaitFor{{$m.Name}}Called({{$m.Local "ctx"}} {{$.Packages.context}}.Context) error {
+	return {{$m.Receiver}}.WaitFor{{$m.Name}}CalledN({{$m.Local "ctx"}}, 1)
+}
+
+// WaitFor{{.Name}}CalledN blocks until Fake{{.Interface}}.{{.Name}} has been called at least n times, returning the error of ctx if it is done first
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) WaitFor{{$m.Name}}CalledN({{$m.Local "ctx"}} {{$.Packages.context}}.Context, {{$m.Local "n"}} int) error {
+	return {{$m.Receiver}}.waitFor({{$m.Local "ctx"}}, func() bool {
+		return len({{$m.Receiver}}.{{$m.Name}}Calls) >= {{$m.Local "n"}}
+	})
+}
+
+// Assert{{.Name}}EventuallyCalled calls t.Error if Fake{{.Interface}}.{{.Name}} is not called within the timeout
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Assert{{$m.Name}}EventuallyCalled(t {{$m.Interface}}TestingT, {{$m.Local "timeout"}} {{$.Packages.time}}.Duration) {
+	t.Helper()
+	{{$m.Local "ctx"}}, {{$m.Local "cancel"}} := {{$.Packages.context}}.WithTimeout({{$.Packages.context}}.Background(), {{$m.Local "timeout"}})
+	defer {{$m.Local "cancel"}}()
+	if {{$m.Receiver}}.WaitFor{{$m.Name}}Called({{$m.Local "ctx"}}) != nil {
+		t.Errorf("Fake{{$m.Interface}}.{{$m.Name}} not called within %v", {{$m.Local "timeout"}})
+	}
+}
+
+{{if .Parameters}}// describe{{.Name}}Calls describes the calls of Fake{{.Interface}}.{{.Name}} against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) describe{{$m.Name}}Calls({{$m.MatchersDeclaration}}) string {
+	var {{$m.Local "b"}} {{$.Packages.strings}}.Builder
+	{{$m.Local "b"}}.WriteString("expected:")
+{{range $m.Parameters}}	{{$.Packages.fmt}}.Fprintf(&{{$m.Local "b"}}, "\n\t{{.TitleCase}}: %s", {{$.Packages.fake}}.Describe({{.Name}}))
+{{end}}
+	if len({{$m.Receiver}}.{{$m.Name}}Calls) == 0 {
+		{{$m.Local "b"}}.WriteString("\nrecorded calls: none")
+		return {{$m.Local "b"}}.String()
+	}
+
+	{{$m.Local "b"}}.WriteString("\nrecorded calls:")
+	{{$m.Local "closest"}}, {{$m.Local "best"}}, {{$m.Local "found"}} := 0, -1, false
+	for {{$m.Local "i"}}, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		{{$m.Local "matched"}} := 0
+{{range $m.Parameters}}		if {{$.Packages.fake}}.Matches({{.Name}}, {{$m.Local "call"}}.Parameters.{{.TitleCase}}) {
+			{{$m.Local "matched"}}++
+		}
+{{end}}
+		if {{$m.Local "matched"}} == {{len $m.Parameters}} {
+			{{$m.Local "found"}} = true
+			{{$.Packages.fmt}}.Fprintf(&{{$m.Local "b"}}, "\n\t%s (matches)", {{$m.Local "call"}})
+		} else {
+			{{$.Packages.fmt}}.Fprintf(&{{$m.Local "b"}}, "\n\t%s", {{$m.Local "call"}})
+		}
+		if {{$m.Local "matched"}} > {{$m.Local "best"}} {
+			{{$m.Local "closest"}}, {{$m.Local "best"}} = {{$m.Local "i"}}, {{$m.Local "matched"}}
+		}
+	}
+	if {{$m.Local "found"}} {
+		return {{$m.Local "b"}}.String()
+	}
+
+	{{$m.Local "call"}} := {{$m.Receiver}}.{{$m.Name}}Calls[{{$m.Local "closest"}}]
+	{{$.Packages.fmt}}.Fprintf(&{{$m.Local "b"}}, "\nclosest call %s differs in:", {{$m.Local "call"}})
+{{range $m.Parameters}}	if !{{$.Packages.fake}}.Matches({{.Name}}, {{$m.Local "call"}}.Parameters.{{.TitleCase}}) {
+		{{$.Packages.fmt}}.Fprintf(&{{$m.Local "b"}}, "\n\t{{.TitleCase}}: got %s, want %s", {{$.Packages.fake}}.Format({{$m.Local "call"}}.Parameters.{{.TitleCase}}), {{$.Packages.fake}}.Describe({{.Name}}))
+	}
+{{end}}
+	return {{$m.Local "b"}}.String()
+}
+
+// {{.Name}}CalledWith returns true if Fake{{.Interface}}.{{.Name}} was called with values matching the given matchers, any of which may be nil to match any value
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) {{$m.Name}}CalledWith({{$m.MatchersDeclaration}}) bool {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	for _, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Assert{{.Name}}CalledWith calls t.Error if Fake{{.Interface}}.{{.Name}} was not called with values matching the given matchers, any of which may be nil to match any value
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Assert{{$m.Name}}CalledWith({{$m.Local "t"}} {{$m.Interface}}TestingT, {{$m.MatchersDeclaration}}) {
+	{{$m.Local "t"}}.Helper()
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	var {{$m.Local "found"}} bool
+	for _, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+			{{$m.Local "found"}} = true
+			break
+		}
+	}
+
+	if !{{$m.Local "found"}} {
+		{{$m.Local "t"}}.Errorf("Fake{{$m.Interface}}.{{$m.Name}} not called with expected parameters\n%s", {{$m.Receiver}}.describe{{$m.Name}}Calls({{range $m.Parameters}}{{.Name}}, {{end}}))
+	}
+}
+
+// {{.Name}}CalledOnceWith returns true if Fake{{.Interface}}.{{.Name}} was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) {{$m.Name}}CalledOnceWith({{$m.MatchersDeclaration}}) bool {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	var {{$m.Local "count"}} int
+	for _, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+			{{$m.Local "count"}}++
+		}
+	}
+
+	return {{$m.Local "count"}} == 1
+}
+
+// Assert{{.Name}}CalledOnceWith calls t.Error if Fake{{.Interface}}.{{.Name}} was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Assert{{$m.Name}}CalledOnceWith({{$m.Local "t"}} {{$m.Interface}}TestingT, {{$m.MatchersDeclaration}}) {
+	{{$m.Local "t"}}.Helper()
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	var {{$m.Local "count"}} int
+	for _, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+			{{$m.Local "count"}}++
+		}
+	}
+
+	if {{$m.Local "count"}} != 1 {
+		{{$m.Local "t"}}.Errorf("Fake{{$m.Interface}}.{{$m.Name}} called %d times with expected parameters, expected one\n%s", {{$m.Local "count"}}, {{$m.Receiver}}.describe{{$m.Name}}Calls({{range $m.Parameters}}{{.Name}}, {{end}}))
+	}
+}
+
+// Assert{{.Name}}EventuallyCalledWith calls t.Error if Fake{{.Interface}}.{{.Name}} is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) Assert{{$m.Name}}EventuallyCalledWith({{$m.Local "t"}} {{$m.Interface}}TestingT, {{$m.Local "timeout"}} {{$.Packages.time}}.Duration, {{$m.MatchersDeclaration}}) {
+	{{$m.Local "t"}}.Helper()
+	{{$m.Local "ctx"}}, {{$m.Local "cancel"}} := {{$.Packages.context}}.WithTimeout({{$.Packages.context}}.Background(), {{$m.Local "timeout"}})
+	defer {{$m.Local "cancel"}}()
+	var {{$m.Local "count"}} int
+	{{$m.Local "err"}} := {{$m.Receiver}}.waitFor({{$m.Local "ctx"}}, func() bool {
+		{{$m.Local "count"}} = len({{$m.Receiver}}.{{$m.Name}}Calls)
+		for _, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+			if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+				return true
+			}
+		}
+		return false
+	})
+
+	if {{$m.Local "err"}} != nil {
+		{{$m.Receiver}}.mutex.Lock()
+		defer {{$m.Receiver}}.mutex.Unlock()
+		{{$m.Local "t"}}.Errorf("Fake{{$m.Interface}}.{{$m.Name}} not called with expected parameters within %v, called %d times\n%s", {{$m.Local "timeout"}}, {{$m.Local "count"}}, {{$m.Receiver}}.describe{{$m.Name}}Calls({{range $m.Parameters}}{{.Name}}, {{end}}))
+	}
+}
+{{if len $m.Results }}
+// {{.Name}}ResultsForCall returns the result values for the first call to Fake{{.Interface}}.{{.Name}} with values matching the given matchers, any of which may be nil to match any value
+func ({{$m.Receiver}} *Fake{{$m.Interface}}{{$m.TypeParams.Reference}}) {{$m.Name}}ResultsForCall({{$m.MatchersDeclaration}}) ({{$m.ResultsDeclaration}}, {{$m.Local "found"}} bool) {
+	{{$m.Receiver}}.mutex.Lock()
+	defer {{$m.Receiver}}.mutex.Unlock()
+	for _, {{$m.Local "call"}} := range {{$m.Receiver}}.{{$m.Name}}Calls {
+		if {{range $idx, $p := $m.Parameters}}{{if $idx}} && {{end}}{{$.Packages.fake}}.Matches({{$p.Name}}, {{$m.Local "call"}}.Parameters.{{$p.TitleCase}}){{end}} {
+			{{range $m.Results}}{{.Name}} = {{$m.Local "call"}}.Results.{{.TitleCase}}
+			{{end}}{{$m.Local "found"}} = true
+			break
+		}
+	}
+
+	return
+}{{end}}{{/* end if len $m.Results */}}
+{{end}}{{/* end if .Parameters */}}
+{{end}}{{/* end range $m := .Methods */}}
+{{end}}{{/* end range .Interfaces */}}
+`
+
+var tmpl = template.Must(template.New("charlatan").Parse(sourceTemplate))
+
+type charlatanTemplate struct {
+	CommandLine string
+	CommandDir  string
+	PackageName string
+	Packages    map[string]string // names of the packages used by the generated code
+	Imports     []*Import
+	Interfaces  []*Interface
+}
+
+func (t *charlatanTemplate) execute() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, t); err != nil {
+		return nil, err
+	}
+
+	src, err := imports.Process("", buf.Bytes(), nil)
+	if err != nil {
+		// Should not happen except when developing this code.
+		// The user can compile the output to see the error.
+		return buf.Bytes(), fmt.Errorf("internal error: invalid code generated: %s", err)
+	}
+
+	return src, nil
+}
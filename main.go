@@ -1,13 +1,20 @@
 package main // import "github.com/percolate/charlatan"
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+
+	"github.com/percolate/charlatan/generator"
 )
 
 type stringSliceValue []string
@@ -31,79 +38,278 @@ https://github.com/percolate/charlatan
 
 Usage:
   charlatan [options] <interface> ...
+  charlatan [options] <import path>.<interface> ...
+  charlatan [options] -all
+  charlatan verify <path> ...
+  charlatan regen <path> ...
   charlatan -h | --help
 
+The verify command reports generated files that are out of date, and the
+regen command rewrites them.  Paths are files or directories, and a path
+ending in "/..." includes its subdirectories.
+
 Options:
 `
 )
 
-var (
-	outputPath    = flag.String("output", "", "output file path [default: ./charlatan.go]")
-	outputPackage = flag.String("package", "", "output package name [default: \"<current package>\"]")
-	dirName       = flag.String("dir", "", "input package directory [default: current package directory]")
-)
+// options are the settings of a single generation, from the command line
+type options struct {
+	outputPath     string
+	outputPackage  string
+	dirName        string
+	sourcePath     string
+	all            bool
+	includeRegexp  string
+	excludeRegexp  string
+	tests          bool
+	interfaceNames []string
+	commandLine    string // recorded in the header of the output
+	json           bool
+}
+
+// newFlagSet returns the flags of the generate command, which store their values in opts
+func newFlagSet(opts *options, errorHandling flag.ErrorHandling) *flag.FlagSet {
+	flags := flag.NewFlagSet("charlatan", errorHandling)
+	flags.StringVar(&opts.outputPath, "output", "", "output file path [default: ./charlatan.go]")
+	flags.StringVar(&opts.outputPackage, "package", "", "output package name [default: \"<current package>\"]")
+	flags.StringVar(&opts.dirName, "dir", "", "input package directory [default: current package directory]")
+	flags.StringVar(&opts.sourcePath, "source", "", "import path of the package declaring the interfaces [default: the -dir package]")
+	flags.BoolVar(&opts.all, "all", false, "generate fakes for every interface declared in the package")
+	flags.StringVar(&opts.includeRegexp, "include", "", "with -all, only generate fakes for interface names matching this regular expression")
+	flags.StringVar(&opts.excludeRegexp, "exclude", "", "with -all, skip interface names matching this regular expression")
+	flags.BoolVar(&opts.tests, "tests", false, "include interfaces declared in the package's _test.go files")
+	flags.BoolVar(&opts.json, "json", false, "print diagnostics to standard output as a JSON array")
+
+	return flags
+}
+
+// parseOptions parses the arguments of the generate command
+func parseOptions(flags *flag.FlagSet, opts *options, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	opts.interfaceNames = flags.Args()
+
+	var argv strings.Builder
+	argv.WriteString("charlatan")
+	flags.Visit(func(f *flag.Flag) {
+		// N.B. - the format of diagnostics doesn't change the output
+		if f.Name != "json" {
+			fmt.Fprintf(&argv, " -%s=%s", f.Name, quoteArgument(f.Value.String()))
+		}
+	})
+	for _, name := range opts.interfaceNames {
+		argv.WriteByte(' ')
+		argv.WriteString(quoteArgument(name))
+	}
+	opts.commandLine = argv.String()
+
+	return opts.validate()
+}
+
+// validate checks the combination of options
+func (opts *options) validate() error {
+	if len(opts.interfaceNames) == 0 && !opts.all {
+		return fmt.Errorf("interface parameters are required")
+	}
+
+	if len(opts.interfaceNames) != 0 && opts.all {
+		return fmt.Errorf("interface parameters cannot be combined with -all")
+	}
+
+	if (opts.includeRegexp != "" || opts.excludeRegexp != "") && !opts.all {
+		return fmt.Errorf("-include and -exclude require -all")
+	}
+
+	if opts.outputPath != "" && !strings.HasSuffix(opts.outputPath, ".go") {
+		return fmt.Errorf("output path must be a Go source file name")
+	}
+
+	return nil
+}
 
 func init() {
 	log.SetFlags(0)
 	log.SetPrefix("charlatan: ")
-	flag.Usage = usage
-}
-
-func usage() {
-	fmt.Fprintf(os.Stderr, usageFormat)
-	flag.PrintDefaults()
 }
 
 func main() {
-	flag.Parse()
+	if len(os.Args) > 1 && (os.Args[1] == "verify" || os.Args[1] == "regen") {
+		os.Exit(runGenerated(os.Args[1], os.Args[2:]))
+	}
 
-	if flag.NArg() == 0 {
-		log.Print("interface parameters are required")
-		flag.Usage()
+	opts := new(options)
+	flags := newFlagSet(opts, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, usageFormat)
+		flags.PrintDefaults()
+	}
+	if err := parseOptions(flags, opts, os.Args[1:]); err != nil {
+		log.Print(err)
+		flags.Usage()
 		os.Exit(1)
 	}
 
-	if *outputPath != "" && !strings.HasSuffix(*outputPath, ".go") {
-		log.Print("output path must be a Go source file name")
-		flag.Usage()
+	diags := []*generator.Diagnostic{}
+	var warn func(*generator.Diagnostic)
+	if opts.json {
+		warn = func(d *generator.Diagnostic) { diags = append(diags, d) }
+	}
+	outputPath, src, err := generate(opts, "", warn)
+	if opts.json {
+		diags = append(diags, diagnostics(err)...)
+		if err := json.NewEncoder(os.Stdout).Encode(diags); err != nil {
+			log.Fatalf("error writing diagnostics: %s", err)
+		}
+	} else if err != nil {
+		for _, d := range diagnostics(err) {
+			log.Print(d)
+		}
+	}
+	if src == nil {
 		os.Exit(1)
 	}
 
-	packageDirectory := "."
-	if *dirName != "" {
-		packageDirectory = *dirName
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		log.Fatalf("error writing output: %s", err)
 	}
 
-	g, err := LoadPackageDir(packageDirectory)
+	if err := ioutil.WriteFile(outputPath, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+
+	out, err := filepath.Abs(outputPath)
 	if err != nil {
-		log.Fatal(err)
+		out = outputPath
 	}
+	log.Printf("wrote %s\n", out)
+}
 
-	g.PackageOverride = *outputPackage
+// generate runs a generation with the given options, resolving relative
+// paths against the given base directory, or the current directory if
+// empty.  Problems that don't prevent generation are passed to warn, or
+// logged if nil.  It returns the path of the output file and its contents,
+// which may be returned along with an error when the generated code is
+// invalid.
+func generate(opts *options, base string, warn func(*generator.Diagnostic)) (string, []byte, error) {
+	include, err := compileOptionalRegexp(opts.includeRegexp)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid -include pattern: %s", err)
+	}
+	exclude, err := compileOptionalRegexp(opts.excludeRegexp)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid -exclude pattern: %s", err)
+	}
 
-	src, err := g.Generate(flag.Args())
+	source, interfaceNames, err := splitInterfaceNames(opts.sourcePath, opts.interfaceNames)
 	if err != nil {
-		log.Print(err)
+		return "", nil, err
 	}
-	if src == nil {
-		os.Exit(1)
+
+	packageDirectory := resolvePath(base, ".")
+	if opts.dirName != "" {
+		packageDirectory = resolvePath(base, opts.dirName)
 	}
 
-	if *outputPath == "" {
-		*outputPath = "charlatan.go"
+	outputPath := resolvePath(base, "charlatan.go")
+	if opts.outputPath != "" {
+		outputPath = resolvePath(base, opts.outputPath)
 	}
 
-	if err := os.MkdirAll(filepath.Dir(*outputPath), 0755); err != nil {
-		log.Fatalf("error writing output: %s", err)
+	// N.B. - the directory the command was run in cannot be found from an
+	// output path leaving it, so it is recorded too
+	var headerDir string
+	if opts.outputPath != "" && !filepath.IsAbs(opts.outputPath) && strings.HasPrefix(filepath.ToSlash(filepath.Clean(opts.outputPath)), "../") {
+		dir, err := filepath.Abs(resolvePath(base, "."))
+		if err != nil {
+			return "", nil, err
+		}
+		output, err := filepath.Abs(filepath.Dir(outputPath))
+		if err != nil {
+			return "", nil, err
+		}
+		rel, err := filepath.Rel(output, dir)
+		if err != nil {
+			return "", nil, err
+		}
+		headerDir = quoteArgument(filepath.ToSlash(rel))
 	}
 
-	if err := ioutil.WriteFile(*outputPath, src, 0644); err != nil {
-		log.Fatalf("error writing output: %s", err)
+	src, err := generator.Generate(context.Background(), generator.Options{
+		Dir:            packageDirectory,
+		Source:         source,
+		Tests:          opts.tests,
+		InterfaceNames: interfaceNames,
+		All:            opts.all,
+		Include:        include,
+		Exclude:        exclude,
+		PackageName:    opts.outputPackage,
+		OutputDir:      filepath.Dir(outputPath),
+		Header:         opts.commandLine,
+		HeaderDir:      headerDir,
+		Warn:           warn,
+	})
+
+	return outputPath, src, err
+}
+
+// diagnostics returns the problems that made a generation fail with the
+// given error, which are only positioned when found in the input package
+func diagnostics(err error) []*generator.Diagnostic {
+	if err == nil {
+		return nil
 	}
 
-	out, err := filepath.Abs(*outputPath)
-	if err != nil {
-		out = *outputPath
+	var diags generator.Diagnostics
+	if errors.As(err, &diags) {
+		return diags
 	}
-	log.Printf("wrote %s\n", out)
+	return []*generator.Diagnostic{{Message: err.Error(), Blocking: true}}
+}
+
+// resolvePath returns the path relative to the given base directory, if not absolute
+func resolvePath(base, path string) string {
+	if base == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(base, path)
+}
+
+// quoteArgument quotes a command line argument if it would otherwise be split or misread
+func quoteArgument(arg string) string {
+	if arg == "" || strings.ContainsAny(arg, " \t\n\"\\") {
+		return strconv.Quote(arg)
+	}
+	return arg
+}
+
+// splitInterfaceNames separates the import path from interface names
+// given as "<import path>.<interface>".  All qualified names must refer
+// to the same package, which must agree with the -source flag if set.
+func splitInterfaceNames(source string, args []string) (string, []string, error) {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		dot := strings.LastIndex(arg, ".")
+		if dot < 0 {
+			names[i] = arg
+			continue
+		}
+
+		path := arg[:dot]
+		if source != "" && source != path {
+			return "", nil, fmt.Errorf("interface %q is not in package %q", arg, source)
+		}
+		source = path
+		names[i] = arg[dot+1:]
+	}
+
+	return source, names, nil
+}
+
+// compileOptionalRegexp compiles the given pattern, an empty pattern results in nil
+func compileOptionalRegexp(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile(pattern)
 }
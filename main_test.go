@@ -0,0 +1,45 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/percolate/charlatan/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitInterfaceNames(t *testing.T) {
+	source, names, err := splitInterfaceNames("", []string{"net/http.RoundTripper", "Handler"})
+	assert.Nil(t, err)
+	assert.Equal(t, "net/http", source)
+	assert.Equal(t, []string{"RoundTripper", "Handler"}, names)
+
+	source, names, err = splitInterfaceNames("io", []string{"ReadCloser"})
+	assert.Nil(t, err)
+	assert.Equal(t, "io", source)
+	assert.Equal(t, []string{"ReadCloser"}, names)
+
+	_, _, err = splitInterfaceNames("io", []string{"net/http.RoundTripper"})
+	assert.NotNil(t, err)
+}
+
+func TestParseOptionsJSON(t *testing.T) {
+	opts := new(options)
+	flags := newFlagSet(opts, flag.ContinueOnError)
+	err := parseOptions(flags, opts, []string{"-json", "-output=fakes.go", "Store"})
+	assert.Nil(t, err)
+	assert.True(t, opts.json)
+	assert.Equal(t, "charlatan -output=fakes.go Store", opts.commandLine)
+}
+
+func TestDiagnostics(t *testing.T) {
+	assert.Nil(t, diagnostics(nil))
+
+	diags := generator.Diagnostics{{Interface: "Store", Message: "interface not found", Blocking: true}}
+	assert.Equal(t, []*generator.Diagnostic(diags), diagnostics(fmt.Errorf("error: %w", diags)))
+
+	got := diagnostics(errors.New("error: no valid interface names provided"))
+	assert.Equal(t, []*generator.Diagnostic{{Message: "error: no valid interface names provided", Blocking: true}}, got)
+}
@@ -2,20 +2,110 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // ArrayArrayParameterInvocation represents a single call of FakeArray.ArrayParameter
 type ArrayArrayParameterInvocation struct {
 	Parameters struct {
 		Ident1 [3]string
 	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetArrayParameterInvocation
+	Matchers struct {
+		Ident1 ArrayMatcher[[3]string]
+	}
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ArrayArrayParameterInvocation) String() string {
+	return fmt.Sprintf("FakeArray.ArrayParameter%+v", i.Parameters)
+}
+
+// ArrayArrayParameterExpectation is a call of FakeArray.ArrayParameter expected by a test, created by FakeArray.ExpectArrayParameter
+type ArrayArrayParameterExpectation struct {
+	fake     *FakeArray
+	t        ArrayTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ArrayMatcher[[3]string]
+	}
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ArrayArrayParameterExpectation) With(ident1 ArrayMatcher[[3]string]) *ArrayArrayParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ArrayArrayParameterExpectation) matches(ident1 [3]string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ArrayArrayParameterExpectation) Times(n int) *ArrayArrayParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // ArrayArrayReturnInvocation represents a single call of FakeArray.ArrayReturn
 type ArrayArrayReturnInvocation struct {
-	Results struct {
-		Ident1 [3]string
-	}
+	Results ArrayArrayReturnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ArrayArrayReturnInvocation) String() string {
+	return "FakeArray.ArrayReturn()"
+}
+
+// ArrayArrayReturnResults holds the results of a single call of FakeArray.ArrayReturn
+type ArrayArrayReturnResults struct {
+	Ident1 [3]string
+}
+
+// ArrayArrayReturnExpectation is a call of FakeArray.ArrayReturn expected by a test, created by FakeArray.ExpectArrayReturn
+type ArrayArrayReturnExpectation struct {
+	fake    *FakeArray
+	t       ArrayTestingT
+	times   int
+	count   int
+	returns bool
+	results ArrayArrayReturnResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ArrayArrayReturnExpectation) Times(n int) *ArrayArrayReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ArrayArrayReturnExpectation) Return(ident1 [3]string) *ArrayArrayReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ArrayArrayReturnResults{Ident1: ident1}
+	return e
 }
 
 // ArraySliceParameterInvocation represents a single call of FakeArray.SliceParameter
@@ -23,23 +113,167 @@ type ArraySliceParameterInvocation struct {
 	Parameters struct {
 		Ident1 []string
 	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetSliceParameterInvocation
+	Matchers struct {
+		Ident1 ArrayMatcher[[]string]
+	}
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ArraySliceParameterInvocation) String() string {
+	return fmt.Sprintf("FakeArray.SliceParameter%+v", i.Parameters)
+}
+
+// ArraySliceParameterExpectation is a call of FakeArray.SliceParameter expected by a test, created by FakeArray.ExpectSliceParameter
+type ArraySliceParameterExpectation struct {
+	fake     *FakeArray
+	t        ArrayTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ArrayMatcher[[]string]
+	}
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ArraySliceParameterExpectation) With(ident1 ArrayMatcher[[]string]) *ArraySliceParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ArraySliceParameterExpectation) matches(ident1 []string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ArraySliceParameterExpectation) Times(n int) *ArraySliceParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // ArraySliceReturnInvocation represents a single call of FakeArray.SliceReturn
 type ArraySliceReturnInvocation struct {
-	Results struct {
-		Ident1 []string
-	}
+	Results ArraySliceReturnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ArraySliceReturnInvocation) String() string {
+	return "FakeArray.SliceReturn()"
+}
+
+// ArraySliceReturnResults holds the results of a single call of FakeArray.SliceReturn
+type ArraySliceReturnResults struct {
+	Ident1 []string
+}
+
+// ArraySliceReturnExpectation is a call of FakeArray.SliceReturn expected by a test, created by FakeArray.ExpectSliceReturn
+type ArraySliceReturnExpectation struct {
+	fake    *FakeArray
+	t       ArrayTestingT
+	times   int
+	count   int
+	returns bool
+	results ArraySliceReturnResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ArraySliceReturnExpectation) Times(n int) *ArraySliceReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ArraySliceReturnExpectation) Return(ident1 []string) *ArraySliceReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ArraySliceReturnResults{Ident1: ident1}
+	return e
 }
 
 // ArrayTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type ArrayTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type ArrayTestingT = fake.TestingT
+
+// ArrayMatcher matches a parameter of a call to FakeArray
+type ArrayMatcher[T any] interface {
+	Match(T) bool
+}
+
+// ArrayMatcherFunc is a ArrayMatcher implemented by a predicate function
+type ArrayMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m ArrayMatcherFunc[T]) Match(v T) bool {
+	return m(v)
 }
 
+// ArrayAny returns a ArrayMatcher that matches any value
+func ArrayAny[T any]() ArrayMatcher[T] {
+	return fake.Any[T]()
+}
+
+// ArrayEq returns a ArrayMatcher that matches values deeply equal to want
+func ArrayEq[T any](want T) ArrayMatcher[T] {
+	return fake.Eq(want)
+}
+
+// ArrayNot returns a ArrayMatcher that matches the values the given matcher does not
+func ArrayNot[T any](m ArrayMatcher[T]) ArrayMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// ArrayPred returns a ArrayMatcher that matches values for which the given predicate returns true
+func ArrayPred[T any](pred func(T) bool) ArrayMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// ArrayAnyOfType returns a ArrayMatcher that matches values whose dynamic type is, or implements, U
+func ArrayAnyOfType[T, U any]() ArrayMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// ArrayCallMatcher selects calls of a method of a fake for ArrayInOrder and ArrayUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeArray
+type ArrayCallMatcher = fake.CallMatcher
+
+// ArrayInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func ArrayInOrder(t ArrayTestingT, calls ...ArrayCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// ArrayUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func ArrayUnordered(t ArrayTestingT, calls ...ArrayCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// ArrayExhausted selects how a method of FakeArray behaves once the results given to its SetXReturnsSequence method are used up
+type ArrayExhausted = fake.Exhausted
+
+const (
+	// ArrayRepeatLast returns the last results in the sequence from all later calls
+	ArrayRepeatLast = fake.RepeatLast
+	// ArrayPanicAfterSequence panics on all later calls
+	ArrayPanicAfterSequence = fake.PanicAfterSequence
+	// ArrayHookAfterSequence calls the method's hook on all later calls
+	ArrayHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeArray is a mock implementation of Array for testing.
 Use it in your tests as in this example:
@@ -56,13 +290,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeArrayParameter ...
+		// assert state of FakeArray ...
 		f.AssertArrayParameterCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeArrayParameter.
+unexpected calls are made to FakeArray.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeArray struct {
 	ArrayParameterHook func([3]string)
@@ -74,6 +312,16 @@ type FakeArray struct {
 	ArrayReturnCalls    []*ArrayArrayReturnInvocation
 	SliceParameterCalls []*ArraySliceParameterInvocation
 	SliceReturnCalls    []*ArraySliceReturnInvocation
+
+	returnsArrayReturn         fake.Returns[ArrayArrayReturnResults]
+	returnsSliceReturn         fake.Returns[ArraySliceReturnResults]
+	expectationsArrayParameter []*ArrayArrayParameterExpectation
+	expectationsArrayReturn    []*ArrayArrayReturnExpectation
+	expectationsSliceParameter []*ArraySliceParameterExpectation
+	expectationsSliceReturn    []*ArraySliceReturnExpectation
+	mutex                      sync.Mutex
+	recorded                   chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters             bool
 }
 
 // NewFakeArrayDefaultPanic returns an instance of FakeArray with all hooks configured to panic
@@ -95,79 +343,264 @@ func NewFakeArrayDefaultPanic() *FakeArray {
 }
 
 // NewFakeArrayDefaultFatal returns an instance of FakeArray with all hooks configured to call t.Fatal
-func NewFakeArrayDefaultFatal(t_sym1 ArrayTestingT) *FakeArray {
+func NewFakeArrayDefaultFatal(t ArrayTestingT) *FakeArray {
 	return &FakeArray{
 		ArrayParameterHook: func([3]string) {
-			t_sym1.Fatal("Unexpected call to Array.ArrayParameter")
+			t.Fatal("Unexpected call to Array.ArrayParameter")
 			return
 		},
 		ArrayReturnHook: func() (ident1 [3]string) {
-			t_sym1.Fatal("Unexpected call to Array.ArrayReturn")
+			t.Fatal("Unexpected call to Array.ArrayReturn")
 			return
 		},
 		SliceParameterHook: func([]string) {
-			t_sym1.Fatal("Unexpected call to Array.SliceParameter")
+			t.Fatal("Unexpected call to Array.SliceParameter")
 			return
 		},
 		SliceReturnHook: func() (ident1 []string) {
-			t_sym1.Fatal("Unexpected call to Array.SliceReturn")
+			t.Fatal("Unexpected call to Array.SliceReturn")
 			return
 		},
 	}
 }
 
 // NewFakeArrayDefaultError returns an instance of FakeArray with all hooks configured to call t.Error
-func NewFakeArrayDefaultError(t_sym2 ArrayTestingT) *FakeArray {
+func NewFakeArrayDefaultError(t ArrayTestingT) *FakeArray {
 	return &FakeArray{
 		ArrayParameterHook: func([3]string) {
-			t_sym2.Error("Unexpected call to Array.ArrayParameter")
+			t.Error("Unexpected call to Array.ArrayParameter")
 			return
 		},
 		ArrayReturnHook: func() (ident1 [3]string) {
-			t_sym2.Error("Unexpected call to Array.ArrayReturn")
+			t.Error("Unexpected call to Array.ArrayReturn")
 			return
 		},
 		SliceParameterHook: func([]string) {
-			t_sym2.Error("Unexpected call to Array.SliceParameter")
+			t.Error("Unexpected call to Array.SliceParameter")
 			return
 		},
 		SliceReturnHook: func() (ident1 []string) {
-			t_sym2.Error("Unexpected call to Array.SliceReturn")
+			t.Error("Unexpected call to Array.SliceReturn")
 			return
 		},
 	}
 }
 
+// NewFakeArrayDefaultZero returns an instance of FakeArray with all hooks configured to return zero values
+func NewFakeArrayDefaultZero() *FakeArray {
+	return &FakeArray{
+		ArrayParameterHook: func([3]string) {
+			return
+		},
+		ArrayReturnHook: func() (ident1 [3]string) {
+			return
+		},
+		SliceParameterHook: func([]string) {
+			return
+		},
+		SliceReturnHook: func() (ident1 []string) {
+			return
+		},
+	}
+}
+
+// NewFakeArrayDefaultFriendly returns an instance of FakeArray with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeArray are friendly fakes, one for each method and result, created on first use
+func NewFakeArrayDefaultFriendly() *FakeArray {
+
+	return &FakeArray{
+		ArrayParameterHook: func([3]string) {
+			return
+		},
+		ArrayReturnHook: func() (ident1 [3]string) {
+			return
+		},
+		SliceParameterHook: func([]string) {
+			return
+		},
+		SliceReturnHook: func() (ident1 []string) {
+			ident1 = make([]string, 0)
+			return
+		},
+	}
+}
+
+// NewFakeArraySpy returns an instance of FakeArray with all hooks configured to call the given implementation
+func NewFakeArraySpy(real Array) *FakeArray {
+	return &FakeArray{
+		ArrayParameterHook: real.ArrayParameter,
+		ArrayReturnHook:    real.ArrayReturn,
+		SliceParameterHook: real.SliceParameter,
+		SliceReturnHook:    real.SliceReturn,
+	}
+}
+
+// Reset forgets all calls made to FakeArray
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeArray) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.ArrayParameterCalls = []*ArrayArrayParameterInvocation{}
+	f.returnsArrayReturn.Rebase(len(f.ArrayReturnCalls))
 	f.ArrayReturnCalls = []*ArrayArrayReturnInvocation{}
 	f.SliceParameterCalls = []*ArraySliceParameterInvocation{}
+	f.returnsSliceReturn.Rebase(len(f.SliceReturnCalls))
 	f.SliceReturnCalls = []*ArraySliceReturnInvocation{}
 }
 
-func (f_sym3 *FakeArray) ArrayParameter(ident1 [3]string) {
-	if f_sym3.ArrayParameterHook == nil {
+// SetCopyParameters configures FakeArray to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeArray) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeArray) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeArray) ArrayParameter(ident1 [3]string) {
+	f.mutex.Lock()
+	hook := f.ArrayParameterHook
+	expectation, t := f.expectedArrayParameter(ident1)
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Array.ArrayParameter() called but FakeArray.ArrayParameterHook is nil")
 	}
 
-	invocation_sym3 := new(ArrayArrayParameterInvocation)
-	f_sym3.ArrayParameterCalls = append(f_sym3.ArrayParameterCalls, invocation_sym3)
+	invocation := new(ArrayArrayParameterInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ArrayParameterCalls = append(f.ArrayParameterCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeArray.ArrayParameter called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	f_sym3.ArrayParameterHook(ident1)
+	if hook != nil {
+		hook(ident1)
+	}
 
 	return
 }
 
+// expectedArrayParameter returns the first unsatisfied expectation of FakeArray.ArrayParameter matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeArray) expectedArrayParameter(ident1 [3]string) (*ArrayArrayParameterExpectation, ArrayTestingT) {
+	if len(f.expectationsArrayParameter) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsArrayParameter {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsArrayParameter[0].t
+}
+
+// ExpectArrayParameter expects calls of FakeArray.ArrayParameter, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeArray) ExpectArrayParameter(t ArrayTestingT) *ArrayArrayParameterExpectation {
+	t.Helper()
+	expectation := &ArrayArrayParameterExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsArrayParameter = append(f.expectationsArrayParameter, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeArray.ArrayParameter called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetArrayParameterHook configures Array.ArrayParameter to call the given function
+func (f *FakeArray) SetArrayParameterHook(hook func([3]string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ArrayParameterHook = hook
+}
+
+// ArrayParameterCallsSnapshot returns a copy of the calls made to FakeArray.ArrayParameter
+func (f *FakeArray) ArrayParameterCallsSnapshot() []*ArrayArrayParameterInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ArrayArrayParameterInvocation, len(f.ArrayParameterCalls))
+	for i, call := range f.ArrayParameterCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// ArrayParameterCall returns a ArrayCallMatcher selecting the calls of FakeArray.ArrayParameter with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) ArrayParameterCall(ident1 ArrayMatcher[[3]string]) ArrayCallMatcher {
+	return fake.NewCallMatcher("FakeArray.ArrayParameter(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ArrayArrayParameterInvocation(nil), f.ArrayParameterCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
 // ArrayParameterCalled returns true if FakeArray.ArrayParameter was called
 func (f *FakeArray) ArrayParameterCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayParameterCalls) != 0
 }
 
 // AssertArrayParameterCalled calls t.Error if FakeArray.ArrayParameter was not called
 func (f *FakeArray) AssertArrayParameterCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayParameterCalls) == 0 {
 		t.Error("FakeArray.ArrayParameter not called, expected at least one")
 	}
@@ -175,12 +608,16 @@ func (f *FakeArray) AssertArrayParameterCalled(t ArrayTestingT) {
 
 // ArrayParameterNotCalled returns true if FakeArray.ArrayParameter was not called
 func (f *FakeArray) ArrayParameterNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayParameterCalls) == 0
 }
 
 // AssertArrayParameterNotCalled calls t.Error if FakeArray.ArrayParameter was called
 func (f *FakeArray) AssertArrayParameterNotCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayParameterCalls) != 0 {
 		t.Error("FakeArray.ArrayParameter called, expected none")
 	}
@@ -188,12 +625,16 @@ func (f *FakeArray) AssertArrayParameterNotCalled(t ArrayTestingT) {
 
 // ArrayParameterCalledOnce returns true if FakeArray.ArrayParameter was called exactly once
 func (f *FakeArray) ArrayParameterCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayParameterCalls) == 1
 }
 
 // AssertArrayParameterCalledOnce calls t.Error if FakeArray.ArrayParameter was not called exactly once
 func (f *FakeArray) AssertArrayParameterCalledOnce(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayParameterCalls) != 1 {
 		t.Errorf("FakeArray.ArrayParameter called %d times, expected 1", len(f.ArrayParameterCalls))
 	}
@@ -201,21 +642,92 @@ func (f *FakeArray) AssertArrayParameterCalledOnce(t ArrayTestingT) {
 
 // ArrayParameterCalledN returns true if FakeArray.ArrayParameter was called at least n times
 func (f *FakeArray) ArrayParameterCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayParameterCalls) >= n
 }
 
 // AssertArrayParameterCalledN calls t.Error if FakeArray.ArrayParameter was called less than n times
 func (f *FakeArray) AssertArrayParameterCalledN(t ArrayTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayParameterCalls) < n {
 		t.Errorf("FakeArray.ArrayParameter called %d times, expected >= %d", len(f.ArrayParameterCalls), n)
 	}
 }
 
-// ArrayParameterCalledWith returns true if FakeArray.ArrayParameter was called with the given values
-func (f_sym4 *FakeArray) ArrayParameterCalledWith(ident1 [3]string) bool {
-	for _, call_sym4 := range f_sym4.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.Ident1, ident1) {
+// WaitForArrayParameterCalled blocks until FakeArray.ArrayParameter has been called, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForArrayParameterCalled(ctx context.Context) error {
+	return f.WaitForArrayParameterCalledN(ctx, 1)
+}
+
+// WaitForArrayParameterCalledN blocks until FakeArray.ArrayParameter has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForArrayParameterCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ArrayParameterCalls) >= n
+	})
+}
+
+// AssertArrayParameterEventuallyCalled calls t.Error if FakeArray.ArrayParameter is not called within the timeout
+func (f *FakeArray) AssertArrayParameterEventuallyCalled(t ArrayTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForArrayParameterCalled(ctx) != nil {
+		t.Errorf("FakeArray.ArrayParameter not called within %v", timeout)
+	}
+}
+
+// describeArrayParameterCalls describes the calls of FakeArray.ArrayParameter against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeArray) describeArrayParameterCalls(ident1 ArrayMatcher[[3]string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ArrayParameterCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ArrayParameterCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ArrayParameterCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ArrayParameterCalledWith returns true if FakeArray.ArrayParameter was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) ArrayParameterCalledWith(ident1 ArrayMatcher[[3]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ArrayParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -223,79 +735,232 @@ func (f_sym4 *FakeArray) ArrayParameterCalledWith(ident1 [3]string) bool {
 	return false
 }
 
-// AssertArrayParameterCalledWith calls t.Error if FakeArray.ArrayParameter was not called with the given values
-func (f_sym5 *FakeArray) AssertArrayParameterCalledWith(t ArrayTestingT, ident1 [3]string) {
+// AssertArrayParameterCalledWith calls t.Error if FakeArray.ArrayParameter was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) AssertArrayParameterCalledWith(t ArrayTestingT, ident1 ArrayMatcher[[3]string]) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-			found_sym5 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ArrayParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym5 {
-		t.Error("FakeArray.ArrayParameter not called with expected parameters")
+	if !found {
+		t.Errorf("FakeArray.ArrayParameter not called with expected parameters\n%s", f.describeArrayParameterCalls(ident1))
 	}
 }
 
-// ArrayParameterCalledOnceWith returns true if FakeArray.ArrayParameter was called exactly once with the given values
-func (f_sym6 *FakeArray) ArrayParameterCalledOnceWith(ident1 [3]string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
-			count_sym6++
+// ArrayParameterCalledOnceWith returns true if FakeArray.ArrayParameter was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) ArrayParameterCalledOnceWith(ident1 ArrayMatcher[[3]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ArrayParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym6 == 1
+	return count == 1
 }
 
-// AssertArrayParameterCalledOnceWith calls t.Error if FakeArray.ArrayParameter was not called exactly once with the given values
-func (f_sym7 *FakeArray) AssertArrayParameterCalledOnceWith(t ArrayTestingT, ident1 [3]string) {
+// AssertArrayParameterCalledOnceWith calls t.Error if FakeArray.ArrayParameter was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) AssertArrayParameterCalledOnceWith(t ArrayTestingT, ident1 ArrayMatcher[[3]string]) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.ArrayParameterCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			count_sym7++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ArrayParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeArray.ArrayParameter called %d times with expected parameters, expected one", count_sym7)
+	if count != 1 {
+		t.Errorf("FakeArray.ArrayParameter called %d times with expected parameters, expected one\n%s", count, f.describeArrayParameterCalls(ident1))
+	}
+}
+
+// AssertArrayParameterEventuallyCalledWith calls t.Error if FakeArray.ArrayParameter is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeArray) AssertArrayParameterEventuallyCalledWith(t ArrayTestingT, timeout time.Duration, ident1 ArrayMatcher[[3]string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ArrayParameterCalls)
+		for _, call := range f.ArrayParameterCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeArray.ArrayParameter not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeArrayParameterCalls(ident1))
 	}
 }
 
-func (f_sym8 *FakeArray) ArrayReturn() (ident1 [3]string) {
-	if f_sym8.ArrayReturnHook == nil {
+func (f *FakeArray) ArrayReturn() (ident1 [3]string) {
+	f.mutex.Lock()
+	hook := f.ArrayReturnHook
+	expectation, t := f.expectedArrayReturn()
+	var results ArrayArrayReturnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsArrayReturn.Lookup(len(f.ArrayReturnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Array.ArrayReturn() called after the results given to FakeArray.SetArrayReturnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Array.ArrayReturn() called but FakeArray.ArrayReturnHook is nil")
 	}
 
-	invocation_sym8 := new(ArrayArrayReturnInvocation)
-	f_sym8.ArrayReturnCalls = append(f_sym8.ArrayReturnCalls, invocation_sym8)
+	invocation := new(ArrayArrayReturnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ArrayReturnCalls = append(f.ArrayReturnCalls, invocation)
 
-	ident1 = f_sym8.ArrayReturnHook()
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeArray.ArrayReturn called more times than expected")
+	}
+
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
 
-	invocation_sym8.Results.Ident1 = ident1
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedArrayReturn returns the first unsatisfied expectation of FakeArray.ArrayReturn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeArray) expectedArrayReturn() (*ArrayArrayReturnExpectation, ArrayTestingT) {
+	if len(f.expectationsArrayReturn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsArrayReturn {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsArrayReturn[0].t
+}
+
+// ExpectArrayReturn expects calls of FakeArray.ArrayReturn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeArray) ExpectArrayReturn(t ArrayTestingT) *ArrayArrayReturnExpectation {
+	t.Helper()
+	expectation := &ArrayArrayReturnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsArrayReturn = append(f.expectationsArrayReturn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeArray.ArrayReturn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetArrayReturnHook configures Array.ArrayReturn to call the given function
+func (f *FakeArray) SetArrayReturnHook(hook func() [3]string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ArrayReturnHook = hook
+}
+
 // SetArrayReturnStub configures Array.ArrayReturn to always return the given values
-func (f_sym9 *FakeArray) SetArrayReturnStub(ident1 [3]string) {
-	f_sym9.ArrayReturnHook = func() [3]string {
+func (f *FakeArray) SetArrayReturnStub(ident1 [3]string) {
+	f.SetArrayReturnHook(func() [3]string {
 		return ident1
+	})
+}
+
+// SetArrayReturnReturnsOnCall configures Array.ArrayReturn to return the given values from the call with the given index in ArrayReturnCalls, rather than calling the hook
+func (f *FakeArray) SetArrayReturnReturnsOnCall(call int, ident1 [3]string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsArrayReturn.Set(call, ArrayArrayReturnResults{Ident1: ident1})
+}
+
+// SetArrayReturnReturnsSequence configures the following calls of Array.ArrayReturn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeArray) SetArrayReturnReturnsSequence(exhausted ArrayExhausted, results ...ArrayArrayReturnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsArrayReturn.Sequence(len(f.ArrayReturnCalls), exhausted, results)
+}
+
+// ArrayReturnCallsSnapshot returns a copy of the calls made to FakeArray.ArrayReturn
+func (f *FakeArray) ArrayReturnCallsSnapshot() []*ArrayArrayReturnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ArrayArrayReturnInvocation, len(f.ArrayReturnCalls))
+	for i, call := range f.ArrayReturnCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ArrayReturnCall returns a ArrayCallMatcher selecting the calls of FakeArray.ArrayReturn
+func (f *FakeArray) ArrayReturnCall() ArrayCallMatcher {
+	return fake.NewCallMatcher("FakeArray.ArrayReturn()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ArrayArrayReturnInvocation(nil), f.ArrayReturnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // ArrayReturnCalled returns true if FakeArray.ArrayReturn was called
 func (f *FakeArray) ArrayReturnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayReturnCalls) != 0
 }
 
 // AssertArrayReturnCalled calls t.Error if FakeArray.ArrayReturn was not called
 func (f *FakeArray) AssertArrayReturnCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayReturnCalls) == 0 {
 		t.Error("FakeArray.ArrayReturn not called, expected at least one")
 	}
@@ -303,12 +968,16 @@ func (f *FakeArray) AssertArrayReturnCalled(t ArrayTestingT) {
 
 // ArrayReturnNotCalled returns true if FakeArray.ArrayReturn was not called
 func (f *FakeArray) ArrayReturnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayReturnCalls) == 0
 }
 
 // AssertArrayReturnNotCalled calls t.Error if FakeArray.ArrayReturn was called
 func (f *FakeArray) AssertArrayReturnNotCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayReturnCalls) != 0 {
 		t.Error("FakeArray.ArrayReturn called, expected none")
 	}
@@ -316,12 +985,16 @@ func (f *FakeArray) AssertArrayReturnNotCalled(t ArrayTestingT) {
 
 // ArrayReturnCalledOnce returns true if FakeArray.ArrayReturn was called exactly once
 func (f *FakeArray) ArrayReturnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayReturnCalls) == 1
 }
 
 // AssertArrayReturnCalledOnce calls t.Error if FakeArray.ArrayReturn was not called exactly once
 func (f *FakeArray) AssertArrayReturnCalledOnce(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayReturnCalls) != 1 {
 		t.Errorf("FakeArray.ArrayReturn called %d times, expected 1", len(f.ArrayReturnCalls))
 	}
@@ -329,40 +1002,166 @@ func (f *FakeArray) AssertArrayReturnCalledOnce(t ArrayTestingT) {
 
 // ArrayReturnCalledN returns true if FakeArray.ArrayReturn was called at least n times
 func (f *FakeArray) ArrayReturnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ArrayReturnCalls) >= n
 }
 
 // AssertArrayReturnCalledN calls t.Error if FakeArray.ArrayReturn was called less than n times
 func (f *FakeArray) AssertArrayReturnCalledN(t ArrayTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ArrayReturnCalls) < n {
 		t.Errorf("FakeArray.ArrayReturn called %d times, expected >= %d", len(f.ArrayReturnCalls), n)
 	}
 }
 
-func (f_sym10 *FakeArray) SliceParameter(ident1 []string) {
-	if f_sym10.SliceParameterHook == nil {
+// WaitForArrayReturnCalled blocks until FakeArray.ArrayReturn has been called, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForArrayReturnCalled(ctx context.Context) error {
+	return f.WaitForArrayReturnCalledN(ctx, 1)
+}
+
+// WaitForArrayReturnCalledN blocks until FakeArray.ArrayReturn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForArrayReturnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ArrayReturnCalls) >= n
+	})
+}
+
+// AssertArrayReturnEventuallyCalled calls t.Error if FakeArray.ArrayReturn is not called within the timeout
+func (f *FakeArray) AssertArrayReturnEventuallyCalled(t ArrayTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForArrayReturnCalled(ctx) != nil {
+		t.Errorf("FakeArray.ArrayReturn not called within %v", timeout)
+	}
+}
+
+func (f *FakeArray) SliceParameter(ident1 []string) {
+	f.mutex.Lock()
+	hook := f.SliceParameterHook
+	expectation, t := f.expectedSliceParameter(ident1)
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Array.SliceParameter() called but FakeArray.SliceParameterHook is nil")
 	}
 
-	invocation_sym10 := new(ArraySliceParameterInvocation)
-	f_sym10.SliceParameterCalls = append(f_sym10.SliceParameterCalls, invocation_sym10)
+	invocation := new(ArraySliceParameterInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.SliceParameterCalls = append(f.SliceParameterCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym10.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeArray.SliceParameter called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	f_sym10.SliceParameterHook(ident1)
+	if hook != nil {
+		hook(ident1)
+	}
 
 	return
 }
 
+// expectedSliceParameter returns the first unsatisfied expectation of FakeArray.SliceParameter matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeArray) expectedSliceParameter(ident1 []string) (*ArraySliceParameterExpectation, ArrayTestingT) {
+	if len(f.expectationsSliceParameter) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsSliceParameter {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsSliceParameter[0].t
+}
+
+// ExpectSliceParameter expects calls of FakeArray.SliceParameter, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeArray) ExpectSliceParameter(t ArrayTestingT) *ArraySliceParameterExpectation {
+	t.Helper()
+	expectation := &ArraySliceParameterExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsSliceParameter = append(f.expectationsSliceParameter, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeArray.SliceParameter called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetSliceParameterHook configures Array.SliceParameter to call the given function
+func (f *FakeArray) SetSliceParameterHook(hook func([]string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.SliceParameterHook = hook
+}
+
+// SliceParameterCallsSnapshot returns a copy of the calls made to FakeArray.SliceParameter
+func (f *FakeArray) SliceParameterCallsSnapshot() []*ArraySliceParameterInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ArraySliceParameterInvocation, len(f.SliceParameterCalls))
+	for i, call := range f.SliceParameterCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// SliceParameterCall returns a ArrayCallMatcher selecting the calls of FakeArray.SliceParameter with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) SliceParameterCall(ident1 ArrayMatcher[[]string]) ArrayCallMatcher {
+	return fake.NewCallMatcher("FakeArray.SliceParameter(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ArraySliceParameterInvocation(nil), f.SliceParameterCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
 // SliceParameterCalled returns true if FakeArray.SliceParameter was called
 func (f *FakeArray) SliceParameterCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceParameterCalls) != 0
 }
 
 // AssertSliceParameterCalled calls t.Error if FakeArray.SliceParameter was not called
 func (f *FakeArray) AssertSliceParameterCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceParameterCalls) == 0 {
 		t.Error("FakeArray.SliceParameter not called, expected at least one")
 	}
@@ -370,12 +1169,16 @@ func (f *FakeArray) AssertSliceParameterCalled(t ArrayTestingT) {
 
 // SliceParameterNotCalled returns true if FakeArray.SliceParameter was not called
 func (f *FakeArray) SliceParameterNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceParameterCalls) == 0
 }
 
 // AssertSliceParameterNotCalled calls t.Error if FakeArray.SliceParameter was called
 func (f *FakeArray) AssertSliceParameterNotCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceParameterCalls) != 0 {
 		t.Error("FakeArray.SliceParameter called, expected none")
 	}
@@ -383,12 +1186,16 @@ func (f *FakeArray) AssertSliceParameterNotCalled(t ArrayTestingT) {
 
 // SliceParameterCalledOnce returns true if FakeArray.SliceParameter was called exactly once
 func (f *FakeArray) SliceParameterCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceParameterCalls) == 1
 }
 
 // AssertSliceParameterCalledOnce calls t.Error if FakeArray.SliceParameter was not called exactly once
 func (f *FakeArray) AssertSliceParameterCalledOnce(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceParameterCalls) != 1 {
 		t.Errorf("FakeArray.SliceParameter called %d times, expected 1", len(f.SliceParameterCalls))
 	}
@@ -396,21 +1203,92 @@ func (f *FakeArray) AssertSliceParameterCalledOnce(t ArrayTestingT) {
 
 // SliceParameterCalledN returns true if FakeArray.SliceParameter was called at least n times
 func (f *FakeArray) SliceParameterCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceParameterCalls) >= n
 }
 
 // AssertSliceParameterCalledN calls t.Error if FakeArray.SliceParameter was called less than n times
 func (f *FakeArray) AssertSliceParameterCalledN(t ArrayTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceParameterCalls) < n {
 		t.Errorf("FakeArray.SliceParameter called %d times, expected >= %d", len(f.SliceParameterCalls), n)
 	}
 }
 
-// SliceParameterCalledWith returns true if FakeArray.SliceParameter was called with the given values
-func (f_sym11 *FakeArray) SliceParameterCalledWith(ident1 []string) bool {
-	for _, call_sym11 := range f_sym11.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
+// WaitForSliceParameterCalled blocks until FakeArray.SliceParameter has been called, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForSliceParameterCalled(ctx context.Context) error {
+	return f.WaitForSliceParameterCalledN(ctx, 1)
+}
+
+// WaitForSliceParameterCalledN blocks until FakeArray.SliceParameter has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForSliceParameterCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.SliceParameterCalls) >= n
+	})
+}
+
+// AssertSliceParameterEventuallyCalled calls t.Error if FakeArray.SliceParameter is not called within the timeout
+func (f *FakeArray) AssertSliceParameterEventuallyCalled(t ArrayTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForSliceParameterCalled(ctx) != nil {
+		t.Errorf("FakeArray.SliceParameter not called within %v", timeout)
+	}
+}
+
+// describeSliceParameterCalls describes the calls of FakeArray.SliceParameter against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeArray) describeSliceParameterCalls(ident1 ArrayMatcher[[]string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.SliceParameterCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.SliceParameterCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.SliceParameterCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// SliceParameterCalledWith returns true if FakeArray.SliceParameter was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) SliceParameterCalledWith(ident1 ArrayMatcher[[]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.SliceParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -418,79 +1296,232 @@ func (f_sym11 *FakeArray) SliceParameterCalledWith(ident1 []string) bool {
 	return false
 }
 
-// AssertSliceParameterCalledWith calls t.Error if FakeArray.SliceParameter was not called with the given values
-func (f_sym12 *FakeArray) AssertSliceParameterCalledWith(t ArrayTestingT, ident1 []string) {
+// AssertSliceParameterCalledWith calls t.Error if FakeArray.SliceParameter was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) AssertSliceParameterCalledWith(t ArrayTestingT, ident1 ArrayMatcher[[]string]) {
 	t.Helper()
-	var found_sym12 bool
-	for _, call_sym12 := range f_sym12.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
-			found_sym12 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.SliceParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym12 {
-		t.Error("FakeArray.SliceParameter not called with expected parameters")
+	if !found {
+		t.Errorf("FakeArray.SliceParameter not called with expected parameters\n%s", f.describeSliceParameterCalls(ident1))
 	}
 }
 
-// SliceParameterCalledOnceWith returns true if FakeArray.SliceParameter was called exactly once with the given values
-func (f_sym13 *FakeArray) SliceParameterCalledOnceWith(ident1 []string) bool {
-	var count_sym13 int
-	for _, call_sym13 := range f_sym13.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym13.Parameters.Ident1, ident1) {
-			count_sym13++
+// SliceParameterCalledOnceWith returns true if FakeArray.SliceParameter was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) SliceParameterCalledOnceWith(ident1 ArrayMatcher[[]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.SliceParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym13 == 1
+	return count == 1
 }
 
-// AssertSliceParameterCalledOnceWith calls t.Error if FakeArray.SliceParameter was not called exactly once with the given values
-func (f_sym14 *FakeArray) AssertSliceParameterCalledOnceWith(t ArrayTestingT, ident1 []string) {
+// AssertSliceParameterCalledOnceWith calls t.Error if FakeArray.SliceParameter was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeArray) AssertSliceParameterCalledOnceWith(t ArrayTestingT, ident1 ArrayMatcher[[]string]) {
 	t.Helper()
-	var count_sym14 int
-	for _, call_sym14 := range f_sym14.SliceParameterCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.Ident1, ident1) {
-			count_sym14++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.SliceParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym14 != 1 {
-		t.Errorf("FakeArray.SliceParameter called %d times with expected parameters, expected one", count_sym14)
+	if count != 1 {
+		t.Errorf("FakeArray.SliceParameter called %d times with expected parameters, expected one\n%s", count, f.describeSliceParameterCalls(ident1))
+	}
+}
+
+// AssertSliceParameterEventuallyCalledWith calls t.Error if FakeArray.SliceParameter is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeArray) AssertSliceParameterEventuallyCalledWith(t ArrayTestingT, timeout time.Duration, ident1 ArrayMatcher[[]string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.SliceParameterCalls)
+		for _, call := range f.SliceParameterCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeArray.SliceParameter not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeSliceParameterCalls(ident1))
 	}
 }
 
-func (f_sym15 *FakeArray) SliceReturn() (ident1 []string) {
-	if f_sym15.SliceReturnHook == nil {
+func (f *FakeArray) SliceReturn() (ident1 []string) {
+	f.mutex.Lock()
+	hook := f.SliceReturnHook
+	expectation, t := f.expectedSliceReturn()
+	var results ArraySliceReturnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsSliceReturn.Lookup(len(f.SliceReturnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Array.SliceReturn() called after the results given to FakeArray.SetSliceReturnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Array.SliceReturn() called but FakeArray.SliceReturnHook is nil")
 	}
 
-	invocation_sym15 := new(ArraySliceReturnInvocation)
-	f_sym15.SliceReturnCalls = append(f_sym15.SliceReturnCalls, invocation_sym15)
+	invocation := new(ArraySliceReturnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.SliceReturnCalls = append(f.SliceReturnCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeArray.SliceReturn called more times than expected")
+	}
 
-	ident1 = f_sym15.SliceReturnHook()
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
 
-	invocation_sym15.Results.Ident1 = ident1
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedSliceReturn returns the first unsatisfied expectation of FakeArray.SliceReturn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeArray) expectedSliceReturn() (*ArraySliceReturnExpectation, ArrayTestingT) {
+	if len(f.expectationsSliceReturn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsSliceReturn {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsSliceReturn[0].t
+}
+
+// ExpectSliceReturn expects calls of FakeArray.SliceReturn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeArray) ExpectSliceReturn(t ArrayTestingT) *ArraySliceReturnExpectation {
+	t.Helper()
+	expectation := &ArraySliceReturnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsSliceReturn = append(f.expectationsSliceReturn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeArray.SliceReturn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetSliceReturnHook configures Array.SliceReturn to call the given function
+func (f *FakeArray) SetSliceReturnHook(hook func() []string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.SliceReturnHook = hook
+}
+
 // SetSliceReturnStub configures Array.SliceReturn to always return the given values
-func (f_sym16 *FakeArray) SetSliceReturnStub(ident1 []string) {
-	f_sym16.SliceReturnHook = func() []string {
+func (f *FakeArray) SetSliceReturnStub(ident1 []string) {
+	f.SetSliceReturnHook(func() []string {
 		return ident1
+	})
+}
+
+// SetSliceReturnReturnsOnCall configures Array.SliceReturn to return the given values from the call with the given index in SliceReturnCalls, rather than calling the hook
+func (f *FakeArray) SetSliceReturnReturnsOnCall(call int, ident1 []string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsSliceReturn.Set(call, ArraySliceReturnResults{Ident1: ident1})
+}
+
+// SetSliceReturnReturnsSequence configures the following calls of Array.SliceReturn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeArray) SetSliceReturnReturnsSequence(exhausted ArrayExhausted, results ...ArraySliceReturnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsSliceReturn.Sequence(len(f.SliceReturnCalls), exhausted, results)
+}
+
+// SliceReturnCallsSnapshot returns a copy of the calls made to FakeArray.SliceReturn
+func (f *FakeArray) SliceReturnCallsSnapshot() []*ArraySliceReturnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ArraySliceReturnInvocation, len(f.SliceReturnCalls))
+	for i, call := range f.SliceReturnCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// SliceReturnCall returns a ArrayCallMatcher selecting the calls of FakeArray.SliceReturn
+func (f *FakeArray) SliceReturnCall() ArrayCallMatcher {
+	return fake.NewCallMatcher("FakeArray.SliceReturn()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ArraySliceReturnInvocation(nil), f.SliceReturnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // SliceReturnCalled returns true if FakeArray.SliceReturn was called
 func (f *FakeArray) SliceReturnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceReturnCalls) != 0
 }
 
 // AssertSliceReturnCalled calls t.Error if FakeArray.SliceReturn was not called
 func (f *FakeArray) AssertSliceReturnCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceReturnCalls) == 0 {
 		t.Error("FakeArray.SliceReturn not called, expected at least one")
 	}
@@ -498,12 +1529,16 @@ func (f *FakeArray) AssertSliceReturnCalled(t ArrayTestingT) {
 
 // SliceReturnNotCalled returns true if FakeArray.SliceReturn was not called
 func (f *FakeArray) SliceReturnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceReturnCalls) == 0
 }
 
 // AssertSliceReturnNotCalled calls t.Error if FakeArray.SliceReturn was called
 func (f *FakeArray) AssertSliceReturnNotCalled(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceReturnCalls) != 0 {
 		t.Error("FakeArray.SliceReturn called, expected none")
 	}
@@ -511,12 +1546,16 @@ func (f *FakeArray) AssertSliceReturnNotCalled(t ArrayTestingT) {
 
 // SliceReturnCalledOnce returns true if FakeArray.SliceReturn was called exactly once
 func (f *FakeArray) SliceReturnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceReturnCalls) == 1
 }
 
 // AssertSliceReturnCalledOnce calls t.Error if FakeArray.SliceReturn was not called exactly once
 func (f *FakeArray) AssertSliceReturnCalledOnce(t ArrayTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceReturnCalls) != 1 {
 		t.Errorf("FakeArray.SliceReturn called %d times, expected 1", len(f.SliceReturnCalls))
 	}
@@ -524,13 +1563,39 @@ func (f *FakeArray) AssertSliceReturnCalledOnce(t ArrayTestingT) {
 
 // SliceReturnCalledN returns true if FakeArray.SliceReturn was called at least n times
 func (f *FakeArray) SliceReturnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SliceReturnCalls) >= n
 }
 
 // AssertSliceReturnCalledN calls t.Error if FakeArray.SliceReturn was called less than n times
 func (f *FakeArray) AssertSliceReturnCalledN(t ArrayTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SliceReturnCalls) < n {
 		t.Errorf("FakeArray.SliceReturn called %d times, expected >= %d", len(f.SliceReturnCalls), n)
 	}
 }
+
+// WaitForSliceReturnCalled blocks until FakeArray.SliceReturn has been called, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForSliceReturnCalled(ctx context.Context) error {
+	return f.WaitForSliceReturnCalledN(ctx, 1)
+}
+
+// WaitForSliceReturnCalledN blocks until FakeArray.SliceReturn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeArray) WaitForSliceReturnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.SliceReturnCalls) >= n
+	})
+}
+
+// AssertSliceReturnEventuallyCalled calls t.Error if FakeArray.SliceReturn is not called within the timeout
+func (f *FakeArray) AssertSliceReturnEventuallyCalled(t ArrayTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForSliceReturnCalled(ctx) != nil {
+		t.Errorf("FakeArray.SliceReturn not called within %v", timeout)
+	}
+}
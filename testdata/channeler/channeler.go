@@ -2,16 +2,39 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // ChannelerChannelInvocation represents a single call of FakeChanneler.Channel
 type ChannelerChannelInvocation struct {
 	Parameters struct {
 		Ident1 chan int
 	}
-	Results struct {
-		Ident2 chan int
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetChannelInvocation
+	Matchers struct {
+		Ident1 ChannelerMatcher[chan int]
 	}
+	Results ChannelerChannelResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ChannelerChannelInvocation) String() string {
+	return fmt.Sprintf("FakeChanneler.Channel%+v", i.Parameters)
+}
+
+// ChannelerChannelResults holds the results of a single call of FakeChanneler.Channel
+type ChannelerChannelResults struct {
+	Ident2 chan int
 }
 
 // NewChannelerChannelInvocation creates a new instance of ChannelerChannelInvocation
@@ -25,14 +48,71 @@ func NewChannelerChannelInvocation(ident1 chan int, ident2 chan int) *ChannelerC
 	return invocation
 }
 
+// ChannelerChannelExpectation is a call of FakeChanneler.Channel expected by a test, created by FakeChanneler.ExpectChannel
+type ChannelerChannelExpectation struct {
+	fake     *FakeChanneler
+	t        ChannelerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ChannelerMatcher[chan int]
+	}
+	returns bool
+	results ChannelerChannelResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ChannelerChannelExpectation) With(ident1 ChannelerMatcher[chan int]) *ChannelerChannelExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ChannelerChannelExpectation) matches(ident1 chan int) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ChannelerChannelExpectation) Times(n int) *ChannelerChannelExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ChannelerChannelExpectation) Return(ident2 chan int) *ChannelerChannelExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ChannelerChannelResults{Ident2: ident2}
+	return e
+}
+
 // ChannelerChannelReceiveInvocation represents a single call of FakeChanneler.ChannelReceive
 type ChannelerChannelReceiveInvocation struct {
 	Parameters struct {
 		Ident1 <-chan int
 	}
-	Results struct {
-		Ident2 <-chan int
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetChannelReceiveInvocation
+	Matchers struct {
+		Ident1 ChannelerMatcher[<-chan int]
 	}
+	Results ChannelerChannelReceiveResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ChannelerChannelReceiveInvocation) String() string {
+	return fmt.Sprintf("FakeChanneler.ChannelReceive%+v", i.Parameters)
+}
+
+// ChannelerChannelReceiveResults holds the results of a single call of FakeChanneler.ChannelReceive
+type ChannelerChannelReceiveResults struct {
+	Ident2 <-chan int
 }
 
 // NewChannelerChannelReceiveInvocation creates a new instance of ChannelerChannelReceiveInvocation
@@ -46,14 +126,71 @@ func NewChannelerChannelReceiveInvocation(ident1 <-chan int, ident2 <-chan int)
 	return invocation
 }
 
+// ChannelerChannelReceiveExpectation is a call of FakeChanneler.ChannelReceive expected by a test, created by FakeChanneler.ExpectChannelReceive
+type ChannelerChannelReceiveExpectation struct {
+	fake     *FakeChanneler
+	t        ChannelerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ChannelerMatcher[<-chan int]
+	}
+	returns bool
+	results ChannelerChannelReceiveResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ChannelerChannelReceiveExpectation) With(ident1 ChannelerMatcher[<-chan int]) *ChannelerChannelReceiveExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ChannelerChannelReceiveExpectation) matches(ident1 <-chan int) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ChannelerChannelReceiveExpectation) Times(n int) *ChannelerChannelReceiveExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ChannelerChannelReceiveExpectation) Return(ident2 <-chan int) *ChannelerChannelReceiveExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ChannelerChannelReceiveResults{Ident2: ident2}
+	return e
+}
+
 // ChannelerChannelSendInvocation represents a single call of FakeChanneler.ChannelSend
 type ChannelerChannelSendInvocation struct {
 	Parameters struct {
 		Ident1 chan<- int
 	}
-	Results struct {
-		Ident2 chan<- int
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetChannelSendInvocation
+	Matchers struct {
+		Ident1 ChannelerMatcher[chan<- int]
 	}
+	Results ChannelerChannelSendResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ChannelerChannelSendInvocation) String() string {
+	return fmt.Sprintf("FakeChanneler.ChannelSend%+v", i.Parameters)
+}
+
+// ChannelerChannelSendResults holds the results of a single call of FakeChanneler.ChannelSend
+type ChannelerChannelSendResults struct {
+	Ident2 chan<- int
 }
 
 // NewChannelerChannelSendInvocation creates a new instance of ChannelerChannelSendInvocation
@@ -67,14 +204,71 @@ func NewChannelerChannelSendInvocation(ident1 chan<- int, ident2 chan<- int) *Ch
 	return invocation
 }
 
+// ChannelerChannelSendExpectation is a call of FakeChanneler.ChannelSend expected by a test, created by FakeChanneler.ExpectChannelSend
+type ChannelerChannelSendExpectation struct {
+	fake     *FakeChanneler
+	t        ChannelerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ChannelerMatcher[chan<- int]
+	}
+	returns bool
+	results ChannelerChannelSendResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ChannelerChannelSendExpectation) With(ident1 ChannelerMatcher[chan<- int]) *ChannelerChannelSendExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ChannelerChannelSendExpectation) matches(ident1 chan<- int) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ChannelerChannelSendExpectation) Times(n int) *ChannelerChannelSendExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ChannelerChannelSendExpectation) Return(ident2 chan<- int) *ChannelerChannelSendExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ChannelerChannelSendResults{Ident2: ident2}
+	return e
+}
+
 // ChannelerChannelPointerInvocation represents a single call of FakeChanneler.ChannelPointer
 type ChannelerChannelPointerInvocation struct {
 	Parameters struct {
 		Ident1 *chan int
 	}
-	Results struct {
-		Ident2 *chan int
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetChannelPointerInvocation
+	Matchers struct {
+		Ident1 ChannelerMatcher[*chan int]
 	}
+	Results ChannelerChannelPointerResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ChannelerChannelPointerInvocation) String() string {
+	return fmt.Sprintf("FakeChanneler.ChannelPointer%+v", i.Parameters)
+}
+
+// ChannelerChannelPointerResults holds the results of a single call of FakeChanneler.ChannelPointer
+type ChannelerChannelPointerResults struct {
+	Ident2 *chan int
 }
 
 // NewChannelerChannelPointerInvocation creates a new instance of ChannelerChannelPointerInvocation
@@ -88,14 +282,71 @@ func NewChannelerChannelPointerInvocation(ident1 *chan int, ident2 *chan int) *C
 	return invocation
 }
 
+// ChannelerChannelPointerExpectation is a call of FakeChanneler.ChannelPointer expected by a test, created by FakeChanneler.ExpectChannelPointer
+type ChannelerChannelPointerExpectation struct {
+	fake     *FakeChanneler
+	t        ChannelerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ChannelerMatcher[*chan int]
+	}
+	returns bool
+	results ChannelerChannelPointerResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ChannelerChannelPointerExpectation) With(ident1 ChannelerMatcher[*chan int]) *ChannelerChannelPointerExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ChannelerChannelPointerExpectation) matches(ident1 *chan int) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ChannelerChannelPointerExpectation) Times(n int) *ChannelerChannelPointerExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ChannelerChannelPointerExpectation) Return(ident2 *chan int) *ChannelerChannelPointerExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ChannelerChannelPointerResults{Ident2: ident2}
+	return e
+}
+
 // ChannelerChannelInterfaceInvocation represents a single call of FakeChanneler.ChannelInterface
 type ChannelerChannelInterfaceInvocation struct {
 	Parameters struct {
 		Ident1 chan interface{}
 	}
-	Results struct {
-		Ident2 chan interface{}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetChannelInterfaceInvocation
+	Matchers struct {
+		Ident1 ChannelerMatcher[chan interface{}]
 	}
+	Results ChannelerChannelInterfaceResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ChannelerChannelInterfaceInvocation) String() string {
+	return fmt.Sprintf("FakeChanneler.ChannelInterface%+v", i.Parameters)
+}
+
+// ChannelerChannelInterfaceResults holds the results of a single call of FakeChanneler.ChannelInterface
+type ChannelerChannelInterfaceResults struct {
+	Ident2 chan interface{}
 }
 
 // NewChannelerChannelInterfaceInvocation creates a new instance of ChannelerChannelInterfaceInvocation
@@ -109,14 +360,120 @@ func NewChannelerChannelInterfaceInvocation(ident1 chan interface{}, ident2 chan
 	return invocation
 }
 
+// ChannelerChannelInterfaceExpectation is a call of FakeChanneler.ChannelInterface expected by a test, created by FakeChanneler.ExpectChannelInterface
+type ChannelerChannelInterfaceExpectation struct {
+	fake     *FakeChanneler
+	t        ChannelerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ChannelerMatcher[chan interface{}]
+	}
+	returns bool
+	results ChannelerChannelInterfaceResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ChannelerChannelInterfaceExpectation) With(ident1 ChannelerMatcher[chan interface{}]) *ChannelerChannelInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ChannelerChannelInterfaceExpectation) matches(ident1 chan interface{}) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ChannelerChannelInterfaceExpectation) Times(n int) *ChannelerChannelInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ChannelerChannelInterfaceExpectation) Return(ident2 chan interface{}) *ChannelerChannelInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ChannelerChannelInterfaceResults{Ident2: ident2}
+	return e
+}
+
 // ChannelerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type ChannelerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type ChannelerTestingT = fake.TestingT
+
+// ChannelerMatcher matches a parameter of a call to FakeChanneler
+type ChannelerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// ChannelerMatcherFunc is a ChannelerMatcher implemented by a predicate function
+type ChannelerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m ChannelerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// ChannelerAny returns a ChannelerMatcher that matches any value
+func ChannelerAny[T any]() ChannelerMatcher[T] {
+	return fake.Any[T]()
+}
+
+// ChannelerEq returns a ChannelerMatcher that matches values deeply equal to want
+func ChannelerEq[T any](want T) ChannelerMatcher[T] {
+	return fake.Eq(want)
+}
+
+// ChannelerNot returns a ChannelerMatcher that matches the values the given matcher does not
+func ChannelerNot[T any](m ChannelerMatcher[T]) ChannelerMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// ChannelerPred returns a ChannelerMatcher that matches values for which the given predicate returns true
+func ChannelerPred[T any](pred func(T) bool) ChannelerMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// ChannelerAnyOfType returns a ChannelerMatcher that matches values whose dynamic type is, or implements, U
+func ChannelerAnyOfType[T, U any]() ChannelerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// ChannelerCallMatcher selects calls of a method of a fake for ChannelerInOrder and ChannelerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeChanneler
+type ChannelerCallMatcher = fake.CallMatcher
+
+// ChannelerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func ChannelerInOrder(t ChannelerTestingT, calls ...ChannelerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// ChannelerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func ChannelerUnordered(t ChannelerTestingT, calls ...ChannelerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
 }
 
+// ChannelerExhausted selects how a method of FakeChanneler behaves once the results given to its SetXReturnsSequence method are used up
+type ChannelerExhausted = fake.Exhausted
+
+const (
+	// ChannelerRepeatLast returns the last results in the sequence from all later calls
+	ChannelerRepeatLast = fake.RepeatLast
+	// ChannelerPanicAfterSequence panics on all later calls
+	ChannelerPanicAfterSequence = fake.PanicAfterSequence
+	// ChannelerHookAfterSequence calls the method's hook on all later calls
+	ChannelerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeChanneler is a mock implementation of Channeler for testing.
 Use it in your tests as in this example:
@@ -133,13 +490,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeChannel ...
+		// assert state of FakeChanneler ...
 		f.AssertChannelCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeChannel.
+unexpected calls are made to FakeChanneler.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeChanneler struct {
 	ChannelHook          func(chan int) chan int
@@ -153,6 +514,20 @@ type FakeChanneler struct {
 	ChannelSendCalls      []*ChannelerChannelSendInvocation
 	ChannelPointerCalls   []*ChannelerChannelPointerInvocation
 	ChannelInterfaceCalls []*ChannelerChannelInterfaceInvocation
+
+	returnsChannel               fake.Returns[ChannelerChannelResults]
+	returnsChannelReceive        fake.Returns[ChannelerChannelReceiveResults]
+	returnsChannelSend           fake.Returns[ChannelerChannelSendResults]
+	returnsChannelPointer        fake.Returns[ChannelerChannelPointerResults]
+	returnsChannelInterface      fake.Returns[ChannelerChannelInterfaceResults]
+	expectationsChannel          []*ChannelerChannelExpectation
+	expectationsChannelReceive   []*ChannelerChannelReceiveExpectation
+	expectationsChannelSend      []*ChannelerChannelSendExpectation
+	expectationsChannelPointer   []*ChannelerChannelPointerExpectation
+	expectationsChannelInterface []*ChannelerChannelInterfaceExpectation
+	mutex                        sync.Mutex
+	recorded                     chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters               bool
 }
 
 // NewFakeChannelerDefaultPanic returns an instance of FakeChanneler with all hooks configured to panic
@@ -177,113 +552,338 @@ func NewFakeChannelerDefaultPanic() *FakeChanneler {
 }
 
 // NewFakeChannelerDefaultFatal returns an instance of FakeChanneler with all hooks configured to call t.Fatal
-func NewFakeChannelerDefaultFatal(t_sym1 ChannelerTestingT) *FakeChanneler {
+func NewFakeChannelerDefaultFatal(t ChannelerTestingT) *FakeChanneler {
 	return &FakeChanneler{
 		ChannelHook: func(chan int) (ident2 chan int) {
-			t_sym1.Fatal("Unexpected call to Channeler.Channel")
+			t.Fatal("Unexpected call to Channeler.Channel")
 			return
 		},
 		ChannelReceiveHook: func(<-chan int) (ident2 <-chan int) {
-			t_sym1.Fatal("Unexpected call to Channeler.ChannelReceive")
+			t.Fatal("Unexpected call to Channeler.ChannelReceive")
 			return
 		},
 		ChannelSendHook: func(chan<- int) (ident2 chan<- int) {
-			t_sym1.Fatal("Unexpected call to Channeler.ChannelSend")
+			t.Fatal("Unexpected call to Channeler.ChannelSend")
 			return
 		},
 		ChannelPointerHook: func(*chan int) (ident2 *chan int) {
-			t_sym1.Fatal("Unexpected call to Channeler.ChannelPointer")
+			t.Fatal("Unexpected call to Channeler.ChannelPointer")
 			return
 		},
 		ChannelInterfaceHook: func(chan interface{}) (ident2 chan interface{}) {
-			t_sym1.Fatal("Unexpected call to Channeler.ChannelInterface")
+			t.Fatal("Unexpected call to Channeler.ChannelInterface")
 			return
 		},
 	}
 }
 
 // NewFakeChannelerDefaultError returns an instance of FakeChanneler with all hooks configured to call t.Error
-func NewFakeChannelerDefaultError(t_sym2 ChannelerTestingT) *FakeChanneler {
+func NewFakeChannelerDefaultError(t ChannelerTestingT) *FakeChanneler {
+	return &FakeChanneler{
+		ChannelHook: func(chan int) (ident2 chan int) {
+			t.Error("Unexpected call to Channeler.Channel")
+			return
+		},
+		ChannelReceiveHook: func(<-chan int) (ident2 <-chan int) {
+			t.Error("Unexpected call to Channeler.ChannelReceive")
+			return
+		},
+		ChannelSendHook: func(chan<- int) (ident2 chan<- int) {
+			t.Error("Unexpected call to Channeler.ChannelSend")
+			return
+		},
+		ChannelPointerHook: func(*chan int) (ident2 *chan int) {
+			t.Error("Unexpected call to Channeler.ChannelPointer")
+			return
+		},
+		ChannelInterfaceHook: func(chan interface{}) (ident2 chan interface{}) {
+			t.Error("Unexpected call to Channeler.ChannelInterface")
+			return
+		},
+	}
+}
+
+// NewFakeChannelerDefaultZero returns an instance of FakeChanneler with all hooks configured to return zero values
+func NewFakeChannelerDefaultZero() *FakeChanneler {
 	return &FakeChanneler{
 		ChannelHook: func(chan int) (ident2 chan int) {
-			t_sym2.Error("Unexpected call to Channeler.Channel")
 			return
 		},
 		ChannelReceiveHook: func(<-chan int) (ident2 <-chan int) {
-			t_sym2.Error("Unexpected call to Channeler.ChannelReceive")
 			return
 		},
 		ChannelSendHook: func(chan<- int) (ident2 chan<- int) {
-			t_sym2.Error("Unexpected call to Channeler.ChannelSend")
 			return
 		},
 		ChannelPointerHook: func(*chan int) (ident2 *chan int) {
-			t_sym2.Error("Unexpected call to Channeler.ChannelPointer")
 			return
 		},
 		ChannelInterfaceHook: func(chan interface{}) (ident2 chan interface{}) {
-			t_sym2.Error("Unexpected call to Channeler.ChannelInterface")
 			return
 		},
 	}
 }
 
+// NewFakeChannelerDefaultFriendly returns an instance of FakeChanneler with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeChanneler are friendly fakes, one for each method and result, created on first use
+func NewFakeChannelerDefaultFriendly() *FakeChanneler {
+
+	return &FakeChanneler{
+		ChannelHook: func(chan int) (ident2 chan int) {
+			return
+		},
+		ChannelReceiveHook: func(<-chan int) (ident2 <-chan int) {
+			return
+		},
+		ChannelSendHook: func(chan<- int) (ident2 chan<- int) {
+			return
+		},
+		ChannelPointerHook: func(*chan int) (ident2 *chan int) {
+			return
+		},
+		ChannelInterfaceHook: func(chan interface{}) (ident2 chan interface{}) {
+			return
+		},
+	}
+}
+
+// NewFakeChannelerSpy returns an instance of FakeChanneler with all hooks configured to call the given implementation
+func NewFakeChannelerSpy(real Channeler) *FakeChanneler {
+	return &FakeChanneler{
+		ChannelHook:          real.Channel,
+		ChannelReceiveHook:   real.ChannelReceive,
+		ChannelSendHook:      real.ChannelSend,
+		ChannelPointerHook:   real.ChannelPointer,
+		ChannelInterfaceHook: real.ChannelInterface,
+	}
+}
+
+// Reset forgets all calls made to FakeChanneler
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeChanneler) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannel.Rebase(len(f.ChannelCalls))
 	f.ChannelCalls = []*ChannelerChannelInvocation{}
+	f.returnsChannelReceive.Rebase(len(f.ChannelReceiveCalls))
 	f.ChannelReceiveCalls = []*ChannelerChannelReceiveInvocation{}
+	f.returnsChannelSend.Rebase(len(f.ChannelSendCalls))
 	f.ChannelSendCalls = []*ChannelerChannelSendInvocation{}
+	f.returnsChannelPointer.Rebase(len(f.ChannelPointerCalls))
 	f.ChannelPointerCalls = []*ChannelerChannelPointerInvocation{}
+	f.returnsChannelInterface.Rebase(len(f.ChannelInterfaceCalls))
 	f.ChannelInterfaceCalls = []*ChannelerChannelInterfaceInvocation{}
 }
 
-func (f_sym3 *FakeChanneler) Channel(ident1 chan int) (ident2 chan int) {
-	if f_sym3.ChannelHook == nil {
+// SetCopyParameters configures FakeChanneler to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeChanneler) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeChanneler) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeChanneler) Channel(ident1 chan int) (ident2 chan int) {
+	f.mutex.Lock()
+	hook := f.ChannelHook
+	expectation, t := f.expectedChannel(ident1)
+	var results ChannelerChannelResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsChannel.Lookup(len(f.ChannelCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Channeler.Channel() called after the results given to FakeChanneler.SetChannelReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Channeler.Channel() called but FakeChanneler.ChannelHook is nil")
 	}
 
-	invocation_sym3 := new(ChannelerChannelInvocation)
-	f_sym3.ChannelCalls = append(f_sym3.ChannelCalls, invocation_sym3)
+	invocation := new(ChannelerChannelInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ChannelCalls = append(f.ChannelCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeChanneler.Channel called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ident2 = f_sym3.ChannelHook(ident1)
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym3.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedChannel returns the first unsatisfied expectation of FakeChanneler.Channel matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeChanneler) expectedChannel(ident1 chan int) (*ChannelerChannelExpectation, ChannelerTestingT) {
+	if len(f.expectationsChannel) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsChannel {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsChannel[0].t
+}
+
+// ExpectChannel expects calls of FakeChanneler.Channel, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeChanneler) ExpectChannel(t ChannelerTestingT) *ChannelerChannelExpectation {
+	t.Helper()
+	expectation := &ChannelerChannelExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsChannel = append(f.expectationsChannel, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeChanneler.Channel called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetChannelHook configures Channeler.Channel to call the given function
+func (f *FakeChanneler) SetChannelHook(hook func(chan int) chan int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ChannelHook = hook
+}
+
 // SetChannelStub configures Channeler.Channel to always return the given values
-func (f_sym4 *FakeChanneler) SetChannelStub(ident2 chan int) {
-	f_sym4.ChannelHook = func(chan int) chan int {
+func (f *FakeChanneler) SetChannelStub(ident2 chan int) {
+	f.SetChannelHook(func(chan int) chan int {
 		return ident2
-	}
+	})
+}
+
+// SetChannelReturnsOnCall configures Channeler.Channel to return the given values from the call with the given index in ChannelCalls, rather than calling the hook
+func (f *FakeChanneler) SetChannelReturnsOnCall(call int, ident2 chan int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannel.Set(call, ChannelerChannelResults{Ident2: ident2})
+}
+
+// SetChannelReturnsSequence configures the following calls of Channeler.Channel to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeChanneler) SetChannelReturnsSequence(exhausted ChannelerExhausted, results ...ChannelerChannelResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannel.Sequence(len(f.ChannelCalls), exhausted, results)
 }
 
 // SetChannelInvocation configures Channeler.Channel to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeChanneler) SetChannelInvocation(calls_sym5 []*ChannelerChannelInvocation, fallback_sym5 func() chan int) {
-	f_sym5.ChannelHook = func(ident1 chan int) (ident2 chan int) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f *FakeChanneler) SetChannelInvocation(calls []*ChannelerChannelInvocation, fallback func() chan int) {
+	f.SetChannelHook(func(ident1 chan int) (ident2 chan int) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// ChannelCallsSnapshot returns a copy of the calls made to FakeChanneler.Channel
+func (f *FakeChanneler) ChannelCallsSnapshot() []*ChannelerChannelInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ChannelerChannelInvocation, len(f.ChannelCalls))
+	for i, call := range f.ChannelCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ChannelCall returns a ChannelerCallMatcher selecting the calls of FakeChanneler.Channel with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelCall(ident1 ChannelerMatcher[chan int]) ChannelerCallMatcher {
+	return fake.NewCallMatcher("FakeChanneler.Channel(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ChannelerChannelInvocation(nil), f.ChannelCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ChannelCalled returns true if FakeChanneler.Channel was called
 func (f *FakeChanneler) ChannelCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelCalls) != 0
 }
 
 // AssertChannelCalled calls t.Error if FakeChanneler.Channel was not called
 func (f *FakeChanneler) AssertChannelCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelCalls) == 0 {
 		t.Error("FakeChanneler.Channel not called, expected at least one")
 	}
@@ -291,12 +891,16 @@ func (f *FakeChanneler) AssertChannelCalled(t ChannelerTestingT) {
 
 // ChannelNotCalled returns true if FakeChanneler.Channel was not called
 func (f *FakeChanneler) ChannelNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelCalls) == 0
 }
 
 // AssertChannelNotCalled calls t.Error if FakeChanneler.Channel was called
 func (f *FakeChanneler) AssertChannelNotCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelCalls) != 0 {
 		t.Error("FakeChanneler.Channel called, expected none")
 	}
@@ -304,12 +908,16 @@ func (f *FakeChanneler) AssertChannelNotCalled(t ChannelerTestingT) {
 
 // ChannelCalledOnce returns true if FakeChanneler.Channel was called exactly once
 func (f *FakeChanneler) ChannelCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelCalls) == 1
 }
 
 // AssertChannelCalledOnce calls t.Error if FakeChanneler.Channel was not called exactly once
 func (f *FakeChanneler) AssertChannelCalledOnce(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelCalls) != 1 {
 		t.Errorf("FakeChanneler.Channel called %d times, expected 1", len(f.ChannelCalls))
 	}
@@ -317,21 +925,92 @@ func (f *FakeChanneler) AssertChannelCalledOnce(t ChannelerTestingT) {
 
 // ChannelCalledN returns true if FakeChanneler.Channel was called at least n times
 func (f *FakeChanneler) ChannelCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelCalls) >= n
 }
 
 // AssertChannelCalledN calls t.Error if FakeChanneler.Channel was called less than n times
 func (f *FakeChanneler) AssertChannelCalledN(t ChannelerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelCalls) < n {
 		t.Errorf("FakeChanneler.Channel called %d times, expected >= %d", len(f.ChannelCalls), n)
 	}
 }
 
-// ChannelCalledWith returns true if FakeChanneler.Channel was called with the given values
-func (f_sym6 *FakeChanneler) ChannelCalledWith(ident1 chan int) bool {
-	for _, call_sym6 := range f_sym6.ChannelCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+// WaitForChannelCalled blocks until FakeChanneler.Channel has been called, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelCalled(ctx context.Context) error {
+	return f.WaitForChannelCalledN(ctx, 1)
+}
+
+// WaitForChannelCalledN blocks until FakeChanneler.Channel has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ChannelCalls) >= n
+	})
+}
+
+// AssertChannelEventuallyCalled calls t.Error if FakeChanneler.Channel is not called within the timeout
+func (f *FakeChanneler) AssertChannelEventuallyCalled(t ChannelerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForChannelCalled(ctx) != nil {
+		t.Errorf("FakeChanneler.Channel not called within %v", timeout)
+	}
+}
+
+// describeChannelCalls describes the calls of FakeChanneler.Channel against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeChanneler) describeChannelCalls(ident1 ChannelerMatcher[chan int]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ChannelCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ChannelCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ChannelCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ChannelCalledWith returns true if FakeChanneler.Channel was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelCalledWith(ident1 ChannelerMatcher[chan int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -339,55 +1018,86 @@ func (f_sym6 *FakeChanneler) ChannelCalledWith(ident1 chan int) bool {
 	return false
 }
 
-// AssertChannelCalledWith calls t.Error if FakeChanneler.Channel was not called with the given values
-func (f_sym7 *FakeChanneler) AssertChannelCalledWith(t ChannelerTestingT, ident1 chan int) {
+// AssertChannelCalledWith calls t.Error if FakeChanneler.Channel was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelCalledWith(t ChannelerTestingT, ident1 ChannelerMatcher[chan int]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.ChannelCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ChannelCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeChanneler.Channel not called with expected parameters")
+	if !found {
+		t.Errorf("FakeChanneler.Channel not called with expected parameters\n%s", f.describeChannelCalls(ident1))
 	}
 }
 
-// ChannelCalledOnceWith returns true if FakeChanneler.Channel was called exactly once with the given values
-func (f_sym8 *FakeChanneler) ChannelCalledOnceWith(ident1 chan int) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.ChannelCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+// ChannelCalledOnceWith returns true if FakeChanneler.Channel was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelCalledOnceWith(ident1 ChannelerMatcher[chan int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertChannelCalledOnceWith calls t.Error if FakeChanneler.Channel was not called exactly once with the given values
-func (f_sym9 *FakeChanneler) AssertChannelCalledOnceWith(t ChannelerTestingT, ident1 chan int) {
+// AssertChannelCalledOnceWith calls t.Error if FakeChanneler.Channel was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelCalledOnceWith(t ChannelerTestingT, ident1 ChannelerMatcher[chan int]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.ChannelCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeChanneler.Channel called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakeChanneler.Channel called %d times with expected parameters, expected one\n%s", count, f.describeChannelCalls(ident1))
+	}
+}
+
+// AssertChannelEventuallyCalledWith calls t.Error if FakeChanneler.Channel is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeChanneler) AssertChannelEventuallyCalledWith(t ChannelerTestingT, timeout time.Duration, ident1 ChannelerMatcher[chan int]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ChannelCalls)
+		for _, call := range f.ChannelCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeChanneler.Channel not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeChannelCalls(ident1))
 	}
 }
 
-// ChannelResultsForCall returns the result values for the first call to FakeChanneler.Channel with the given values
-func (f_sym10 *FakeChanneler) ChannelResultsForCall(ident1 chan int) (ident2 chan int, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.ChannelCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+// ChannelResultsForCall returns the result values for the first call to FakeChanneler.Channel with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelResultsForCall(ident1 ChannelerMatcher[chan int]) (ident2 chan int, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -395,54 +1105,185 @@ func (f_sym10 *FakeChanneler) ChannelResultsForCall(ident1 chan int) (ident2 cha
 	return
 }
 
-func (f_sym11 *FakeChanneler) ChannelReceive(ident1 <-chan int) (ident2 <-chan int) {
-	if f_sym11.ChannelReceiveHook == nil {
+func (f *FakeChanneler) ChannelReceive(ident1 <-chan int) (ident2 <-chan int) {
+	f.mutex.Lock()
+	hook := f.ChannelReceiveHook
+	expectation, t := f.expectedChannelReceive(ident1)
+	var results ChannelerChannelReceiveResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsChannelReceive.Lookup(len(f.ChannelReceiveCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Channeler.ChannelReceive() called after the results given to FakeChanneler.SetChannelReceiveReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Channeler.ChannelReceive() called but FakeChanneler.ChannelReceiveHook is nil")
 	}
 
-	invocation_sym11 := new(ChannelerChannelReceiveInvocation)
-	f_sym11.ChannelReceiveCalls = append(f_sym11.ChannelReceiveCalls, invocation_sym11)
+	invocation := new(ChannelerChannelReceiveInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ChannelReceiveCalls = append(f.ChannelReceiveCalls, invocation)
 
-	invocation_sym11.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	ident2 = f_sym11.ChannelReceiveHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym11.Results.Ident2 = ident2
+	if t != nil && expectation == nil {
+		t.Errorf("FakeChanneler.ChannelReceive called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedChannelReceive returns the first unsatisfied expectation of FakeChanneler.ChannelReceive matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeChanneler) expectedChannelReceive(ident1 <-chan int) (*ChannelerChannelReceiveExpectation, ChannelerTestingT) {
+	if len(f.expectationsChannelReceive) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsChannelReceive {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsChannelReceive[0].t
+}
+
+// ExpectChannelReceive expects calls of FakeChanneler.ChannelReceive, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeChanneler) ExpectChannelReceive(t ChannelerTestingT) *ChannelerChannelReceiveExpectation {
+	t.Helper()
+	expectation := &ChannelerChannelReceiveExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsChannelReceive = append(f.expectationsChannelReceive, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeChanneler.ChannelReceive called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetChannelReceiveHook configures Channeler.ChannelReceive to call the given function
+func (f *FakeChanneler) SetChannelReceiveHook(hook func(<-chan int) <-chan int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ChannelReceiveHook = hook
+}
+
 // SetChannelReceiveStub configures Channeler.ChannelReceive to always return the given values
-func (f_sym12 *FakeChanneler) SetChannelReceiveStub(ident2 <-chan int) {
-	f_sym12.ChannelReceiveHook = func(<-chan int) <-chan int {
+func (f *FakeChanneler) SetChannelReceiveStub(ident2 <-chan int) {
+	f.SetChannelReceiveHook(func(<-chan int) <-chan int {
 		return ident2
-	}
+	})
+}
+
+// SetChannelReceiveReturnsOnCall configures Channeler.ChannelReceive to return the given values from the call with the given index in ChannelReceiveCalls, rather than calling the hook
+func (f *FakeChanneler) SetChannelReceiveReturnsOnCall(call int, ident2 <-chan int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelReceive.Set(call, ChannelerChannelReceiveResults{Ident2: ident2})
+}
+
+// SetChannelReceiveReturnsSequence configures the following calls of Channeler.ChannelReceive to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeChanneler) SetChannelReceiveReturnsSequence(exhausted ChannelerExhausted, results ...ChannelerChannelReceiveResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelReceive.Sequence(len(f.ChannelReceiveCalls), exhausted, results)
 }
 
 // SetChannelReceiveInvocation configures Channeler.ChannelReceive to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeChanneler) SetChannelReceiveInvocation(calls_sym13 []*ChannelerChannelReceiveInvocation, fallback_sym13 func() <-chan int) {
-	f_sym13.ChannelReceiveHook = func(ident1 <-chan int) (ident2 <-chan int) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.Ident1, ident1) {
-				ident2 = call_sym13.Results.Ident2
+func (f *FakeChanneler) SetChannelReceiveInvocation(calls []*ChannelerChannelReceiveInvocation, fallback func() <-chan int) {
+	f.SetChannelReceiveHook(func(ident1 <-chan int) (ident2 <-chan int) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback()
+	})
+}
+
+// ChannelReceiveCallsSnapshot returns a copy of the calls made to FakeChanneler.ChannelReceive
+func (f *FakeChanneler) ChannelReceiveCallsSnapshot() []*ChannelerChannelReceiveInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ChannelerChannelReceiveInvocation, len(f.ChannelReceiveCalls))
+	for i, call := range f.ChannelReceiveCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ChannelReceiveCall returns a ChannelerCallMatcher selecting the calls of FakeChanneler.ChannelReceive with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelReceiveCall(ident1 ChannelerMatcher[<-chan int]) ChannelerCallMatcher {
+	return fake.NewCallMatcher("FakeChanneler.ChannelReceive(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ChannelerChannelReceiveInvocation(nil), f.ChannelReceiveCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ChannelReceiveCalled returns true if FakeChanneler.ChannelReceive was called
 func (f *FakeChanneler) ChannelReceiveCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelReceiveCalls) != 0
 }
 
 // AssertChannelReceiveCalled calls t.Error if FakeChanneler.ChannelReceive was not called
 func (f *FakeChanneler) AssertChannelReceiveCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelReceiveCalls) == 0 {
 		t.Error("FakeChanneler.ChannelReceive not called, expected at least one")
 	}
@@ -450,12 +1291,16 @@ func (f *FakeChanneler) AssertChannelReceiveCalled(t ChannelerTestingT) {
 
 // ChannelReceiveNotCalled returns true if FakeChanneler.ChannelReceive was not called
 func (f *FakeChanneler) ChannelReceiveNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelReceiveCalls) == 0
 }
 
 // AssertChannelReceiveNotCalled calls t.Error if FakeChanneler.ChannelReceive was called
 func (f *FakeChanneler) AssertChannelReceiveNotCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelReceiveCalls) != 0 {
 		t.Error("FakeChanneler.ChannelReceive called, expected none")
 	}
@@ -463,12 +1308,16 @@ func (f *FakeChanneler) AssertChannelReceiveNotCalled(t ChannelerTestingT) {
 
 // ChannelReceiveCalledOnce returns true if FakeChanneler.ChannelReceive was called exactly once
 func (f *FakeChanneler) ChannelReceiveCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelReceiveCalls) == 1
 }
 
 // AssertChannelReceiveCalledOnce calls t.Error if FakeChanneler.ChannelReceive was not called exactly once
 func (f *FakeChanneler) AssertChannelReceiveCalledOnce(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelReceiveCalls) != 1 {
 		t.Errorf("FakeChanneler.ChannelReceive called %d times, expected 1", len(f.ChannelReceiveCalls))
 	}
@@ -476,21 +1325,92 @@ func (f *FakeChanneler) AssertChannelReceiveCalledOnce(t ChannelerTestingT) {
 
 // ChannelReceiveCalledN returns true if FakeChanneler.ChannelReceive was called at least n times
 func (f *FakeChanneler) ChannelReceiveCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelReceiveCalls) >= n
 }
 
 // AssertChannelReceiveCalledN calls t.Error if FakeChanneler.ChannelReceive was called less than n times
 func (f *FakeChanneler) AssertChannelReceiveCalledN(t ChannelerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelReceiveCalls) < n {
 		t.Errorf("FakeChanneler.ChannelReceive called %d times, expected >= %d", len(f.ChannelReceiveCalls), n)
 	}
 }
 
-// ChannelReceiveCalledWith returns true if FakeChanneler.ChannelReceive was called with the given values
-func (f_sym14 *FakeChanneler) ChannelReceiveCalledWith(ident1 <-chan int) bool {
-	for _, call_sym14 := range f_sym14.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.Ident1, ident1) {
+// WaitForChannelReceiveCalled blocks until FakeChanneler.ChannelReceive has been called, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelReceiveCalled(ctx context.Context) error {
+	return f.WaitForChannelReceiveCalledN(ctx, 1)
+}
+
+// WaitForChannelReceiveCalledN blocks until FakeChanneler.ChannelReceive has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelReceiveCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ChannelReceiveCalls) >= n
+	})
+}
+
+// AssertChannelReceiveEventuallyCalled calls t.Error if FakeChanneler.ChannelReceive is not called within the timeout
+func (f *FakeChanneler) AssertChannelReceiveEventuallyCalled(t ChannelerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForChannelReceiveCalled(ctx) != nil {
+		t.Errorf("FakeChanneler.ChannelReceive not called within %v", timeout)
+	}
+}
+
+// describeChannelReceiveCalls describes the calls of FakeChanneler.ChannelReceive against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeChanneler) describeChannelReceiveCalls(ident1 ChannelerMatcher[<-chan int]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ChannelReceiveCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ChannelReceiveCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ChannelReceiveCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ChannelReceiveCalledWith returns true if FakeChanneler.ChannelReceive was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelReceiveCalledWith(ident1 ChannelerMatcher[<-chan int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelReceiveCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -498,55 +1418,86 @@ func (f_sym14 *FakeChanneler) ChannelReceiveCalledWith(ident1 <-chan int) bool {
 	return false
 }
 
-// AssertChannelReceiveCalledWith calls t.Error if FakeChanneler.ChannelReceive was not called with the given values
-func (f_sym15 *FakeChanneler) AssertChannelReceiveCalledWith(t ChannelerTestingT, ident1 <-chan int) {
+// AssertChannelReceiveCalledWith calls t.Error if FakeChanneler.ChannelReceive was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelReceiveCalledWith(t ChannelerTestingT, ident1 ChannelerMatcher[<-chan int]) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.Ident1, ident1) {
-			found_sym15 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ChannelReceiveCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym15 {
-		t.Error("FakeChanneler.ChannelReceive not called with expected parameters")
+	if !found {
+		t.Errorf("FakeChanneler.ChannelReceive not called with expected parameters\n%s", f.describeChannelReceiveCalls(ident1))
 	}
 }
 
-// ChannelReceiveCalledOnceWith returns true if FakeChanneler.ChannelReceive was called exactly once with the given values
-func (f_sym16 *FakeChanneler) ChannelReceiveCalledOnceWith(ident1 <-chan int) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.Ident1, ident1) {
-			count_sym16++
+// ChannelReceiveCalledOnceWith returns true if FakeChanneler.ChannelReceive was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelReceiveCalledOnceWith(ident1 ChannelerMatcher[<-chan int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelReceiveCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym16 == 1
+	return count == 1
 }
 
-// AssertChannelReceiveCalledOnceWith calls t.Error if FakeChanneler.ChannelReceive was not called exactly once with the given values
-func (f_sym17 *FakeChanneler) AssertChannelReceiveCalledOnceWith(t ChannelerTestingT, ident1 <-chan int) {
+// AssertChannelReceiveCalledOnceWith calls t.Error if FakeChanneler.ChannelReceive was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelReceiveCalledOnceWith(t ChannelerTestingT, ident1 ChannelerMatcher[<-chan int]) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.Ident1, ident1) {
-			count_sym17++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelReceiveCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeChanneler.ChannelReceive called %d times with expected parameters, expected one", count_sym17)
+	if count != 1 {
+		t.Errorf("FakeChanneler.ChannelReceive called %d times with expected parameters, expected one\n%s", count, f.describeChannelReceiveCalls(ident1))
+	}
+}
+
+// AssertChannelReceiveEventuallyCalledWith calls t.Error if FakeChanneler.ChannelReceive is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeChanneler) AssertChannelReceiveEventuallyCalledWith(t ChannelerTestingT, timeout time.Duration, ident1 ChannelerMatcher[<-chan int]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ChannelReceiveCalls)
+		for _, call := range f.ChannelReceiveCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeChanneler.ChannelReceive not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeChannelReceiveCalls(ident1))
 	}
 }
 
-// ChannelReceiveResultsForCall returns the result values for the first call to FakeChanneler.ChannelReceive with the given values
-func (f_sym18 *FakeChanneler) ChannelReceiveResultsForCall(ident1 <-chan int) (ident2 <-chan int, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.ChannelReceiveCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.Ident1, ident1) {
-			ident2 = call_sym18.Results.Ident2
-			found_sym18 = true
+// ChannelReceiveResultsForCall returns the result values for the first call to FakeChanneler.ChannelReceive with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelReceiveResultsForCall(ident1 ChannelerMatcher[<-chan int]) (ident2 <-chan int, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelReceiveCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -554,54 +1505,185 @@ func (f_sym18 *FakeChanneler) ChannelReceiveResultsForCall(ident1 <-chan int) (i
 	return
 }
 
-func (f_sym19 *FakeChanneler) ChannelSend(ident1 chan<- int) (ident2 chan<- int) {
-	if f_sym19.ChannelSendHook == nil {
+func (f *FakeChanneler) ChannelSend(ident1 chan<- int) (ident2 chan<- int) {
+	f.mutex.Lock()
+	hook := f.ChannelSendHook
+	expectation, t := f.expectedChannelSend(ident1)
+	var results ChannelerChannelSendResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsChannelSend.Lookup(len(f.ChannelSendCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Channeler.ChannelSend() called after the results given to FakeChanneler.SetChannelSendReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Channeler.ChannelSend() called but FakeChanneler.ChannelSendHook is nil")
 	}
 
-	invocation_sym19 := new(ChannelerChannelSendInvocation)
-	f_sym19.ChannelSendCalls = append(f_sym19.ChannelSendCalls, invocation_sym19)
+	invocation := new(ChannelerChannelSendInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ChannelSendCalls = append(f.ChannelSendCalls, invocation)
 
-	invocation_sym19.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	ident2 = f_sym19.ChannelSendHook(ident1)
+	if t != nil && expectation == nil {
+		t.Errorf("FakeChanneler.ChannelSend called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym19.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedChannelSend returns the first unsatisfied expectation of FakeChanneler.ChannelSend matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeChanneler) expectedChannelSend(ident1 chan<- int) (*ChannelerChannelSendExpectation, ChannelerTestingT) {
+	if len(f.expectationsChannelSend) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsChannelSend {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsChannelSend[0].t
+}
+
+// ExpectChannelSend expects calls of FakeChanneler.ChannelSend, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeChanneler) ExpectChannelSend(t ChannelerTestingT) *ChannelerChannelSendExpectation {
+	t.Helper()
+	expectation := &ChannelerChannelSendExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsChannelSend = append(f.expectationsChannelSend, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeChanneler.ChannelSend called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetChannelSendHook configures Channeler.ChannelSend to call the given function
+func (f *FakeChanneler) SetChannelSendHook(hook func(chan<- int) chan<- int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ChannelSendHook = hook
+}
+
 // SetChannelSendStub configures Channeler.ChannelSend to always return the given values
-func (f_sym20 *FakeChanneler) SetChannelSendStub(ident2 chan<- int) {
-	f_sym20.ChannelSendHook = func(chan<- int) chan<- int {
+func (f *FakeChanneler) SetChannelSendStub(ident2 chan<- int) {
+	f.SetChannelSendHook(func(chan<- int) chan<- int {
 		return ident2
-	}
+	})
+}
+
+// SetChannelSendReturnsOnCall configures Channeler.ChannelSend to return the given values from the call with the given index in ChannelSendCalls, rather than calling the hook
+func (f *FakeChanneler) SetChannelSendReturnsOnCall(call int, ident2 chan<- int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelSend.Set(call, ChannelerChannelSendResults{Ident2: ident2})
+}
+
+// SetChannelSendReturnsSequence configures the following calls of Channeler.ChannelSend to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeChanneler) SetChannelSendReturnsSequence(exhausted ChannelerExhausted, results ...ChannelerChannelSendResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelSend.Sequence(len(f.ChannelSendCalls), exhausted, results)
 }
 
 // SetChannelSendInvocation configures Channeler.ChannelSend to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym21 *FakeChanneler) SetChannelSendInvocation(calls_sym21 []*ChannelerChannelSendInvocation, fallback_sym21 func() chan<- int) {
-	f_sym21.ChannelSendHook = func(ident1 chan<- int) (ident2 chan<- int) {
-		for _, call_sym21 := range calls_sym21 {
-			if reflect.DeepEqual(call_sym21.Parameters.Ident1, ident1) {
-				ident2 = call_sym21.Results.Ident2
+func (f *FakeChanneler) SetChannelSendInvocation(calls []*ChannelerChannelSendInvocation, fallback func() chan<- int) {
+	f.SetChannelSendHook(func(ident1 chan<- int) (ident2 chan<- int) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym21()
+		return fallback()
+	})
+}
+
+// ChannelSendCallsSnapshot returns a copy of the calls made to FakeChanneler.ChannelSend
+func (f *FakeChanneler) ChannelSendCallsSnapshot() []*ChannelerChannelSendInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ChannelerChannelSendInvocation, len(f.ChannelSendCalls))
+	for i, call := range f.ChannelSendCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ChannelSendCall returns a ChannelerCallMatcher selecting the calls of FakeChanneler.ChannelSend with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelSendCall(ident1 ChannelerMatcher[chan<- int]) ChannelerCallMatcher {
+	return fake.NewCallMatcher("FakeChanneler.ChannelSend(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ChannelerChannelSendInvocation(nil), f.ChannelSendCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ChannelSendCalled returns true if FakeChanneler.ChannelSend was called
 func (f *FakeChanneler) ChannelSendCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelSendCalls) != 0
 }
 
 // AssertChannelSendCalled calls t.Error if FakeChanneler.ChannelSend was not called
 func (f *FakeChanneler) AssertChannelSendCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelSendCalls) == 0 {
 		t.Error("FakeChanneler.ChannelSend not called, expected at least one")
 	}
@@ -609,12 +1691,16 @@ func (f *FakeChanneler) AssertChannelSendCalled(t ChannelerTestingT) {
 
 // ChannelSendNotCalled returns true if FakeChanneler.ChannelSend was not called
 func (f *FakeChanneler) ChannelSendNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelSendCalls) == 0
 }
 
 // AssertChannelSendNotCalled calls t.Error if FakeChanneler.ChannelSend was called
 func (f *FakeChanneler) AssertChannelSendNotCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelSendCalls) != 0 {
 		t.Error("FakeChanneler.ChannelSend called, expected none")
 	}
@@ -622,12 +1708,16 @@ func (f *FakeChanneler) AssertChannelSendNotCalled(t ChannelerTestingT) {
 
 // ChannelSendCalledOnce returns true if FakeChanneler.ChannelSend was called exactly once
 func (f *FakeChanneler) ChannelSendCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelSendCalls) == 1
 }
 
 // AssertChannelSendCalledOnce calls t.Error if FakeChanneler.ChannelSend was not called exactly once
 func (f *FakeChanneler) AssertChannelSendCalledOnce(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelSendCalls) != 1 {
 		t.Errorf("FakeChanneler.ChannelSend called %d times, expected 1", len(f.ChannelSendCalls))
 	}
@@ -635,21 +1725,92 @@ func (f *FakeChanneler) AssertChannelSendCalledOnce(t ChannelerTestingT) {
 
 // ChannelSendCalledN returns true if FakeChanneler.ChannelSend was called at least n times
 func (f *FakeChanneler) ChannelSendCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelSendCalls) >= n
 }
 
 // AssertChannelSendCalledN calls t.Error if FakeChanneler.ChannelSend was called less than n times
 func (f *FakeChanneler) AssertChannelSendCalledN(t ChannelerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelSendCalls) < n {
 		t.Errorf("FakeChanneler.ChannelSend called %d times, expected >= %d", len(f.ChannelSendCalls), n)
 	}
 }
 
-// ChannelSendCalledWith returns true if FakeChanneler.ChannelSend was called with the given values
-func (f_sym22 *FakeChanneler) ChannelSendCalledWith(ident1 chan<- int) bool {
-	for _, call_sym22 := range f_sym22.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym22.Parameters.Ident1, ident1) {
+// WaitForChannelSendCalled blocks until FakeChanneler.ChannelSend has been called, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelSendCalled(ctx context.Context) error {
+	return f.WaitForChannelSendCalledN(ctx, 1)
+}
+
+// WaitForChannelSendCalledN blocks until FakeChanneler.ChannelSend has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelSendCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ChannelSendCalls) >= n
+	})
+}
+
+// AssertChannelSendEventuallyCalled calls t.Error if FakeChanneler.ChannelSend is not called within the timeout
+func (f *FakeChanneler) AssertChannelSendEventuallyCalled(t ChannelerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForChannelSendCalled(ctx) != nil {
+		t.Errorf("FakeChanneler.ChannelSend not called within %v", timeout)
+	}
+}
+
+// describeChannelSendCalls describes the calls of FakeChanneler.ChannelSend against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeChanneler) describeChannelSendCalls(ident1 ChannelerMatcher[chan<- int]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ChannelSendCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ChannelSendCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ChannelSendCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ChannelSendCalledWith returns true if FakeChanneler.ChannelSend was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelSendCalledWith(ident1 ChannelerMatcher[chan<- int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelSendCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -657,55 +1818,86 @@ func (f_sym22 *FakeChanneler) ChannelSendCalledWith(ident1 chan<- int) bool {
 	return false
 }
 
-// AssertChannelSendCalledWith calls t.Error if FakeChanneler.ChannelSend was not called with the given values
-func (f_sym23 *FakeChanneler) AssertChannelSendCalledWith(t ChannelerTestingT, ident1 chan<- int) {
+// AssertChannelSendCalledWith calls t.Error if FakeChanneler.ChannelSend was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelSendCalledWith(t ChannelerTestingT, ident1 ChannelerMatcher[chan<- int]) {
 	t.Helper()
-	var found_sym23 bool
-	for _, call_sym23 := range f_sym23.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym23.Parameters.Ident1, ident1) {
-			found_sym23 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ChannelSendCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym23 {
-		t.Error("FakeChanneler.ChannelSend not called with expected parameters")
+	if !found {
+		t.Errorf("FakeChanneler.ChannelSend not called with expected parameters\n%s", f.describeChannelSendCalls(ident1))
 	}
 }
 
-// ChannelSendCalledOnceWith returns true if FakeChanneler.ChannelSend was called exactly once with the given values
-func (f_sym24 *FakeChanneler) ChannelSendCalledOnceWith(ident1 chan<- int) bool {
-	var count_sym24 int
-	for _, call_sym24 := range f_sym24.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym24.Parameters.Ident1, ident1) {
-			count_sym24++
+// ChannelSendCalledOnceWith returns true if FakeChanneler.ChannelSend was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelSendCalledOnceWith(ident1 ChannelerMatcher[chan<- int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelSendCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym24 == 1
+	return count == 1
 }
 
-// AssertChannelSendCalledOnceWith calls t.Error if FakeChanneler.ChannelSend was not called exactly once with the given values
-func (f_sym25 *FakeChanneler) AssertChannelSendCalledOnceWith(t ChannelerTestingT, ident1 chan<- int) {
+// AssertChannelSendCalledOnceWith calls t.Error if FakeChanneler.ChannelSend was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelSendCalledOnceWith(t ChannelerTestingT, ident1 ChannelerMatcher[chan<- int]) {
 	t.Helper()
-	var count_sym25 int
-	for _, call_sym25 := range f_sym25.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym25.Parameters.Ident1, ident1) {
-			count_sym25++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelSendCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym25 != 1 {
-		t.Errorf("FakeChanneler.ChannelSend called %d times with expected parameters, expected one", count_sym25)
+	if count != 1 {
+		t.Errorf("FakeChanneler.ChannelSend called %d times with expected parameters, expected one\n%s", count, f.describeChannelSendCalls(ident1))
 	}
 }
 
-// ChannelSendResultsForCall returns the result values for the first call to FakeChanneler.ChannelSend with the given values
-func (f_sym26 *FakeChanneler) ChannelSendResultsForCall(ident1 chan<- int) (ident2 chan<- int, found_sym26 bool) {
-	for _, call_sym26 := range f_sym26.ChannelSendCalls {
-		if reflect.DeepEqual(call_sym26.Parameters.Ident1, ident1) {
-			ident2 = call_sym26.Results.Ident2
-			found_sym26 = true
+// AssertChannelSendEventuallyCalledWith calls t.Error if FakeChanneler.ChannelSend is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeChanneler) AssertChannelSendEventuallyCalledWith(t ChannelerTestingT, timeout time.Duration, ident1 ChannelerMatcher[chan<- int]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ChannelSendCalls)
+		for _, call := range f.ChannelSendCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeChanneler.ChannelSend not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeChannelSendCalls(ident1))
+	}
+}
+
+// ChannelSendResultsForCall returns the result values for the first call to FakeChanneler.ChannelSend with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelSendResultsForCall(ident1 ChannelerMatcher[chan<- int]) (ident2 chan<- int, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelSendCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -713,54 +1905,185 @@ func (f_sym26 *FakeChanneler) ChannelSendResultsForCall(ident1 chan<- int) (iden
 	return
 }
 
-func (f_sym27 *FakeChanneler) ChannelPointer(ident1 *chan int) (ident2 *chan int) {
-	if f_sym27.ChannelPointerHook == nil {
+func (f *FakeChanneler) ChannelPointer(ident1 *chan int) (ident2 *chan int) {
+	f.mutex.Lock()
+	hook := f.ChannelPointerHook
+	expectation, t := f.expectedChannelPointer(ident1)
+	var results ChannelerChannelPointerResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsChannelPointer.Lookup(len(f.ChannelPointerCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Channeler.ChannelPointer() called after the results given to FakeChanneler.SetChannelPointerReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Channeler.ChannelPointer() called but FakeChanneler.ChannelPointerHook is nil")
 	}
 
-	invocation_sym27 := new(ChannelerChannelPointerInvocation)
-	f_sym27.ChannelPointerCalls = append(f_sym27.ChannelPointerCalls, invocation_sym27)
+	invocation := new(ChannelerChannelPointerInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ChannelPointerCalls = append(f.ChannelPointerCalls, invocation)
 
-	invocation_sym27.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	ident2 = f_sym27.ChannelPointerHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeChanneler.ChannelPointer called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	invocation_sym27.Results.Ident2 = ident2
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedChannelPointer returns the first unsatisfied expectation of FakeChanneler.ChannelPointer matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeChanneler) expectedChannelPointer(ident1 *chan int) (*ChannelerChannelPointerExpectation, ChannelerTestingT) {
+	if len(f.expectationsChannelPointer) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsChannelPointer {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsChannelPointer[0].t
+}
+
+// ExpectChannelPointer expects calls of FakeChanneler.ChannelPointer, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeChanneler) ExpectChannelPointer(t ChannelerTestingT) *ChannelerChannelPointerExpectation {
+	t.Helper()
+	expectation := &ChannelerChannelPointerExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsChannelPointer = append(f.expectationsChannelPointer, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeChanneler.ChannelPointer called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetChannelPointerHook configures Channeler.ChannelPointer to call the given function
+func (f *FakeChanneler) SetChannelPointerHook(hook func(*chan int) *chan int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ChannelPointerHook = hook
+}
+
 // SetChannelPointerStub configures Channeler.ChannelPointer to always return the given values
-func (f_sym28 *FakeChanneler) SetChannelPointerStub(ident2 *chan int) {
-	f_sym28.ChannelPointerHook = func(*chan int) *chan int {
+func (f *FakeChanneler) SetChannelPointerStub(ident2 *chan int) {
+	f.SetChannelPointerHook(func(*chan int) *chan int {
 		return ident2
-	}
+	})
+}
+
+// SetChannelPointerReturnsOnCall configures Channeler.ChannelPointer to return the given values from the call with the given index in ChannelPointerCalls, rather than calling the hook
+func (f *FakeChanneler) SetChannelPointerReturnsOnCall(call int, ident2 *chan int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelPointer.Set(call, ChannelerChannelPointerResults{Ident2: ident2})
+}
+
+// SetChannelPointerReturnsSequence configures the following calls of Channeler.ChannelPointer to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeChanneler) SetChannelPointerReturnsSequence(exhausted ChannelerExhausted, results ...ChannelerChannelPointerResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelPointer.Sequence(len(f.ChannelPointerCalls), exhausted, results)
 }
 
 // SetChannelPointerInvocation configures Channeler.ChannelPointer to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym29 *FakeChanneler) SetChannelPointerInvocation(calls_sym29 []*ChannelerChannelPointerInvocation, fallback_sym29 func() *chan int) {
-	f_sym29.ChannelPointerHook = func(ident1 *chan int) (ident2 *chan int) {
-		for _, call_sym29 := range calls_sym29 {
-			if reflect.DeepEqual(call_sym29.Parameters.Ident1, ident1) {
-				ident2 = call_sym29.Results.Ident2
+func (f *FakeChanneler) SetChannelPointerInvocation(calls []*ChannelerChannelPointerInvocation, fallback func() *chan int) {
+	f.SetChannelPointerHook(func(ident1 *chan int) (ident2 *chan int) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym29()
+		return fallback()
+	})
+}
+
+// ChannelPointerCallsSnapshot returns a copy of the calls made to FakeChanneler.ChannelPointer
+func (f *FakeChanneler) ChannelPointerCallsSnapshot() []*ChannelerChannelPointerInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ChannelerChannelPointerInvocation, len(f.ChannelPointerCalls))
+	for i, call := range f.ChannelPointerCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ChannelPointerCall returns a ChannelerCallMatcher selecting the calls of FakeChanneler.ChannelPointer with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelPointerCall(ident1 ChannelerMatcher[*chan int]) ChannelerCallMatcher {
+	return fake.NewCallMatcher("FakeChanneler.ChannelPointer(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ChannelerChannelPointerInvocation(nil), f.ChannelPointerCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ChannelPointerCalled returns true if FakeChanneler.ChannelPointer was called
 func (f *FakeChanneler) ChannelPointerCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelPointerCalls) != 0
 }
 
 // AssertChannelPointerCalled calls t.Error if FakeChanneler.ChannelPointer was not called
 func (f *FakeChanneler) AssertChannelPointerCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelPointerCalls) == 0 {
 		t.Error("FakeChanneler.ChannelPointer not called, expected at least one")
 	}
@@ -768,12 +2091,16 @@ func (f *FakeChanneler) AssertChannelPointerCalled(t ChannelerTestingT) {
 
 // ChannelPointerNotCalled returns true if FakeChanneler.ChannelPointer was not called
 func (f *FakeChanneler) ChannelPointerNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelPointerCalls) == 0
 }
 
 // AssertChannelPointerNotCalled calls t.Error if FakeChanneler.ChannelPointer was called
 func (f *FakeChanneler) AssertChannelPointerNotCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelPointerCalls) != 0 {
 		t.Error("FakeChanneler.ChannelPointer called, expected none")
 	}
@@ -781,12 +2108,16 @@ func (f *FakeChanneler) AssertChannelPointerNotCalled(t ChannelerTestingT) {
 
 // ChannelPointerCalledOnce returns true if FakeChanneler.ChannelPointer was called exactly once
 func (f *FakeChanneler) ChannelPointerCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelPointerCalls) == 1
 }
 
 // AssertChannelPointerCalledOnce calls t.Error if FakeChanneler.ChannelPointer was not called exactly once
 func (f *FakeChanneler) AssertChannelPointerCalledOnce(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelPointerCalls) != 1 {
 		t.Errorf("FakeChanneler.ChannelPointer called %d times, expected 1", len(f.ChannelPointerCalls))
 	}
@@ -794,21 +2125,92 @@ func (f *FakeChanneler) AssertChannelPointerCalledOnce(t ChannelerTestingT) {
 
 // ChannelPointerCalledN returns true if FakeChanneler.ChannelPointer was called at least n times
 func (f *FakeChanneler) ChannelPointerCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelPointerCalls) >= n
 }
 
 // AssertChannelPointerCalledN calls t.Error if FakeChanneler.ChannelPointer was called less than n times
 func (f *FakeChanneler) AssertChannelPointerCalledN(t ChannelerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelPointerCalls) < n {
 		t.Errorf("FakeChanneler.ChannelPointer called %d times, expected >= %d", len(f.ChannelPointerCalls), n)
 	}
 }
 
-// ChannelPointerCalledWith returns true if FakeChanneler.ChannelPointer was called with the given values
-func (f_sym30 *FakeChanneler) ChannelPointerCalledWith(ident1 *chan int) bool {
-	for _, call_sym30 := range f_sym30.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym30.Parameters.Ident1, ident1) {
+// WaitForChannelPointerCalled blocks until FakeChanneler.ChannelPointer has been called, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelPointerCalled(ctx context.Context) error {
+	return f.WaitForChannelPointerCalledN(ctx, 1)
+}
+
+// WaitForChannelPointerCalledN blocks until FakeChanneler.ChannelPointer has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelPointerCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ChannelPointerCalls) >= n
+	})
+}
+
+// AssertChannelPointerEventuallyCalled calls t.Error if FakeChanneler.ChannelPointer is not called within the timeout
+func (f *FakeChanneler) AssertChannelPointerEventuallyCalled(t ChannelerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForChannelPointerCalled(ctx) != nil {
+		t.Errorf("FakeChanneler.ChannelPointer not called within %v", timeout)
+	}
+}
+
+// describeChannelPointerCalls describes the calls of FakeChanneler.ChannelPointer against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeChanneler) describeChannelPointerCalls(ident1 ChannelerMatcher[*chan int]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ChannelPointerCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ChannelPointerCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ChannelPointerCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ChannelPointerCalledWith returns true if FakeChanneler.ChannelPointer was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelPointerCalledWith(ident1 ChannelerMatcher[*chan int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelPointerCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -816,55 +2218,86 @@ func (f_sym30 *FakeChanneler) ChannelPointerCalledWith(ident1 *chan int) bool {
 	return false
 }
 
-// AssertChannelPointerCalledWith calls t.Error if FakeChanneler.ChannelPointer was not called with the given values
-func (f_sym31 *FakeChanneler) AssertChannelPointerCalledWith(t ChannelerTestingT, ident1 *chan int) {
+// AssertChannelPointerCalledWith calls t.Error if FakeChanneler.ChannelPointer was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelPointerCalledWith(t ChannelerTestingT, ident1 ChannelerMatcher[*chan int]) {
 	t.Helper()
-	var found_sym31 bool
-	for _, call_sym31 := range f_sym31.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym31.Parameters.Ident1, ident1) {
-			found_sym31 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ChannelPointerCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym31 {
-		t.Error("FakeChanneler.ChannelPointer not called with expected parameters")
+	if !found {
+		t.Errorf("FakeChanneler.ChannelPointer not called with expected parameters\n%s", f.describeChannelPointerCalls(ident1))
 	}
 }
 
-// ChannelPointerCalledOnceWith returns true if FakeChanneler.ChannelPointer was called exactly once with the given values
-func (f_sym32 *FakeChanneler) ChannelPointerCalledOnceWith(ident1 *chan int) bool {
-	var count_sym32 int
-	for _, call_sym32 := range f_sym32.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym32.Parameters.Ident1, ident1) {
-			count_sym32++
+// ChannelPointerCalledOnceWith returns true if FakeChanneler.ChannelPointer was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelPointerCalledOnceWith(ident1 ChannelerMatcher[*chan int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelPointerCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym32 == 1
+	return count == 1
 }
 
-// AssertChannelPointerCalledOnceWith calls t.Error if FakeChanneler.ChannelPointer was not called exactly once with the given values
-func (f_sym33 *FakeChanneler) AssertChannelPointerCalledOnceWith(t ChannelerTestingT, ident1 *chan int) {
+// AssertChannelPointerCalledOnceWith calls t.Error if FakeChanneler.ChannelPointer was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelPointerCalledOnceWith(t ChannelerTestingT, ident1 ChannelerMatcher[*chan int]) {
 	t.Helper()
-	var count_sym33 int
-	for _, call_sym33 := range f_sym33.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym33.Parameters.Ident1, ident1) {
-			count_sym33++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelPointerCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym33 != 1 {
-		t.Errorf("FakeChanneler.ChannelPointer called %d times with expected parameters, expected one", count_sym33)
+	if count != 1 {
+		t.Errorf("FakeChanneler.ChannelPointer called %d times with expected parameters, expected one\n%s", count, f.describeChannelPointerCalls(ident1))
 	}
 }
 
-// ChannelPointerResultsForCall returns the result values for the first call to FakeChanneler.ChannelPointer with the given values
-func (f_sym34 *FakeChanneler) ChannelPointerResultsForCall(ident1 *chan int) (ident2 *chan int, found_sym34 bool) {
-	for _, call_sym34 := range f_sym34.ChannelPointerCalls {
-		if reflect.DeepEqual(call_sym34.Parameters.Ident1, ident1) {
-			ident2 = call_sym34.Results.Ident2
-			found_sym34 = true
+// AssertChannelPointerEventuallyCalledWith calls t.Error if FakeChanneler.ChannelPointer is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeChanneler) AssertChannelPointerEventuallyCalledWith(t ChannelerTestingT, timeout time.Duration, ident1 ChannelerMatcher[*chan int]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ChannelPointerCalls)
+		for _, call := range f.ChannelPointerCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeChanneler.ChannelPointer not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeChannelPointerCalls(ident1))
+	}
+}
+
+// ChannelPointerResultsForCall returns the result values for the first call to FakeChanneler.ChannelPointer with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelPointerResultsForCall(ident1 ChannelerMatcher[*chan int]) (ident2 *chan int, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelPointerCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -872,54 +2305,185 @@ func (f_sym34 *FakeChanneler) ChannelPointerResultsForCall(ident1 *chan int) (id
 	return
 }
 
-func (f_sym35 *FakeChanneler) ChannelInterface(ident1 chan interface{}) (ident2 chan interface{}) {
-	if f_sym35.ChannelInterfaceHook == nil {
+func (f *FakeChanneler) ChannelInterface(ident1 chan interface{}) (ident2 chan interface{}) {
+	f.mutex.Lock()
+	hook := f.ChannelInterfaceHook
+	expectation, t := f.expectedChannelInterface(ident1)
+	var results ChannelerChannelInterfaceResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsChannelInterface.Lookup(len(f.ChannelInterfaceCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Channeler.ChannelInterface() called after the results given to FakeChanneler.SetChannelInterfaceReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Channeler.ChannelInterface() called but FakeChanneler.ChannelInterfaceHook is nil")
 	}
 
-	invocation_sym35 := new(ChannelerChannelInterfaceInvocation)
-	f_sym35.ChannelInterfaceCalls = append(f_sym35.ChannelInterfaceCalls, invocation_sym35)
+	invocation := new(ChannelerChannelInterfaceInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ChannelInterfaceCalls = append(f.ChannelInterfaceCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym35.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeChanneler.ChannelInterface called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ident2 = f_sym35.ChannelInterfaceHook(ident1)
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym35.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedChannelInterface returns the first unsatisfied expectation of FakeChanneler.ChannelInterface matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeChanneler) expectedChannelInterface(ident1 chan interface{}) (*ChannelerChannelInterfaceExpectation, ChannelerTestingT) {
+	if len(f.expectationsChannelInterface) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsChannelInterface {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsChannelInterface[0].t
+}
+
+// ExpectChannelInterface expects calls of FakeChanneler.ChannelInterface, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeChanneler) ExpectChannelInterface(t ChannelerTestingT) *ChannelerChannelInterfaceExpectation {
+	t.Helper()
+	expectation := &ChannelerChannelInterfaceExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsChannelInterface = append(f.expectationsChannelInterface, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeChanneler.ChannelInterface called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetChannelInterfaceHook configures Channeler.ChannelInterface to call the given function
+func (f *FakeChanneler) SetChannelInterfaceHook(hook func(chan interface{}) chan interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ChannelInterfaceHook = hook
+}
+
 // SetChannelInterfaceStub configures Channeler.ChannelInterface to always return the given values
-func (f_sym36 *FakeChanneler) SetChannelInterfaceStub(ident2 chan interface{}) {
-	f_sym36.ChannelInterfaceHook = func(chan interface{}) chan interface{} {
+func (f *FakeChanneler) SetChannelInterfaceStub(ident2 chan interface{}) {
+	f.SetChannelInterfaceHook(func(chan interface{}) chan interface{} {
 		return ident2
-	}
+	})
+}
+
+// SetChannelInterfaceReturnsOnCall configures Channeler.ChannelInterface to return the given values from the call with the given index in ChannelInterfaceCalls, rather than calling the hook
+func (f *FakeChanneler) SetChannelInterfaceReturnsOnCall(call int, ident2 chan interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelInterface.Set(call, ChannelerChannelInterfaceResults{Ident2: ident2})
+}
+
+// SetChannelInterfaceReturnsSequence configures the following calls of Channeler.ChannelInterface to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeChanneler) SetChannelInterfaceReturnsSequence(exhausted ChannelerExhausted, results ...ChannelerChannelInterfaceResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsChannelInterface.Sequence(len(f.ChannelInterfaceCalls), exhausted, results)
 }
 
 // SetChannelInterfaceInvocation configures Channeler.ChannelInterface to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym37 *FakeChanneler) SetChannelInterfaceInvocation(calls_sym37 []*ChannelerChannelInterfaceInvocation, fallback_sym37 func() chan interface{}) {
-	f_sym37.ChannelInterfaceHook = func(ident1 chan interface{}) (ident2 chan interface{}) {
-		for _, call_sym37 := range calls_sym37 {
-			if reflect.DeepEqual(call_sym37.Parameters.Ident1, ident1) {
-				ident2 = call_sym37.Results.Ident2
+func (f *FakeChanneler) SetChannelInterfaceInvocation(calls []*ChannelerChannelInterfaceInvocation, fallback func() chan interface{}) {
+	f.SetChannelInterfaceHook(func(ident1 chan interface{}) (ident2 chan interface{}) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym37()
+		return fallback()
+	})
+}
+
+// ChannelInterfaceCallsSnapshot returns a copy of the calls made to FakeChanneler.ChannelInterface
+func (f *FakeChanneler) ChannelInterfaceCallsSnapshot() []*ChannelerChannelInterfaceInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ChannelerChannelInterfaceInvocation, len(f.ChannelInterfaceCalls))
+	for i, call := range f.ChannelInterfaceCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ChannelInterfaceCall returns a ChannelerCallMatcher selecting the calls of FakeChanneler.ChannelInterface with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelInterfaceCall(ident1 ChannelerMatcher[chan interface{}]) ChannelerCallMatcher {
+	return fake.NewCallMatcher("FakeChanneler.ChannelInterface(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ChannelerChannelInterfaceInvocation(nil), f.ChannelInterfaceCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ChannelInterfaceCalled returns true if FakeChanneler.ChannelInterface was called
 func (f *FakeChanneler) ChannelInterfaceCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelInterfaceCalls) != 0
 }
 
 // AssertChannelInterfaceCalled calls t.Error if FakeChanneler.ChannelInterface was not called
 func (f *FakeChanneler) AssertChannelInterfaceCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelInterfaceCalls) == 0 {
 		t.Error("FakeChanneler.ChannelInterface not called, expected at least one")
 	}
@@ -927,12 +2491,16 @@ func (f *FakeChanneler) AssertChannelInterfaceCalled(t ChannelerTestingT) {
 
 // ChannelInterfaceNotCalled returns true if FakeChanneler.ChannelInterface was not called
 func (f *FakeChanneler) ChannelInterfaceNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelInterfaceCalls) == 0
 }
 
 // AssertChannelInterfaceNotCalled calls t.Error if FakeChanneler.ChannelInterface was called
 func (f *FakeChanneler) AssertChannelInterfaceNotCalled(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelInterfaceCalls) != 0 {
 		t.Error("FakeChanneler.ChannelInterface called, expected none")
 	}
@@ -940,12 +2508,16 @@ func (f *FakeChanneler) AssertChannelInterfaceNotCalled(t ChannelerTestingT) {
 
 // ChannelInterfaceCalledOnce returns true if FakeChanneler.ChannelInterface was called exactly once
 func (f *FakeChanneler) ChannelInterfaceCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelInterfaceCalls) == 1
 }
 
 // AssertChannelInterfaceCalledOnce calls t.Error if FakeChanneler.ChannelInterface was not called exactly once
 func (f *FakeChanneler) AssertChannelInterfaceCalledOnce(t ChannelerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelInterfaceCalls) != 1 {
 		t.Errorf("FakeChanneler.ChannelInterface called %d times, expected 1", len(f.ChannelInterfaceCalls))
 	}
@@ -953,21 +2525,92 @@ func (f *FakeChanneler) AssertChannelInterfaceCalledOnce(t ChannelerTestingT) {
 
 // ChannelInterfaceCalledN returns true if FakeChanneler.ChannelInterface was called at least n times
 func (f *FakeChanneler) ChannelInterfaceCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ChannelInterfaceCalls) >= n
 }
 
 // AssertChannelInterfaceCalledN calls t.Error if FakeChanneler.ChannelInterface was called less than n times
 func (f *FakeChanneler) AssertChannelInterfaceCalledN(t ChannelerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ChannelInterfaceCalls) < n {
 		t.Errorf("FakeChanneler.ChannelInterface called %d times, expected >= %d", len(f.ChannelInterfaceCalls), n)
 	}
 }
 
-// ChannelInterfaceCalledWith returns true if FakeChanneler.ChannelInterface was called with the given values
-func (f_sym38 *FakeChanneler) ChannelInterfaceCalledWith(ident1 chan interface{}) bool {
-	for _, call_sym38 := range f_sym38.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym38.Parameters.Ident1, ident1) {
+// WaitForChannelInterfaceCalled blocks until FakeChanneler.ChannelInterface has been called, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelInterfaceCalled(ctx context.Context) error {
+	return f.WaitForChannelInterfaceCalledN(ctx, 1)
+}
+
+// WaitForChannelInterfaceCalledN blocks until FakeChanneler.ChannelInterface has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeChanneler) WaitForChannelInterfaceCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ChannelInterfaceCalls) >= n
+	})
+}
+
+// AssertChannelInterfaceEventuallyCalled calls t.Error if FakeChanneler.ChannelInterface is not called within the timeout
+func (f *FakeChanneler) AssertChannelInterfaceEventuallyCalled(t ChannelerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForChannelInterfaceCalled(ctx) != nil {
+		t.Errorf("FakeChanneler.ChannelInterface not called within %v", timeout)
+	}
+}
+
+// describeChannelInterfaceCalls describes the calls of FakeChanneler.ChannelInterface against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeChanneler) describeChannelInterfaceCalls(ident1 ChannelerMatcher[chan interface{}]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ChannelInterfaceCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ChannelInterfaceCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ChannelInterfaceCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ChannelInterfaceCalledWith returns true if FakeChanneler.ChannelInterface was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelInterfaceCalledWith(ident1 ChannelerMatcher[chan interface{}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelInterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -975,55 +2618,86 @@ func (f_sym38 *FakeChanneler) ChannelInterfaceCalledWith(ident1 chan interface{}
 	return false
 }
 
-// AssertChannelInterfaceCalledWith calls t.Error if FakeChanneler.ChannelInterface was not called with the given values
-func (f_sym39 *FakeChanneler) AssertChannelInterfaceCalledWith(t ChannelerTestingT, ident1 chan interface{}) {
+// AssertChannelInterfaceCalledWith calls t.Error if FakeChanneler.ChannelInterface was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelInterfaceCalledWith(t ChannelerTestingT, ident1 ChannelerMatcher[chan interface{}]) {
 	t.Helper()
-	var found_sym39 bool
-	for _, call_sym39 := range f_sym39.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym39.Parameters.Ident1, ident1) {
-			found_sym39 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ChannelInterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym39 {
-		t.Error("FakeChanneler.ChannelInterface not called with expected parameters")
+	if !found {
+		t.Errorf("FakeChanneler.ChannelInterface not called with expected parameters\n%s", f.describeChannelInterfaceCalls(ident1))
 	}
 }
 
-// ChannelInterfaceCalledOnceWith returns true if FakeChanneler.ChannelInterface was called exactly once with the given values
-func (f_sym40 *FakeChanneler) ChannelInterfaceCalledOnceWith(ident1 chan interface{}) bool {
-	var count_sym40 int
-	for _, call_sym40 := range f_sym40.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym40.Parameters.Ident1, ident1) {
-			count_sym40++
+// ChannelInterfaceCalledOnceWith returns true if FakeChanneler.ChannelInterface was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelInterfaceCalledOnceWith(ident1 ChannelerMatcher[chan interface{}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelInterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym40 == 1
+	return count == 1
 }
 
-// AssertChannelInterfaceCalledOnceWith calls t.Error if FakeChanneler.ChannelInterface was not called exactly once with the given values
-func (f_sym41 *FakeChanneler) AssertChannelInterfaceCalledOnceWith(t ChannelerTestingT, ident1 chan interface{}) {
+// AssertChannelInterfaceCalledOnceWith calls t.Error if FakeChanneler.ChannelInterface was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) AssertChannelInterfaceCalledOnceWith(t ChannelerTestingT, ident1 ChannelerMatcher[chan interface{}]) {
 	t.Helper()
-	var count_sym41 int
-	for _, call_sym41 := range f_sym41.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym41.Parameters.Ident1, ident1) {
-			count_sym41++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ChannelInterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym41 != 1 {
-		t.Errorf("FakeChanneler.ChannelInterface called %d times with expected parameters, expected one", count_sym41)
+	if count != 1 {
+		t.Errorf("FakeChanneler.ChannelInterface called %d times with expected parameters, expected one\n%s", count, f.describeChannelInterfaceCalls(ident1))
+	}
+}
+
+// AssertChannelInterfaceEventuallyCalledWith calls t.Error if FakeChanneler.ChannelInterface is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeChanneler) AssertChannelInterfaceEventuallyCalledWith(t ChannelerTestingT, timeout time.Duration, ident1 ChannelerMatcher[chan interface{}]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ChannelInterfaceCalls)
+		for _, call := range f.ChannelInterfaceCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeChanneler.ChannelInterface not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeChannelInterfaceCalls(ident1))
 	}
 }
 
-// ChannelInterfaceResultsForCall returns the result values for the first call to FakeChanneler.ChannelInterface with the given values
-func (f_sym42 *FakeChanneler) ChannelInterfaceResultsForCall(ident1 chan interface{}) (ident2 chan interface{}, found_sym42 bool) {
-	for _, call_sym42 := range f_sym42.ChannelInterfaceCalls {
-		if reflect.DeepEqual(call_sym42.Parameters.Ident1, ident1) {
-			ident2 = call_sym42.Results.Ident2
-			found_sym42 = true
+// ChannelInterfaceResultsForCall returns the result values for the first call to FakeChanneler.ChannelInterface with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeChanneler) ChannelInterfaceResultsForCall(ident1 ChannelerMatcher[chan interface{}]) (ident2 chan interface{}, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ChannelInterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
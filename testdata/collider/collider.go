@@ -0,0 +1,1236 @@
+// generated by "charlatan -dir=testdata/collider -output=testdata/collider/collider.go Collider".  DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	rand2 "math/rand"
+	reflect2 "reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
+
+// ColliderSeedInvocation represents a single call of FakeCollider.Seed
+type ColliderSeedInvocation struct {
+	Parameters struct {
+		Rand    *rand2.Rand
+		Reflect bool
+	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetSeedInvocation
+	Matchers struct {
+		Rand    ColliderMatcher[*rand2.Rand]
+		Reflect ColliderMatcher[bool]
+	}
+	Results ColliderSeedResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ColliderSeedInvocation) String() string {
+	return fmt.Sprintf("FakeCollider.Seed%+v", i.Parameters)
+}
+
+// ColliderSeedResults holds the results of a single call of FakeCollider.Seed
+type ColliderSeedResults struct {
+	Ident1 error
+}
+
+// NewColliderSeedInvocation creates a new instance of ColliderSeedInvocation
+func NewColliderSeedInvocation(rand *rand2.Rand, reflect bool, ident1 error) *ColliderSeedInvocation {
+	invocation := new(ColliderSeedInvocation)
+
+	invocation.Parameters.Rand = rand
+	invocation.Parameters.Reflect = reflect
+
+	invocation.Results.Ident1 = ident1
+
+	return invocation
+}
+
+// ColliderSeedExpectation is a call of FakeCollider.Seed expected by a test, created by FakeCollider.ExpectSeed
+type ColliderSeedExpectation struct {
+	fake     *FakeCollider
+	t        ColliderTestingT
+	times    int
+	count    int
+	matchers struct {
+		Rand    ColliderMatcher[*rand2.Rand]
+		Reflect ColliderMatcher[bool]
+	}
+	returns bool
+	results ColliderSeedResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ColliderSeedExpectation) With(rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) *ColliderSeedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Rand = rand
+	e.matchers.Reflect = reflect
+
+	return e
+}
+
+func (e *ColliderSeedExpectation) matches(rand *rand2.Rand, reflect bool) bool {
+	return fake.Matches(e.matchers.Rand, rand) && fake.Matches(e.matchers.Reflect, reflect)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ColliderSeedExpectation) Times(n int) *ColliderSeedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ColliderSeedExpectation) Return(ident1 error) *ColliderSeedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ColliderSeedResults{Ident1: ident1}
+	return e
+}
+
+// ColliderIntnInvocation represents a single call of FakeCollider.Intn
+type ColliderIntnInvocation struct {
+	Parameters struct {
+		Ident1 int
+	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetIntnInvocation
+	Matchers struct {
+		Ident1 ColliderMatcher[int]
+	}
+	Results ColliderIntnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ColliderIntnInvocation) String() string {
+	return fmt.Sprintf("FakeCollider.Intn%+v", i.Parameters)
+}
+
+// ColliderIntnResults holds the results of a single call of FakeCollider.Intn
+type ColliderIntnResults struct {
+	Ident2 int
+}
+
+// NewColliderIntnInvocation creates a new instance of ColliderIntnInvocation
+func NewColliderIntnInvocation(ident1 int, ident2 int) *ColliderIntnInvocation {
+	invocation := new(ColliderIntnInvocation)
+
+	invocation.Parameters.Ident1 = ident1
+
+	invocation.Results.Ident2 = ident2
+
+	return invocation
+}
+
+// ColliderIntnExpectation is a call of FakeCollider.Intn expected by a test, created by FakeCollider.ExpectIntn
+type ColliderIntnExpectation struct {
+	fake     *FakeCollider
+	t        ColliderTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ColliderMatcher[int]
+	}
+	returns bool
+	results ColliderIntnResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ColliderIntnExpectation) With(ident1 ColliderMatcher[int]) *ColliderIntnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ColliderIntnExpectation) matches(ident1 int) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ColliderIntnExpectation) Times(n int) *ColliderIntnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ColliderIntnExpectation) Return(ident2 int) *ColliderIntnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ColliderIntnResults{Ident2: ident2}
+	return e
+}
+
+// ColliderTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type ColliderTestingT = fake.TestingT
+
+// ColliderMatcher matches a parameter of a call to FakeCollider
+type ColliderMatcher[T any] interface {
+	Match(T) bool
+}
+
+// ColliderMatcherFunc is a ColliderMatcher implemented by a predicate function
+type ColliderMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m ColliderMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// ColliderAny returns a ColliderMatcher that matches any value
+func ColliderAny[T any]() ColliderMatcher[T] {
+	return fake.Any[T]()
+}
+
+// ColliderEq returns a ColliderMatcher that matches values deeply equal to want
+func ColliderEq[T any](want T) ColliderMatcher[T] {
+	return fake.Eq(want)
+}
+
+// ColliderNot returns a ColliderMatcher that matches the values the given matcher does not
+func ColliderNot[T any](m ColliderMatcher[T]) ColliderMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// ColliderPred returns a ColliderMatcher that matches values for which the given predicate returns true
+func ColliderPred[T any](pred func(T) bool) ColliderMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// ColliderAnyOfType returns a ColliderMatcher that matches values whose dynamic type is, or implements, U
+func ColliderAnyOfType[T, U any]() ColliderMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// ColliderCallMatcher selects calls of a method of a fake for ColliderInOrder and ColliderUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeCollider
+type ColliderCallMatcher = fake.CallMatcher
+
+// ColliderInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func ColliderInOrder(t ColliderTestingT, calls ...ColliderCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// ColliderUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func ColliderUnordered(t ColliderTestingT, calls ...ColliderCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// ColliderExhausted selects how a method of FakeCollider behaves once the results given to its SetXReturnsSequence method are used up
+type ColliderExhausted = fake.Exhausted
+
+const (
+	// ColliderRepeatLast returns the last results in the sequence from all later calls
+	ColliderRepeatLast = fake.RepeatLast
+	// ColliderPanicAfterSequence panics on all later calls
+	ColliderPanicAfterSequence = fake.PanicAfterSequence
+	// ColliderHookAfterSequence calls the method's hook on all later calls
+	ColliderHookAfterSequence = fake.HookAfterSequence
+)
+
+/*
+FakeCollider is a mock implementation of Collider for testing.
+Use it in your tests as in this example:
+
+	package example
+
+	func TestWithCollider(t *testing.T) {
+		f := &main.FakeCollider{
+			SeedHook: func(rand *rand2.Rand, reflect bool) (ident1 error) {
+				// ensure parameters meet expectations, signal errors using t, etc
+				return
+			},
+		}
+
+		// test code goes here ...
+
+		// assert state of FakeCollider ...
+		f.AssertSeedCalledOnce(t)
+	}
+
+Create anonymous function implementations for only those interface methods that
+should be called in the code under test.  This will force a panic if any
+unexpected calls are made to FakeCollider.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
+*/
+type FakeCollider struct {
+	SeedHook func(*rand2.Rand, bool) error
+	IntnHook func(int) int
+
+	SeedCalls []*ColliderSeedInvocation
+	IntnCalls []*ColliderIntnInvocation
+
+	returnsSeed      fake.Returns[ColliderSeedResults]
+	returnsIntn      fake.Returns[ColliderIntnResults]
+	expectationsSeed []*ColliderSeedExpectation
+	expectationsIntn []*ColliderIntnExpectation
+	mutex            sync.Mutex
+	recorded         chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters   bool
+}
+
+// NewFakeColliderDefaultPanic returns an instance of FakeCollider with all hooks configured to panic
+func NewFakeColliderDefaultPanic() *FakeCollider {
+	return &FakeCollider{
+		SeedHook: func(*rand2.Rand, bool) (ident1 error) {
+			panic("Unexpected call to Collider.Seed")
+		},
+		IntnHook: func(int) (ident2 int) {
+			panic("Unexpected call to Collider.Intn")
+		},
+	}
+}
+
+// NewFakeColliderDefaultFatal returns an instance of FakeCollider with all hooks configured to call t.Fatal
+func NewFakeColliderDefaultFatal(t ColliderTestingT) *FakeCollider {
+	return &FakeCollider{
+		SeedHook: func(*rand2.Rand, bool) (ident1 error) {
+			t.Fatal("Unexpected call to Collider.Seed")
+			return
+		},
+		IntnHook: func(int) (ident2 int) {
+			t.Fatal("Unexpected call to Collider.Intn")
+			return
+		},
+	}
+}
+
+// NewFakeColliderDefaultError returns an instance of FakeCollider with all hooks configured to call t.Error
+func NewFakeColliderDefaultError(t ColliderTestingT) *FakeCollider {
+	return &FakeCollider{
+		SeedHook: func(*rand2.Rand, bool) (ident1 error) {
+			t.Error("Unexpected call to Collider.Seed")
+			return
+		},
+		IntnHook: func(int) (ident2 int) {
+			t.Error("Unexpected call to Collider.Intn")
+			return
+		},
+	}
+}
+
+// NewFakeColliderDefaultZero returns an instance of FakeCollider with all hooks configured to return zero values
+func NewFakeColliderDefaultZero() *FakeCollider {
+	return &FakeCollider{
+		SeedHook: func(*rand2.Rand, bool) (ident1 error) {
+			return
+		},
+		IntnHook: func(int) (ident2 int) {
+			return
+		},
+	}
+}
+
+// NewFakeColliderDefaultFriendly returns an instance of FakeCollider with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeCollider are friendly fakes, one for each method and result, created on first use
+func NewFakeColliderDefaultFriendly() *FakeCollider {
+
+	return &FakeCollider{
+		SeedHook: func(*rand2.Rand, bool) (ident1 error) {
+			return
+		},
+		IntnHook: func(int) (ident2 int) {
+			return
+		},
+	}
+}
+
+// NewFakeColliderSpy returns an instance of FakeCollider with all hooks configured to call the given implementation
+func NewFakeColliderSpy(real Collider) *FakeCollider {
+	return &FakeCollider{
+		SeedHook: real.Seed,
+		IntnHook: real.Intn,
+	}
+}
+
+// Reset forgets all calls made to FakeCollider
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
+func (f *FakeCollider) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsSeed.Rebase(len(f.SeedCalls))
+	f.SeedCalls = []*ColliderSeedInvocation{}
+	f.returnsIntn.Rebase(len(f.IntnCalls))
+	f.IntnCalls = []*ColliderIntnInvocation{}
+}
+
+// SetCopyParameters configures FakeCollider to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeCollider) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// FailAll configures every method of FakeCollider whose last result is of type error to return the given error, with zero values for its other results, as its SetXError method does
+// Calls given results by the SetXReturnsOnCall, SetXReturnsSequence and SetXErrorOnCall methods or by an expectation's Return method still return those, rather than calling the hook
+func (f *FakeCollider) FailAll(err error) {
+	f.SetSeedError(err)
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeCollider) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeCollider) Seed(rand *rand2.Rand, reflect bool) (ident1 error) {
+	f.mutex.Lock()
+	hook := f.SeedHook
+	expectation, t := f.expectedSeed(rand, reflect)
+	var results ColliderSeedResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsSeed.Lookup(len(f.SeedCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Collider.Seed() called after the results given to FakeCollider.SetSeedReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
+		panic("Collider.Seed() called but FakeCollider.SeedHook is nil")
+	}
+
+	invocation := new(ColliderSeedInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.SeedCalls = append(f.SeedCalls, invocation)
+
+	invocation.Parameters.Rand = rand
+	invocation.Parameters.Reflect = reflect
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect2.Value)
+		invocation.Parameters.Rand = fake.CopyParameter(invocation.Parameters.Rand, seen)
+		invocation.Parameters.Reflect = fake.CopyParameter(invocation.Parameters.Reflect, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeCollider.Seed called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook(rand, reflect)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
+
+	return
+}
+
+// expectedSeed returns the first unsatisfied expectation of FakeCollider.Seed matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeCollider) expectedSeed(rand *rand2.Rand, reflect bool) (*ColliderSeedExpectation, ColliderTestingT) {
+	if len(f.expectationsSeed) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsSeed {
+		if expectation.count < expectation.times && expectation.matches(rand, reflect) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsSeed[0].t
+}
+
+// ExpectSeed expects calls of FakeCollider.Seed, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeCollider) ExpectSeed(t ColliderTestingT) *ColliderSeedExpectation {
+	t.Helper()
+	expectation := &ColliderSeedExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsSeed = append(f.expectationsSeed, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeCollider.Seed called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetSeedHook configures Collider.Seed to call the given function
+func (f *FakeCollider) SetSeedHook(hook func(*rand2.Rand, bool) error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.SeedHook = hook
+}
+
+// SetSeedStub configures Collider.Seed to always return the given values
+func (f *FakeCollider) SetSeedStub(ident1 error) {
+	f.SetSeedHook(func(*rand2.Rand, bool) error {
+		return ident1
+	})
+}
+
+// SetSeedReturnsOnCall configures Collider.Seed to return the given values from the call with the given index in SeedCalls, rather than calling the hook
+func (f *FakeCollider) SetSeedReturnsOnCall(call int, ident1 error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsSeed.Set(call, ColliderSeedResults{Ident1: ident1})
+}
+
+// SetSeedReturnsSequence configures the following calls of Collider.Seed to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeCollider) SetSeedReturnsSequence(exhausted ColliderExhausted, results ...ColliderSeedResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsSeed.Sequence(len(f.SeedCalls), exhausted, results)
+}
+
+// SetSeedError configures Collider.Seed to always return the given error, with zero values for its other results
+func (f *FakeCollider) SetSeedError(err error) {
+	f.SetSeedHook(func(*rand2.Rand, bool) (ident1 error) {
+		ident1 = err
+		return
+	})
+}
+
+// SetSeedErrorOnCall configures Collider.Seed to return the given error, with zero values for its other results, from the call with the given index in SeedCalls, rather than calling the hook
+func (f *FakeCollider) SetSeedErrorOnCall(call int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsSeed.Set(call, ColliderSeedResults{Ident1: err})
+}
+
+// SetSeedInvocation configures Collider.Seed to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f *FakeCollider) SetSeedInvocation(calls []*ColliderSeedInvocation, fallback func() error) {
+	f.SetSeedHook(func(rand *rand2.Rand, reflect bool) (ident1 error) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Rand, call.Parameters.Rand, rand) && fake.MatchParameter(call.Matchers.Reflect, call.Parameters.Reflect, reflect) {
+				ident1 = call.Results.Ident1
+
+				return
+			}
+		}
+
+		return fallback()
+	})
+}
+
+// SeedCallsSnapshot returns a copy of the calls made to FakeCollider.Seed
+func (f *FakeCollider) SeedCallsSnapshot() []*ColliderSeedInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ColliderSeedInvocation, len(f.SeedCalls))
+	for i, call := range f.SeedCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// SeedCall returns a ColliderCallMatcher selecting the calls of FakeCollider.Seed with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) SeedCall(rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) ColliderCallMatcher {
+	return fake.NewCallMatcher("FakeCollider.Seed(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ColliderSeedInvocation(nil), f.SeedCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
+// SeedCalled returns true if FakeCollider.Seed was called
+func (f *FakeCollider) SeedCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.SeedCalls) != 0
+}
+
+// AssertSeedCalled calls t.Error if FakeCollider.Seed was not called
+func (f *FakeCollider) AssertSeedCalled(t ColliderTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.SeedCalls) == 0 {
+		t.Error("FakeCollider.Seed not called, expected at least one")
+	}
+}
+
+// SeedNotCalled returns true if FakeCollider.Seed was not called
+func (f *FakeCollider) SeedNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.SeedCalls) == 0
+}
+
+// AssertSeedNotCalled calls t.Error if FakeCollider.Seed was called
+func (f *FakeCollider) AssertSeedNotCalled(t ColliderTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.SeedCalls) != 0 {
+		t.Error("FakeCollider.Seed called, expected none")
+	}
+}
+
+// SeedCalledOnce returns true if FakeCollider.Seed was called exactly once
+func (f *FakeCollider) SeedCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.SeedCalls) == 1
+}
+
+// AssertSeedCalledOnce calls t.Error if FakeCollider.Seed was not called exactly once
+func (f *FakeCollider) AssertSeedCalledOnce(t ColliderTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.SeedCalls) != 1 {
+		t.Errorf("FakeCollider.Seed called %d times, expected 1", len(f.SeedCalls))
+	}
+}
+
+// SeedCalledN returns true if FakeCollider.Seed was called at least n times
+func (f *FakeCollider) SeedCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.SeedCalls) >= n
+}
+
+// AssertSeedCalledN calls t.Error if FakeCollider.Seed was called less than n times
+func (f *FakeCollider) AssertSeedCalledN(t ColliderTestingT, n int) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.SeedCalls) < n {
+		t.Errorf("FakeCollider.Seed called %d times, expected >= %d", len(f.SeedCalls), n)
+	}
+}
+
+// WaitForSeedCalled blocks until FakeCollider.Seed has been called, returning the error of ctx if it is done first
+func (f *FakeCollider) WaitForSeedCalled(ctx context.Context) error {
+	return f.WaitForSeedCalledN(ctx, 1)
+}
+
+// WaitForSeedCalledN blocks until FakeCollider.Seed has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeCollider) WaitForSeedCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.SeedCalls) >= n
+	})
+}
+
+// AssertSeedEventuallyCalled calls t.Error if FakeCollider.Seed is not called within the timeout
+func (f *FakeCollider) AssertSeedEventuallyCalled(t ColliderTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForSeedCalled(ctx) != nil {
+		t.Errorf("FakeCollider.Seed not called within %v", timeout)
+	}
+}
+
+// describeSeedCalls describes the calls of FakeCollider.Seed against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeCollider) describeSeedCalls(rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tRand: %s", fake.Describe(rand))
+	fmt.Fprintf(&b, "\n\tReflect: %s", fake.Describe(reflect))
+
+	if len(f.SeedCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.SeedCalls {
+		matched := 0
+		if fake.Matches(rand, call.Parameters.Rand) {
+			matched++
+		}
+		if fake.Matches(reflect, call.Parameters.Reflect) {
+			matched++
+		}
+
+		if matched == 2 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.SeedCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(rand, call.Parameters.Rand) {
+		fmt.Fprintf(&b, "\n\tRand: got %s, want %s", fake.Format(call.Parameters.Rand), fake.Describe(rand))
+	}
+	if !fake.Matches(reflect, call.Parameters.Reflect) {
+		fmt.Fprintf(&b, "\n\tReflect: got %s, want %s", fake.Format(call.Parameters.Reflect), fake.Describe(reflect))
+	}
+
+	return b.String()
+}
+
+// SeedCalledWith returns true if FakeCollider.Seed was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) SeedCalledWith(rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.SeedCalls {
+		if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertSeedCalledWith calls t.Error if FakeCollider.Seed was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) AssertSeedCalledWith(t ColliderTestingT, rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.SeedCalls {
+		if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		t.Errorf("FakeCollider.Seed not called with expected parameters\n%s", f.describeSeedCalls(rand, reflect))
+	}
+}
+
+// SeedCalledOnceWith returns true if FakeCollider.Seed was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) SeedCalledOnceWith(rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.SeedCalls {
+		if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+			count++
+		}
+	}
+
+	return count == 1
+}
+
+// AssertSeedCalledOnceWith calls t.Error if FakeCollider.Seed was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) AssertSeedCalledOnceWith(t ColliderTestingT, rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.SeedCalls {
+		if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+			count++
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("FakeCollider.Seed called %d times with expected parameters, expected one\n%s", count, f.describeSeedCalls(rand, reflect))
+	}
+}
+
+// AssertSeedEventuallyCalledWith calls t.Error if FakeCollider.Seed is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeCollider) AssertSeedEventuallyCalledWith(t ColliderTestingT, timeout time.Duration, rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.SeedCalls)
+		for _, call := range f.SeedCalls {
+			if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeCollider.Seed not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeSeedCalls(rand, reflect))
+	}
+}
+
+// SeedResultsForCall returns the result values for the first call to FakeCollider.Seed with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) SeedResultsForCall(rand ColliderMatcher[*rand2.Rand], reflect ColliderMatcher[bool]) (ident1 error, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.SeedCalls {
+		if fake.Matches(rand, call.Parameters.Rand) && fake.Matches(reflect, call.Parameters.Reflect) {
+			ident1 = call.Results.Ident1
+			found = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f *FakeCollider) Intn(ident1 int) (ident2 int) {
+	f.mutex.Lock()
+	hook := f.IntnHook
+	expectation, t := f.expectedIntn(ident1)
+	var results ColliderIntnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsIntn.Lookup(len(f.IntnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Collider.Intn() called after the results given to FakeCollider.SetIntnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
+		panic("Collider.Intn() called but FakeCollider.IntnHook is nil")
+	}
+
+	invocation := new(ColliderIntnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.IntnCalls = append(f.IntnCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect2.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeCollider.Intn called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
+
+	return
+}
+
+// expectedIntn returns the first unsatisfied expectation of FakeCollider.Intn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeCollider) expectedIntn(ident1 int) (*ColliderIntnExpectation, ColliderTestingT) {
+	if len(f.expectationsIntn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsIntn {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsIntn[0].t
+}
+
+// ExpectIntn expects calls of FakeCollider.Intn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeCollider) ExpectIntn(t ColliderTestingT) *ColliderIntnExpectation {
+	t.Helper()
+	expectation := &ColliderIntnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsIntn = append(f.expectationsIntn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeCollider.Intn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetIntnHook configures Collider.Intn to call the given function
+func (f *FakeCollider) SetIntnHook(hook func(int) int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.IntnHook = hook
+}
+
+// SetIntnStub configures Collider.Intn to always return the given values
+func (f *FakeCollider) SetIntnStub(ident2 int) {
+	f.SetIntnHook(func(int) int {
+		return ident2
+	})
+}
+
+// SetIntnReturnsOnCall configures Collider.Intn to return the given values from the call with the given index in IntnCalls, rather than calling the hook
+func (f *FakeCollider) SetIntnReturnsOnCall(call int, ident2 int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsIntn.Set(call, ColliderIntnResults{Ident2: ident2})
+}
+
+// SetIntnReturnsSequence configures the following calls of Collider.Intn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeCollider) SetIntnReturnsSequence(exhausted ColliderExhausted, results ...ColliderIntnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsIntn.Sequence(len(f.IntnCalls), exhausted, results)
+}
+
+// SetIntnInvocation configures Collider.Intn to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f *FakeCollider) SetIntnInvocation(calls []*ColliderIntnInvocation, fallback func() int) {
+	f.SetIntnHook(func(ident1 int) (ident2 int) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
+
+				return
+			}
+		}
+
+		return fallback()
+	})
+}
+
+// IntnCallsSnapshot returns a copy of the calls made to FakeCollider.Intn
+func (f *FakeCollider) IntnCallsSnapshot() []*ColliderIntnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ColliderIntnInvocation, len(f.IntnCalls))
+	for i, call := range f.IntnCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// IntnCall returns a ColliderCallMatcher selecting the calls of FakeCollider.Intn with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) IntnCall(ident1 ColliderMatcher[int]) ColliderCallMatcher {
+	return fake.NewCallMatcher("FakeCollider.Intn(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ColliderIntnInvocation(nil), f.IntnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
+// IntnCalled returns true if FakeCollider.Intn was called
+func (f *FakeCollider) IntnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.IntnCalls) != 0
+}
+
+// AssertIntnCalled calls t.Error if FakeCollider.Intn was not called
+func (f *FakeCollider) AssertIntnCalled(t ColliderTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.IntnCalls) == 0 {
+		t.Error("FakeCollider.Intn not called, expected at least one")
+	}
+}
+
+// IntnNotCalled returns true if FakeCollider.Intn was not called
+func (f *FakeCollider) IntnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.IntnCalls) == 0
+}
+
+// AssertIntnNotCalled calls t.Error if FakeCollider.Intn was called
+func (f *FakeCollider) AssertIntnNotCalled(t ColliderTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.IntnCalls) != 0 {
+		t.Error("FakeCollider.Intn called, expected none")
+	}
+}
+
+// IntnCalledOnce returns true if FakeCollider.Intn was called exactly once
+func (f *FakeCollider) IntnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.IntnCalls) == 1
+}
+
+// AssertIntnCalledOnce calls t.Error if FakeCollider.Intn was not called exactly once
+func (f *FakeCollider) AssertIntnCalledOnce(t ColliderTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.IntnCalls) != 1 {
+		t.Errorf("FakeCollider.Intn called %d times, expected 1", len(f.IntnCalls))
+	}
+}
+
+// IntnCalledN returns true if FakeCollider.Intn was called at least n times
+func (f *FakeCollider) IntnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.IntnCalls) >= n
+}
+
+// AssertIntnCalledN calls t.Error if FakeCollider.Intn was called less than n times
+func (f *FakeCollider) AssertIntnCalledN(t ColliderTestingT, n int) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.IntnCalls) < n {
+		t.Errorf("FakeCollider.Intn called %d times, expected >= %d", len(f.IntnCalls), n)
+	}
+}
+
+// WaitForIntnCalled blocks until FakeCollider.Intn has been called, returning the error of ctx if it is done first
+func (f *FakeCollider) WaitForIntnCalled(ctx context.Context) error {
+	return f.WaitForIntnCalledN(ctx, 1)
+}
+
+// WaitForIntnCalledN blocks until FakeCollider.Intn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeCollider) WaitForIntnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.IntnCalls) >= n
+	})
+}
+
+// AssertIntnEventuallyCalled calls t.Error if FakeCollider.Intn is not called within the timeout
+func (f *FakeCollider) AssertIntnEventuallyCalled(t ColliderTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForIntnCalled(ctx) != nil {
+		t.Errorf("FakeCollider.Intn not called within %v", timeout)
+	}
+}
+
+// describeIntnCalls describes the calls of FakeCollider.Intn against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeCollider) describeIntnCalls(ident1 ColliderMatcher[int]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.IntnCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.IntnCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.IntnCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// IntnCalledWith returns true if FakeCollider.Intn was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) IntnCalledWith(ident1 ColliderMatcher[int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.IntnCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertIntnCalledWith calls t.Error if FakeCollider.Intn was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) AssertIntnCalledWith(t ColliderTestingT, ident1 ColliderMatcher[int]) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.IntnCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		t.Errorf("FakeCollider.Intn not called with expected parameters\n%s", f.describeIntnCalls(ident1))
+	}
+}
+
+// IntnCalledOnceWith returns true if FakeCollider.Intn was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) IntnCalledOnceWith(ident1 ColliderMatcher[int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.IntnCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
+		}
+	}
+
+	return count == 1
+}
+
+// AssertIntnCalledOnceWith calls t.Error if FakeCollider.Intn was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) AssertIntnCalledOnceWith(t ColliderTestingT, ident1 ColliderMatcher[int]) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.IntnCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("FakeCollider.Intn called %d times with expected parameters, expected one\n%s", count, f.describeIntnCalls(ident1))
+	}
+}
+
+// AssertIntnEventuallyCalledWith calls t.Error if FakeCollider.Intn is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeCollider) AssertIntnEventuallyCalledWith(t ColliderTestingT, timeout time.Duration, ident1 ColliderMatcher[int]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.IntnCalls)
+		for _, call := range f.IntnCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeCollider.Intn not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeIntnCalls(ident1))
+	}
+}
+
+// IntnResultsForCall returns the result values for the first call to FakeCollider.Intn with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeCollider) IntnResultsForCall(ident1 ColliderMatcher[int]) (ident2 int, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.IntnCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
+			break
+		}
+	}
+
+	return
+}
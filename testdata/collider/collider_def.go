@@ -0,0 +1,8 @@
+package main
+
+import "math/rand"
+
+type Collider interface {
+	Seed(rand *rand.Rand, reflect bool) error
+	Intn(int) int
+}
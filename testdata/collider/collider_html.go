@@ -0,0 +1,7 @@
+package main
+
+import "html/template"
+
+type Renderer interface {
+	Render(*template.Template) error
+}
@@ -0,0 +1,7 @@
+package main
+
+import "text/template"
+
+type Executor interface {
+	Execute(*template.Template) error
+}
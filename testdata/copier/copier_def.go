@@ -0,0 +1,13 @@
+package main
+
+type Request struct {
+	Path    string
+	Headers map[string][]string
+	Next    *Request
+	secret  []byte
+}
+
+type Copier interface {
+	Write(buf []byte, meta map[string]int) (int, error)
+	Send(req *Request, batch [2]*Request, tags ...string)
+}
@@ -0,0 +1,6 @@
+package main
+
+type Crowder[ctx comparable, done any, recorded any, err any, enabled any] interface {
+	Fetch(key ctx) (done, error)
+	Record(value recorded, flag enabled) err
+}
@@ -0,0 +1,6 @@
+package diagnoser
+
+func broken() int {
+	var n int = "one"
+	return n
+}
@@ -0,0 +1,14 @@
+package diagnoser
+
+// Good is declared in a package whose other files fail to type check
+type Good interface {
+	Get(key string) error
+}
+
+// Bad refers to an undefined type
+type Bad interface {
+	Put(value Undefined) error
+	Delete(key string) error
+}
+
+type Empty interface{}
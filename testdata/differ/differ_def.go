@@ -0,0 +1,5 @@
+package main
+
+type Differ interface {
+	Lookup(table string, id int, fields []string) (string, error)
+}
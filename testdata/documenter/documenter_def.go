@@ -0,0 +1,24 @@
+package main
+
+// Closer releases resources
+type Closer interface {
+	// Close releases the resources held by the receiver.
+	//
+	// Calling Close more than once has no effect.
+	Close() error
+}
+
+// Documenter stores documents by name.  Documents are stored whole and
+// cannot be updated in place.
+type Documenter interface {
+	Closer
+
+	// Get returns the named document.
+	Get(name string) ([]byte, error)
+	Put(name string, body []byte) error // Put stores the named document.
+	// Delete removes the named document.
+	//
+	// Deprecated: documents are kept forever, use Put with an empty body.
+	Delete(name string) error
+	Len() int
+}
@@ -2,13 +2,59 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // EmbedderStringInvocation represents a single call of FakeEmbedder.String
 type EmbedderStringInvocation struct {
-	Results struct {
-		Ident5 string
-	}
+	Results EmbedderStringResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *EmbedderStringInvocation) String() string {
+	return "FakeEmbedder.String()"
+}
+
+// EmbedderStringResults holds the results of a single call of FakeEmbedder.String
+type EmbedderStringResults struct {
+	Ident1 string
+}
+
+// EmbedderStringExpectation is a call of FakeEmbedder.String expected by a test, created by FakeEmbedder.ExpectString
+type EmbedderStringExpectation struct {
+	fake    *FakeEmbedder
+	t       EmbedderTestingT
+	times   int
+	count   int
+	returns bool
+	results EmbedderStringResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *EmbedderStringExpectation) Times(n int) *EmbedderStringExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *EmbedderStringExpectation) Return(ident1 string) *EmbedderStringExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = EmbedderStringResults{Ident1: ident1}
+	return e
 }
 
 // EmbedderEmbedInvocation represents a single call of FakeEmbedder.Embed
@@ -16,9 +62,23 @@ type EmbedderEmbedInvocation struct {
 	Parameters struct {
 		Ident1 string
 	}
-	Results struct {
-		Ident2 string
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetEmbedInvocation
+	Matchers struct {
+		Ident1 EmbedderMatcher[string]
 	}
+	Results EmbedderEmbedResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *EmbedderEmbedInvocation) String() string {
+	return fmt.Sprintf("FakeEmbedder.Embed%+v", i.Parameters)
+}
+
+// EmbedderEmbedResults holds the results of a single call of FakeEmbedder.Embed
+type EmbedderEmbedResults struct {
+	Ident2 string
 }
 
 // NewEmbedderEmbedInvocation creates a new instance of EmbedderEmbedInvocation
@@ -32,14 +92,71 @@ func NewEmbedderEmbedInvocation(ident1 string, ident2 string) *EmbedderEmbedInvo
 	return invocation
 }
 
+// EmbedderEmbedExpectation is a call of FakeEmbedder.Embed expected by a test, created by FakeEmbedder.ExpectEmbed
+type EmbedderEmbedExpectation struct {
+	fake     *FakeEmbedder
+	t        EmbedderTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 EmbedderMatcher[string]
+	}
+	returns bool
+	results EmbedderEmbedResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *EmbedderEmbedExpectation) With(ident1 EmbedderMatcher[string]) *EmbedderEmbedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *EmbedderEmbedExpectation) matches(ident1 string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *EmbedderEmbedExpectation) Times(n int) *EmbedderEmbedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *EmbedderEmbedExpectation) Return(ident2 string) *EmbedderEmbedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = EmbedderEmbedResults{Ident2: ident2}
+	return e
+}
+
 // EmbedderOtherInvocation represents a single call of FakeEmbedder.Other
 type EmbedderOtherInvocation struct {
 	Parameters struct {
 		Ident1 string
 	}
-	Results struct {
-		Ident2 string
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetOtherInvocation
+	Matchers struct {
+		Ident1 EmbedderMatcher[string]
 	}
+	Results EmbedderOtherResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *EmbedderOtherInvocation) String() string {
+	return fmt.Sprintf("FakeEmbedder.Other%+v", i.Parameters)
+}
+
+// EmbedderOtherResults holds the results of a single call of FakeEmbedder.Other
+type EmbedderOtherResults struct {
+	Ident2 string
 }
 
 // NewEmbedderOtherInvocation creates a new instance of EmbedderOtherInvocation
@@ -53,14 +170,120 @@ func NewEmbedderOtherInvocation(ident1 string, ident2 string) *EmbedderOtherInvo
 	return invocation
 }
 
+// EmbedderOtherExpectation is a call of FakeEmbedder.Other expected by a test, created by FakeEmbedder.ExpectOther
+type EmbedderOtherExpectation struct {
+	fake     *FakeEmbedder
+	t        EmbedderTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 EmbedderMatcher[string]
+	}
+	returns bool
+	results EmbedderOtherResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *EmbedderOtherExpectation) With(ident1 EmbedderMatcher[string]) *EmbedderOtherExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *EmbedderOtherExpectation) matches(ident1 string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *EmbedderOtherExpectation) Times(n int) *EmbedderOtherExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *EmbedderOtherExpectation) Return(ident2 string) *EmbedderOtherExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = EmbedderOtherResults{Ident2: ident2}
+	return e
+}
+
 // EmbedderTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type EmbedderTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type EmbedderTestingT = fake.TestingT
+
+// EmbedderMatcher matches a parameter of a call to FakeEmbedder
+type EmbedderMatcher[T any] interface {
+	Match(T) bool
 }
 
+// EmbedderMatcherFunc is a EmbedderMatcher implemented by a predicate function
+type EmbedderMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m EmbedderMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// EmbedderAny returns a EmbedderMatcher that matches any value
+func EmbedderAny[T any]() EmbedderMatcher[T] {
+	return fake.Any[T]()
+}
+
+// EmbedderEq returns a EmbedderMatcher that matches values deeply equal to want
+func EmbedderEq[T any](want T) EmbedderMatcher[T] {
+	return fake.Eq(want)
+}
+
+// EmbedderNot returns a EmbedderMatcher that matches the values the given matcher does not
+func EmbedderNot[T any](m EmbedderMatcher[T]) EmbedderMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// EmbedderPred returns a EmbedderMatcher that matches values for which the given predicate returns true
+func EmbedderPred[T any](pred func(T) bool) EmbedderMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// EmbedderAnyOfType returns a EmbedderMatcher that matches values whose dynamic type is, or implements, U
+func EmbedderAnyOfType[T, U any]() EmbedderMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// EmbedderCallMatcher selects calls of a method of a fake for EmbedderInOrder and EmbedderUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeEmbedder
+type EmbedderCallMatcher = fake.CallMatcher
+
+// EmbedderInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func EmbedderInOrder(t EmbedderTestingT, calls ...EmbedderCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// EmbedderUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func EmbedderUnordered(t EmbedderTestingT, calls ...EmbedderCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// EmbedderExhausted selects how a method of FakeEmbedder behaves once the results given to its SetXReturnsSequence method are used up
+type EmbedderExhausted = fake.Exhausted
+
+const (
+	// EmbedderRepeatLast returns the last results in the sequence from all later calls
+	EmbedderRepeatLast = fake.RepeatLast
+	// EmbedderPanicAfterSequence panics on all later calls
+	EmbedderPanicAfterSequence = fake.PanicAfterSequence
+	// EmbedderHookAfterSequence calls the method's hook on all later calls
+	EmbedderHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeEmbedder is a mock implementation of Embedder for testing.
 Use it in your tests as in this example:
@@ -69,7 +292,7 @@ Use it in your tests as in this example:
 
 	func TestWithEmbedder(t *testing.T) {
 		f := &main.FakeEmbedder{
-			StringHook: func() (ident5 string) {
+			StringHook: func() (ident1 string) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -77,13 +300,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeString ...
+		// assert state of FakeEmbedder ...
 		f.AssertStringCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeString.
+unexpected calls are made to FakeEmbedder.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeEmbedder struct {
 	StringHook func() string
@@ -93,12 +320,22 @@ type FakeEmbedder struct {
 	StringCalls []*EmbedderStringInvocation
 	EmbedCalls  []*EmbedderEmbedInvocation
 	OtherCalls  []*EmbedderOtherInvocation
+
+	returnsString      fake.Returns[EmbedderStringResults]
+	returnsEmbed       fake.Returns[EmbedderEmbedResults]
+	returnsOther       fake.Returns[EmbedderOtherResults]
+	expectationsString []*EmbedderStringExpectation
+	expectationsEmbed  []*EmbedderEmbedExpectation
+	expectationsOther  []*EmbedderOtherExpectation
+	mutex              sync.Mutex
+	recorded           chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters     bool
 }
 
 // NewFakeEmbedderDefaultPanic returns an instance of FakeEmbedder with all hooks configured to panic
 func NewFakeEmbedderDefaultPanic() *FakeEmbedder {
 	return &FakeEmbedder{
-		StringHook: func() (ident5 string) {
+		StringHook: func() (ident1 string) {
 			panic("Unexpected call to Embedder.String")
 		},
 		EmbedHook: func(string) (ident2 string) {
@@ -111,77 +348,279 @@ func NewFakeEmbedderDefaultPanic() *FakeEmbedder {
 }
 
 // NewFakeEmbedderDefaultFatal returns an instance of FakeEmbedder with all hooks configured to call t.Fatal
-func NewFakeEmbedderDefaultFatal(t_sym1 EmbedderTestingT) *FakeEmbedder {
+func NewFakeEmbedderDefaultFatal(t EmbedderTestingT) *FakeEmbedder {
 	return &FakeEmbedder{
-		StringHook: func() (ident5 string) {
-			t_sym1.Fatal("Unexpected call to Embedder.String")
+		StringHook: func() (ident1 string) {
+			t.Fatal("Unexpected call to Embedder.String")
 			return
 		},
 		EmbedHook: func(string) (ident2 string) {
-			t_sym1.Fatal("Unexpected call to Embedder.Embed")
+			t.Fatal("Unexpected call to Embedder.Embed")
 			return
 		},
 		OtherHook: func(string) (ident2 string) {
-			t_sym1.Fatal("Unexpected call to Embedder.Other")
+			t.Fatal("Unexpected call to Embedder.Other")
 			return
 		},
 	}
 }
 
 // NewFakeEmbedderDefaultError returns an instance of FakeEmbedder with all hooks configured to call t.Error
-func NewFakeEmbedderDefaultError(t_sym2 EmbedderTestingT) *FakeEmbedder {
+func NewFakeEmbedderDefaultError(t EmbedderTestingT) *FakeEmbedder {
+	return &FakeEmbedder{
+		StringHook: func() (ident1 string) {
+			t.Error("Unexpected call to Embedder.String")
+			return
+		},
+		EmbedHook: func(string) (ident2 string) {
+			t.Error("Unexpected call to Embedder.Embed")
+			return
+		},
+		OtherHook: func(string) (ident2 string) {
+			t.Error("Unexpected call to Embedder.Other")
+			return
+		},
+	}
+}
+
+// NewFakeEmbedderDefaultZero returns an instance of FakeEmbedder with all hooks configured to return zero values
+func NewFakeEmbedderDefaultZero() *FakeEmbedder {
 	return &FakeEmbedder{
-		StringHook: func() (ident5 string) {
-			t_sym2.Error("Unexpected call to Embedder.String")
+		StringHook: func() (ident1 string) {
 			return
 		},
 		EmbedHook: func(string) (ident2 string) {
-			t_sym2.Error("Unexpected call to Embedder.Embed")
 			return
 		},
 		OtherHook: func(string) (ident2 string) {
-			t_sym2.Error("Unexpected call to Embedder.Other")
 			return
 		},
 	}
 }
 
+// NewFakeEmbedderDefaultFriendly returns an instance of FakeEmbedder with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeEmbedder are friendly fakes, one for each method and result, created on first use
+func NewFakeEmbedderDefaultFriendly() *FakeEmbedder {
+
+	return &FakeEmbedder{
+		StringHook: func() (ident1 string) {
+			return
+		},
+		EmbedHook: func(string) (ident2 string) {
+			return
+		},
+		OtherHook: func(string) (ident2 string) {
+			return
+		},
+	}
+}
+
+// NewFakeEmbedderSpy returns an instance of FakeEmbedder with all hooks configured to call the given implementation
+func NewFakeEmbedderSpy(real Embedder) *FakeEmbedder {
+	return &FakeEmbedder{
+		StringHook: real.String,
+		EmbedHook:  real.Embed,
+		OtherHook:  real.Other,
+	}
+}
+
+// Reset forgets all calls made to FakeEmbedder
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeEmbedder) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsString.Rebase(len(f.StringCalls))
 	f.StringCalls = []*EmbedderStringInvocation{}
+	f.returnsEmbed.Rebase(len(f.EmbedCalls))
 	f.EmbedCalls = []*EmbedderEmbedInvocation{}
+	f.returnsOther.Rebase(len(f.OtherCalls))
 	f.OtherCalls = []*EmbedderOtherInvocation{}
 }
 
-func (f_sym3 *FakeEmbedder) String() (ident5 string) {
-	if f_sym3.StringHook == nil {
+// SetCopyParameters configures FakeEmbedder to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeEmbedder) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeEmbedder) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeEmbedder) String() (ident1 string) {
+	f.mutex.Lock()
+	hook := f.StringHook
+	expectation, t := f.expectedString()
+	var results EmbedderStringResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsString.Lookup(len(f.StringCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Embedder.String() called after the results given to FakeEmbedder.SetStringReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Embedder.String() called but FakeEmbedder.StringHook is nil")
 	}
 
-	invocation_sym3 := new(EmbedderStringInvocation)
-	f_sym3.StringCalls = append(f_sym3.StringCalls, invocation_sym3)
+	invocation := new(EmbedderStringInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.StringCalls = append(f.StringCalls, invocation)
 
-	ident5 = f_sym3.StringHook()
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeEmbedder.String called more times than expected")
+	}
+
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
 
-	invocation_sym3.Results.Ident5 = ident5
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedString returns the first unsatisfied expectation of FakeEmbedder.String matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeEmbedder) expectedString() (*EmbedderStringExpectation, EmbedderTestingT) {
+	if len(f.expectationsString) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsString {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsString[0].t
+}
+
+// ExpectString expects calls of FakeEmbedder.String, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeEmbedder) ExpectString(t EmbedderTestingT) *EmbedderStringExpectation {
+	t.Helper()
+	expectation := &EmbedderStringExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsString = append(f.expectationsString, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeEmbedder.String called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetStringHook configures Embedder.String to call the given function
+func (f *FakeEmbedder) SetStringHook(hook func() string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.StringHook = hook
+}
+
 // SetStringStub configures Embedder.String to always return the given values
-func (f_sym4 *FakeEmbedder) SetStringStub(ident5 string) {
-	f_sym4.StringHook = func() string {
-		return ident5
+func (f *FakeEmbedder) SetStringStub(ident1 string) {
+	f.SetStringHook(func() string {
+		return ident1
+	})
+}
+
+// SetStringReturnsOnCall configures Embedder.String to return the given values from the call with the given index in StringCalls, rather than calling the hook
+func (f *FakeEmbedder) SetStringReturnsOnCall(call int, ident1 string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsString.Set(call, EmbedderStringResults{Ident1: ident1})
+}
+
+// SetStringReturnsSequence configures the following calls of Embedder.String to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeEmbedder) SetStringReturnsSequence(exhausted EmbedderExhausted, results ...EmbedderStringResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsString.Sequence(len(f.StringCalls), exhausted, results)
+}
+
+// StringCallsSnapshot returns a copy of the calls made to FakeEmbedder.String
+func (f *FakeEmbedder) StringCallsSnapshot() []*EmbedderStringInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*EmbedderStringInvocation, len(f.StringCalls))
+	for i, call := range f.StringCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// StringCall returns a EmbedderCallMatcher selecting the calls of FakeEmbedder.String
+func (f *FakeEmbedder) StringCall() EmbedderCallMatcher {
+	return fake.NewCallMatcher("FakeEmbedder.String()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*EmbedderStringInvocation(nil), f.StringCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // StringCalled returns true if FakeEmbedder.String was called
 func (f *FakeEmbedder) StringCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StringCalls) != 0
 }
 
 // AssertStringCalled calls t.Error if FakeEmbedder.String was not called
 func (f *FakeEmbedder) AssertStringCalled(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StringCalls) == 0 {
 		t.Error("FakeEmbedder.String not called, expected at least one")
 	}
@@ -189,12 +628,16 @@ func (f *FakeEmbedder) AssertStringCalled(t EmbedderTestingT) {
 
 // StringNotCalled returns true if FakeEmbedder.String was not called
 func (f *FakeEmbedder) StringNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StringCalls) == 0
 }
 
 // AssertStringNotCalled calls t.Error if FakeEmbedder.String was called
 func (f *FakeEmbedder) AssertStringNotCalled(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StringCalls) != 0 {
 		t.Error("FakeEmbedder.String called, expected none")
 	}
@@ -202,12 +645,16 @@ func (f *FakeEmbedder) AssertStringNotCalled(t EmbedderTestingT) {
 
 // StringCalledOnce returns true if FakeEmbedder.String was called exactly once
 func (f *FakeEmbedder) StringCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StringCalls) == 1
 }
 
 // AssertStringCalledOnce calls t.Error if FakeEmbedder.String was not called exactly once
 func (f *FakeEmbedder) AssertStringCalledOnce(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StringCalls) != 1 {
 		t.Errorf("FakeEmbedder.String called %d times, expected 1", len(f.StringCalls))
 	}
@@ -215,65 +662,222 @@ func (f *FakeEmbedder) AssertStringCalledOnce(t EmbedderTestingT) {
 
 // StringCalledN returns true if FakeEmbedder.String was called at least n times
 func (f *FakeEmbedder) StringCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StringCalls) >= n
 }
 
 // AssertStringCalledN calls t.Error if FakeEmbedder.String was called less than n times
 func (f *FakeEmbedder) AssertStringCalledN(t EmbedderTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StringCalls) < n {
 		t.Errorf("FakeEmbedder.String called %d times, expected >= %d", len(f.StringCalls), n)
 	}
 }
 
-func (f_sym5 *FakeEmbedder) Embed(ident1 string) (ident2 string) {
-	if f_sym5.EmbedHook == nil {
+// WaitForStringCalled blocks until FakeEmbedder.String has been called, returning the error of ctx if it is done first
+func (f *FakeEmbedder) WaitForStringCalled(ctx context.Context) error {
+	return f.WaitForStringCalledN(ctx, 1)
+}
+
+// WaitForStringCalledN blocks until FakeEmbedder.String has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeEmbedder) WaitForStringCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.StringCalls) >= n
+	})
+}
+
+// AssertStringEventuallyCalled calls t.Error if FakeEmbedder.String is not called within the timeout
+func (f *FakeEmbedder) AssertStringEventuallyCalled(t EmbedderTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForStringCalled(ctx) != nil {
+		t.Errorf("FakeEmbedder.String not called within %v", timeout)
+	}
+}
+
+func (f *FakeEmbedder) Embed(ident1 string) (ident2 string) {
+	f.mutex.Lock()
+	hook := f.EmbedHook
+	expectation, t := f.expectedEmbed(ident1)
+	var results EmbedderEmbedResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsEmbed.Lookup(len(f.EmbedCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Embedder.Embed() called after the results given to FakeEmbedder.SetEmbedReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Embedder.Embed() called but FakeEmbedder.EmbedHook is nil")
 	}
 
-	invocation_sym5 := new(EmbedderEmbedInvocation)
-	f_sym5.EmbedCalls = append(f_sym5.EmbedCalls, invocation_sym5)
+	invocation := new(EmbedderEmbedInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.EmbedCalls = append(f.EmbedCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym5.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeEmbedder.Embed called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ident2 = f_sym5.EmbedHook(ident1)
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym5.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedEmbed returns the first unsatisfied expectation of FakeEmbedder.Embed matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeEmbedder) expectedEmbed(ident1 string) (*EmbedderEmbedExpectation, EmbedderTestingT) {
+	if len(f.expectationsEmbed) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsEmbed {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsEmbed[0].t
+}
+
+// ExpectEmbed expects calls of FakeEmbedder.Embed, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeEmbedder) ExpectEmbed(t EmbedderTestingT) *EmbedderEmbedExpectation {
+	t.Helper()
+	expectation := &EmbedderEmbedExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsEmbed = append(f.expectationsEmbed, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeEmbedder.Embed called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetEmbedHook configures Embedder.Embed to call the given function
+func (f *FakeEmbedder) SetEmbedHook(hook func(string) string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.EmbedHook = hook
+}
+
 // SetEmbedStub configures Embedder.Embed to always return the given values
-func (f_sym6 *FakeEmbedder) SetEmbedStub(ident2 string) {
-	f_sym6.EmbedHook = func(string) string {
+func (f *FakeEmbedder) SetEmbedStub(ident2 string) {
+	f.SetEmbedHook(func(string) string {
 		return ident2
-	}
+	})
+}
+
+// SetEmbedReturnsOnCall configures Embedder.Embed to return the given values from the call with the given index in EmbedCalls, rather than calling the hook
+func (f *FakeEmbedder) SetEmbedReturnsOnCall(call int, ident2 string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsEmbed.Set(call, EmbedderEmbedResults{Ident2: ident2})
+}
+
+// SetEmbedReturnsSequence configures the following calls of Embedder.Embed to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeEmbedder) SetEmbedReturnsSequence(exhausted EmbedderExhausted, results ...EmbedderEmbedResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsEmbed.Sequence(len(f.EmbedCalls), exhausted, results)
 }
 
 // SetEmbedInvocation configures Embedder.Embed to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym7 *FakeEmbedder) SetEmbedInvocation(calls_sym7 []*EmbedderEmbedInvocation, fallback_sym7 func() string) {
-	f_sym7.EmbedHook = func(ident1 string) (ident2 string) {
-		for _, call_sym7 := range calls_sym7 {
-			if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-				ident2 = call_sym7.Results.Ident2
+func (f *FakeEmbedder) SetEmbedInvocation(calls []*EmbedderEmbedInvocation, fallback func() string) {
+	f.SetEmbedHook(func(ident1 string) (ident2 string) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym7()
+		return fallback()
+	})
+}
+
+// EmbedCallsSnapshot returns a copy of the calls made to FakeEmbedder.Embed
+func (f *FakeEmbedder) EmbedCallsSnapshot() []*EmbedderEmbedInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*EmbedderEmbedInvocation, len(f.EmbedCalls))
+	for i, call := range f.EmbedCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// EmbedCall returns a EmbedderCallMatcher selecting the calls of FakeEmbedder.Embed with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) EmbedCall(ident1 EmbedderMatcher[string]) EmbedderCallMatcher {
+	return fake.NewCallMatcher("FakeEmbedder.Embed(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*EmbedderEmbedInvocation(nil), f.EmbedCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // EmbedCalled returns true if FakeEmbedder.Embed was called
 func (f *FakeEmbedder) EmbedCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.EmbedCalls) != 0
 }
 
 // AssertEmbedCalled calls t.Error if FakeEmbedder.Embed was not called
 func (f *FakeEmbedder) AssertEmbedCalled(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.EmbedCalls) == 0 {
 		t.Error("FakeEmbedder.Embed not called, expected at least one")
 	}
@@ -281,12 +885,16 @@ func (f *FakeEmbedder) AssertEmbedCalled(t EmbedderTestingT) {
 
 // EmbedNotCalled returns true if FakeEmbedder.Embed was not called
 func (f *FakeEmbedder) EmbedNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.EmbedCalls) == 0
 }
 
 // AssertEmbedNotCalled calls t.Error if FakeEmbedder.Embed was called
 func (f *FakeEmbedder) AssertEmbedNotCalled(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.EmbedCalls) != 0 {
 		t.Error("FakeEmbedder.Embed called, expected none")
 	}
@@ -294,12 +902,16 @@ func (f *FakeEmbedder) AssertEmbedNotCalled(t EmbedderTestingT) {
 
 // EmbedCalledOnce returns true if FakeEmbedder.Embed was called exactly once
 func (f *FakeEmbedder) EmbedCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.EmbedCalls) == 1
 }
 
 // AssertEmbedCalledOnce calls t.Error if FakeEmbedder.Embed was not called exactly once
 func (f *FakeEmbedder) AssertEmbedCalledOnce(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.EmbedCalls) != 1 {
 		t.Errorf("FakeEmbedder.Embed called %d times, expected 1", len(f.EmbedCalls))
 	}
@@ -307,21 +919,92 @@ func (f *FakeEmbedder) AssertEmbedCalledOnce(t EmbedderTestingT) {
 
 // EmbedCalledN returns true if FakeEmbedder.Embed was called at least n times
 func (f *FakeEmbedder) EmbedCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.EmbedCalls) >= n
 }
 
 // AssertEmbedCalledN calls t.Error if FakeEmbedder.Embed was called less than n times
 func (f *FakeEmbedder) AssertEmbedCalledN(t EmbedderTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.EmbedCalls) < n {
 		t.Errorf("FakeEmbedder.Embed called %d times, expected >= %d", len(f.EmbedCalls), n)
 	}
 }
 
-// EmbedCalledWith returns true if FakeEmbedder.Embed was called with the given values
-func (f_sym8 *FakeEmbedder) EmbedCalledWith(ident1 string) bool {
-	for _, call_sym8 := range f_sym8.EmbedCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
+// WaitForEmbedCalled blocks until FakeEmbedder.Embed has been called, returning the error of ctx if it is done first
+func (f *FakeEmbedder) WaitForEmbedCalled(ctx context.Context) error {
+	return f.WaitForEmbedCalledN(ctx, 1)
+}
+
+// WaitForEmbedCalledN blocks until FakeEmbedder.Embed has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeEmbedder) WaitForEmbedCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.EmbedCalls) >= n
+	})
+}
+
+// AssertEmbedEventuallyCalled calls t.Error if FakeEmbedder.Embed is not called within the timeout
+func (f *FakeEmbedder) AssertEmbedEventuallyCalled(t EmbedderTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForEmbedCalled(ctx) != nil {
+		t.Errorf("FakeEmbedder.Embed not called within %v", timeout)
+	}
+}
+
+// describeEmbedCalls describes the calls of FakeEmbedder.Embed against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeEmbedder) describeEmbedCalls(ident1 EmbedderMatcher[string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.EmbedCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.EmbedCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.EmbedCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// EmbedCalledWith returns true if FakeEmbedder.Embed was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) EmbedCalledWith(ident1 EmbedderMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.EmbedCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -329,55 +1012,86 @@ func (f_sym8 *FakeEmbedder) EmbedCalledWith(ident1 string) bool {
 	return false
 }
 
-// AssertEmbedCalledWith calls t.Error if FakeEmbedder.Embed was not called with the given values
-func (f_sym9 *FakeEmbedder) AssertEmbedCalledWith(t EmbedderTestingT, ident1 string) {
+// AssertEmbedCalledWith calls t.Error if FakeEmbedder.Embed was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) AssertEmbedCalledWith(t EmbedderTestingT, ident1 EmbedderMatcher[string]) {
 	t.Helper()
-	var found_sym9 bool
-	for _, call_sym9 := range f_sym9.EmbedCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			found_sym9 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.EmbedCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym9 {
-		t.Error("FakeEmbedder.Embed not called with expected parameters")
+	if !found {
+		t.Errorf("FakeEmbedder.Embed not called with expected parameters\n%s", f.describeEmbedCalls(ident1))
 	}
 }
 
-// EmbedCalledOnceWith returns true if FakeEmbedder.Embed was called exactly once with the given values
-func (f_sym10 *FakeEmbedder) EmbedCalledOnceWith(ident1 string) bool {
-	var count_sym10 int
-	for _, call_sym10 := range f_sym10.EmbedCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			count_sym10++
+// EmbedCalledOnceWith returns true if FakeEmbedder.Embed was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) EmbedCalledOnceWith(ident1 EmbedderMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.EmbedCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym10 == 1
+	return count == 1
 }
 
-// AssertEmbedCalledOnceWith calls t.Error if FakeEmbedder.Embed was not called exactly once with the given values
-func (f_sym11 *FakeEmbedder) AssertEmbedCalledOnceWith(t EmbedderTestingT, ident1 string) {
+// AssertEmbedCalledOnceWith calls t.Error if FakeEmbedder.Embed was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) AssertEmbedCalledOnceWith(t EmbedderTestingT, ident1 EmbedderMatcher[string]) {
 	t.Helper()
-	var count_sym11 int
-	for _, call_sym11 := range f_sym11.EmbedCalls {
-		if reflect.DeepEqual(call_sym11.Parameters.Ident1, ident1) {
-			count_sym11++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.EmbedCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym11 != 1 {
-		t.Errorf("FakeEmbedder.Embed called %d times with expected parameters, expected one", count_sym11)
+	if count != 1 {
+		t.Errorf("FakeEmbedder.Embed called %d times with expected parameters, expected one\n%s", count, f.describeEmbedCalls(ident1))
+	}
+}
+
+// AssertEmbedEventuallyCalledWith calls t.Error if FakeEmbedder.Embed is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeEmbedder) AssertEmbedEventuallyCalledWith(t EmbedderTestingT, timeout time.Duration, ident1 EmbedderMatcher[string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.EmbedCalls)
+		for _, call := range f.EmbedCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeEmbedder.Embed not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeEmbedCalls(ident1))
 	}
 }
 
-// EmbedResultsForCall returns the result values for the first call to FakeEmbedder.Embed with the given values
-func (f_sym12 *FakeEmbedder) EmbedResultsForCall(ident1 string) (ident2 string, found_sym12 bool) {
-	for _, call_sym12 := range f_sym12.EmbedCalls {
-		if reflect.DeepEqual(call_sym12.Parameters.Ident1, ident1) {
-			ident2 = call_sym12.Results.Ident2
-			found_sym12 = true
+// EmbedResultsForCall returns the result values for the first call to FakeEmbedder.Embed with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) EmbedResultsForCall(ident1 EmbedderMatcher[string]) (ident2 string, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.EmbedCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -385,54 +1099,185 @@ func (f_sym12 *FakeEmbedder) EmbedResultsForCall(ident1 string) (ident2 string,
 	return
 }
 
-func (f_sym13 *FakeEmbedder) Other(ident1 string) (ident2 string) {
-	if f_sym13.OtherHook == nil {
+func (f *FakeEmbedder) Other(ident1 string) (ident2 string) {
+	f.mutex.Lock()
+	hook := f.OtherHook
+	expectation, t := f.expectedOther(ident1)
+	var results EmbedderOtherResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsOther.Lookup(len(f.OtherCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Embedder.Other() called after the results given to FakeEmbedder.SetOtherReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Embedder.Other() called but FakeEmbedder.OtherHook is nil")
 	}
 
-	invocation_sym13 := new(EmbedderOtherInvocation)
-	f_sym13.OtherCalls = append(f_sym13.OtherCalls, invocation_sym13)
+	invocation := new(EmbedderOtherInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.OtherCalls = append(f.OtherCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym13.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeEmbedder.Other called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ident2 = f_sym13.OtherHook(ident1)
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym13.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedOther returns the first unsatisfied expectation of FakeEmbedder.Other matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeEmbedder) expectedOther(ident1 string) (*EmbedderOtherExpectation, EmbedderTestingT) {
+	if len(f.expectationsOther) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsOther {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsOther[0].t
+}
+
+// ExpectOther expects calls of FakeEmbedder.Other, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeEmbedder) ExpectOther(t EmbedderTestingT) *EmbedderOtherExpectation {
+	t.Helper()
+	expectation := &EmbedderOtherExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsOther = append(f.expectationsOther, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeEmbedder.Other called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetOtherHook configures Embedder.Other to call the given function
+func (f *FakeEmbedder) SetOtherHook(hook func(string) string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.OtherHook = hook
+}
+
 // SetOtherStub configures Embedder.Other to always return the given values
-func (f_sym14 *FakeEmbedder) SetOtherStub(ident2 string) {
-	f_sym14.OtherHook = func(string) string {
+func (f *FakeEmbedder) SetOtherStub(ident2 string) {
+	f.SetOtherHook(func(string) string {
 		return ident2
-	}
+	})
+}
+
+// SetOtherReturnsOnCall configures Embedder.Other to return the given values from the call with the given index in OtherCalls, rather than calling the hook
+func (f *FakeEmbedder) SetOtherReturnsOnCall(call int, ident2 string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsOther.Set(call, EmbedderOtherResults{Ident2: ident2})
+}
+
+// SetOtherReturnsSequence configures the following calls of Embedder.Other to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeEmbedder) SetOtherReturnsSequence(exhausted EmbedderExhausted, results ...EmbedderOtherResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsOther.Sequence(len(f.OtherCalls), exhausted, results)
 }
 
 // SetOtherInvocation configures Embedder.Other to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym15 *FakeEmbedder) SetOtherInvocation(calls_sym15 []*EmbedderOtherInvocation, fallback_sym15 func() string) {
-	f_sym15.OtherHook = func(ident1 string) (ident2 string) {
-		for _, call_sym15 := range calls_sym15 {
-			if reflect.DeepEqual(call_sym15.Parameters.Ident1, ident1) {
-				ident2 = call_sym15.Results.Ident2
+func (f *FakeEmbedder) SetOtherInvocation(calls []*EmbedderOtherInvocation, fallback func() string) {
+	f.SetOtherHook(func(ident1 string) (ident2 string) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym15()
+		return fallback()
+	})
+}
+
+// OtherCallsSnapshot returns a copy of the calls made to FakeEmbedder.Other
+func (f *FakeEmbedder) OtherCallsSnapshot() []*EmbedderOtherInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*EmbedderOtherInvocation, len(f.OtherCalls))
+	for i, call := range f.OtherCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// OtherCall returns a EmbedderCallMatcher selecting the calls of FakeEmbedder.Other with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) OtherCall(ident1 EmbedderMatcher[string]) EmbedderCallMatcher {
+	return fake.NewCallMatcher("FakeEmbedder.Other(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*EmbedderOtherInvocation(nil), f.OtherCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // OtherCalled returns true if FakeEmbedder.Other was called
 func (f *FakeEmbedder) OtherCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.OtherCalls) != 0
 }
 
 // AssertOtherCalled calls t.Error if FakeEmbedder.Other was not called
 func (f *FakeEmbedder) AssertOtherCalled(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.OtherCalls) == 0 {
 		t.Error("FakeEmbedder.Other not called, expected at least one")
 	}
@@ -440,12 +1285,16 @@ func (f *FakeEmbedder) AssertOtherCalled(t EmbedderTestingT) {
 
 // OtherNotCalled returns true if FakeEmbedder.Other was not called
 func (f *FakeEmbedder) OtherNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.OtherCalls) == 0
 }
 
 // AssertOtherNotCalled calls t.Error if FakeEmbedder.Other was called
 func (f *FakeEmbedder) AssertOtherNotCalled(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.OtherCalls) != 0 {
 		t.Error("FakeEmbedder.Other called, expected none")
 	}
@@ -453,12 +1302,16 @@ func (f *FakeEmbedder) AssertOtherNotCalled(t EmbedderTestingT) {
 
 // OtherCalledOnce returns true if FakeEmbedder.Other was called exactly once
 func (f *FakeEmbedder) OtherCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.OtherCalls) == 1
 }
 
 // AssertOtherCalledOnce calls t.Error if FakeEmbedder.Other was not called exactly once
 func (f *FakeEmbedder) AssertOtherCalledOnce(t EmbedderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.OtherCalls) != 1 {
 		t.Errorf("FakeEmbedder.Other called %d times, expected 1", len(f.OtherCalls))
 	}
@@ -466,21 +1319,92 @@ func (f *FakeEmbedder) AssertOtherCalledOnce(t EmbedderTestingT) {
 
 // OtherCalledN returns true if FakeEmbedder.Other was called at least n times
 func (f *FakeEmbedder) OtherCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.OtherCalls) >= n
 }
 
 // AssertOtherCalledN calls t.Error if FakeEmbedder.Other was called less than n times
 func (f *FakeEmbedder) AssertOtherCalledN(t EmbedderTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.OtherCalls) < n {
 		t.Errorf("FakeEmbedder.Other called %d times, expected >= %d", len(f.OtherCalls), n)
 	}
 }
 
-// OtherCalledWith returns true if FakeEmbedder.Other was called with the given values
-func (f_sym16 *FakeEmbedder) OtherCalledWith(ident1 string) bool {
-	for _, call_sym16 := range f_sym16.OtherCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.Ident1, ident1) {
+// WaitForOtherCalled blocks until FakeEmbedder.Other has been called, returning the error of ctx if it is done first
+func (f *FakeEmbedder) WaitForOtherCalled(ctx context.Context) error {
+	return f.WaitForOtherCalledN(ctx, 1)
+}
+
+// WaitForOtherCalledN blocks until FakeEmbedder.Other has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeEmbedder) WaitForOtherCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.OtherCalls) >= n
+	})
+}
+
+// AssertOtherEventuallyCalled calls t.Error if FakeEmbedder.Other is not called within the timeout
+func (f *FakeEmbedder) AssertOtherEventuallyCalled(t EmbedderTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForOtherCalled(ctx) != nil {
+		t.Errorf("FakeEmbedder.Other not called within %v", timeout)
+	}
+}
+
+// describeOtherCalls describes the calls of FakeEmbedder.Other against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeEmbedder) describeOtherCalls(ident1 EmbedderMatcher[string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.OtherCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.OtherCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.OtherCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// OtherCalledWith returns true if FakeEmbedder.Other was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) OtherCalledWith(ident1 EmbedderMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.OtherCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -488,55 +1412,86 @@ func (f_sym16 *FakeEmbedder) OtherCalledWith(ident1 string) bool {
 	return false
 }
 
-// AssertOtherCalledWith calls t.Error if FakeEmbedder.Other was not called with the given values
-func (f_sym17 *FakeEmbedder) AssertOtherCalledWith(t EmbedderTestingT, ident1 string) {
+// AssertOtherCalledWith calls t.Error if FakeEmbedder.Other was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) AssertOtherCalledWith(t EmbedderTestingT, ident1 EmbedderMatcher[string]) {
 	t.Helper()
-	var found_sym17 bool
-	for _, call_sym17 := range f_sym17.OtherCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.Ident1, ident1) {
-			found_sym17 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.OtherCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym17 {
-		t.Error("FakeEmbedder.Other not called with expected parameters")
+	if !found {
+		t.Errorf("FakeEmbedder.Other not called with expected parameters\n%s", f.describeOtherCalls(ident1))
 	}
 }
 
-// OtherCalledOnceWith returns true if FakeEmbedder.Other was called exactly once with the given values
-func (f_sym18 *FakeEmbedder) OtherCalledOnceWith(ident1 string) bool {
-	var count_sym18 int
-	for _, call_sym18 := range f_sym18.OtherCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.Ident1, ident1) {
-			count_sym18++
+// OtherCalledOnceWith returns true if FakeEmbedder.Other was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) OtherCalledOnceWith(ident1 EmbedderMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.OtherCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym18 == 1
+	return count == 1
 }
 
-// AssertOtherCalledOnceWith calls t.Error if FakeEmbedder.Other was not called exactly once with the given values
-func (f_sym19 *FakeEmbedder) AssertOtherCalledOnceWith(t EmbedderTestingT, ident1 string) {
+// AssertOtherCalledOnceWith calls t.Error if FakeEmbedder.Other was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) AssertOtherCalledOnceWith(t EmbedderTestingT, ident1 EmbedderMatcher[string]) {
 	t.Helper()
-	var count_sym19 int
-	for _, call_sym19 := range f_sym19.OtherCalls {
-		if reflect.DeepEqual(call_sym19.Parameters.Ident1, ident1) {
-			count_sym19++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.OtherCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym19 != 1 {
-		t.Errorf("FakeEmbedder.Other called %d times with expected parameters, expected one", count_sym19)
+	if count != 1 {
+		t.Errorf("FakeEmbedder.Other called %d times with expected parameters, expected one\n%s", count, f.describeOtherCalls(ident1))
+	}
+}
+
+// AssertOtherEventuallyCalledWith calls t.Error if FakeEmbedder.Other is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeEmbedder) AssertOtherEventuallyCalledWith(t EmbedderTestingT, timeout time.Duration, ident1 EmbedderMatcher[string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.OtherCalls)
+		for _, call := range f.OtherCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeEmbedder.Other not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeOtherCalls(ident1))
 	}
 }
 
-// OtherResultsForCall returns the result values for the first call to FakeEmbedder.Other with the given values
-func (f_sym20 *FakeEmbedder) OtherResultsForCall(ident1 string) (ident2 string, found_sym20 bool) {
-	for _, call_sym20 := range f_sym20.OtherCalls {
-		if reflect.DeepEqual(call_sym20.Parameters.Ident1, ident1) {
-			ident2 = call_sym20.Results.Ident2
-			found_sym20 = true
+// OtherResultsForCall returns the result values for the first call to FakeEmbedder.Other with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeEmbedder) OtherResultsForCall(ident1 EmbedderMatcher[string]) (ident2 string, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.OtherCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -50,10 +50,10 @@ func main() {
 	if !f.ArrayParameterCalledN(1) {
 		panic("ArrayParameterCalledN: ArrayParameter not called once")
 	}
-	if !f.ArrayParameterCalledWith(a) {
+	if !f.ArrayParameterCalledWith(ArrayEq(a)) {
 		panic(fmt.Sprintf("ArrayParameterCalledWith: ArrayParameter not called with %s", a))
 	}
-	if !f.ArrayParameterCalledOnceWith(a) {
+	if !f.ArrayParameterCalledOnceWith(ArrayEq(a)) {
 		panic(fmt.Sprintf("ArrayParameterCalledOnceWith: ArrayParameter not called once with %s", a))
 	}
 
@@ -101,10 +101,10 @@ func main() {
 	if !f.SliceParameterCalledN(1) {
 		panic("SliceParameterCalledN: SliceParameter not called once")
 	}
-	if !f.SliceParameterCalledWith(s) {
+	if !f.SliceParameterCalledWith(ArrayEq(s)) {
 		panic(fmt.Sprintf("SliceParameterCalledWith: SliceParameter not called with %s", s))
 	}
-	if !f.SliceParameterCalledOnceWith(s) {
+	if !f.SliceParameterCalledOnceWith(ArrayEq(s)) {
 		panic(fmt.Sprintf("SliceParameterCalledOnceWith: SliceParameter not called once with %s", s))
 	}
 
@@ -53,7 +53,7 @@ func testChannel() {
 }
 
 func testChannelReceive() {
-	var found int
+	received := make(chan int)
 	hookCalled := false
 	expected := 9
 
@@ -61,7 +61,7 @@ func testChannelReceive() {
 		ChannelReceiveHook: func(c <-chan int) <-chan int {
 			hookCalled = true
 			go func() {
-				found = <-c
+				received <- <-c
 			}()
 
 			return c
@@ -75,7 +75,7 @@ func testChannelReceive() {
 
 	input <- expected
 
-	if found != expected {
+	if found := <-received; found != expected {
 		panic(fmt.Sprintf("ChannelReceive returned unexpected value: %s", found))
 	}
 	if output != input {
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+var _ Collider = &FakeCollider{}
+
+func main() {
+	f := NewFakeColliderDefaultPanic()
+	f.SetSeedStub(nil)
+	negative := NewColliderIntnInvocation(0, -1)
+	negative.Matchers.Ident1 = ColliderPred(func(n int) bool { return n < 0 })
+	f.SetIntnInvocation([]*ColliderIntnInvocation{NewColliderIntnInvocation(4, 2), negative}, func() int { return 0 })
+
+	source := rand.New(rand.NewSource(1))
+	if err := f.Seed(source, true); err != nil {
+		panic(fmt.Sprintf("Seed: unexpected error %s", err))
+	}
+	if !f.SeedCalledOnceWith(ColliderEq(source), ColliderEq(true)) {
+		panic("SeedCalledOnceWith: Seed not called once with source, true")
+	}
+	if f.SeedCalledWith(ColliderEq(source), ColliderEq(false)) {
+		panic("SeedCalledWith: Seed called with source, false")
+	}
+
+	if n := f.Intn(4); n != 2 {
+		panic(fmt.Sprintf("Intn: %d, expected 2", n))
+	}
+	if n := f.Intn(5); n != 0 {
+		panic(fmt.Sprintf("Intn: %d, expected 0", n))
+	}
+	if n := f.Intn(-3); n != -1 {
+		panic(fmt.Sprintf("Intn: %d, expected -1", n))
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var _ Copier = &FakeCopier{}
+
+func main() {
+	f := &FakeCopier{}
+	f.SetWriteStub(0, nil)
+	f.SetSendHook(func(*Request, [2]*Request, ...string) {})
+
+	// by default the recorded parameters share values with the caller
+	buf := []byte("first")
+	f.Write(buf, nil)
+	copy(buf, "reuse")
+	if got := string(f.WriteCallsSnapshot()[0].Parameters.Buf); got != "reuse" {
+		panic(fmt.Sprintf("Write: recorded %q without copying, expected the mutated buffer", got))
+	}
+
+	f.SetCopyParameters(true)
+	copy(buf, "first")
+	meta := map[string]int{"n": 1}
+	f.Write(buf, meta)
+	copy(buf, "reuse")
+	meta["n"] = 2
+	if !f.WriteCalledWith(CopierEq([]byte("first")), CopierEq(map[string]int{"n": 1})) {
+		panic(fmt.Sprintf("Write: copied parameters changed: %+v", f.WriteCallsSnapshot()[1].Parameters))
+	}
+
+	secret := []byte("secret")
+	req := &Request{Path: "/a", Headers: map[string][]string{"X": {"1"}}, secret: secret}
+	req.Next = req
+	tags := []string{"t"}
+	f.Send(req, [2]*Request{req, nil}, tags...)
+
+	req.Path = "/b"
+	req.Headers["X"][0] = "2"
+	tags[0] = "u"
+	recorded := f.SendCallsSnapshot()[0].Parameters
+	if recorded.Req == req || recorded.Req.Path != "/a" || recorded.Req.Headers["X"][0] != "1" {
+		panic(fmt.Sprintf("Send: request not copied: %+v", recorded.Req))
+	}
+	if recorded.Req.Next != recorded.Req || recorded.Batch[0] != recorded.Req {
+		panic("Send: copies of the same pointer differ")
+	}
+	if !reflect.DeepEqual(recorded.Tags, []string{"t"}) {
+		panic(fmt.Sprintf("Send: tags not copied: %v", recorded.Tags))
+	}
+	if &recorded.Req.secret[0] != &secret[0] {
+		panic("Send: unexported field copied")
+	}
+
+	// nil values stay nil
+	f.Write(nil, nil)
+	if p := f.WriteCallsSnapshot()[2].Parameters; p.Buf != nil || p.Meta != nil {
+		panic(fmt.Sprintf("Write: nil parameters recorded as %+v", p))
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ Differ = &FakeDiffer{}
+
+// even is a DifferMatcher that does not describe itself
+type even struct{}
+
+func (even) Match(n int) bool { return n%2 == 0 }
+
+func expectError(name string, t *recorder, lines ...string) {
+	if len(t.errors) != 1 {
+		panic(fmt.Sprintf("%s: %d errors, expected 1: %q", name, len(t.errors), t.errors))
+	}
+	for _, line := range lines {
+		if !strings.Contains(t.errors[0], line+"\n") && !strings.HasSuffix(t.errors[0], line) {
+			panic(fmt.Sprintf("%s: error %q does not contain line %q", name, t.errors[0], line))
+		}
+	}
+}
+
+func main() {
+	f := &FakeDiffer{}
+	f.SetLookupStub("", nil)
+
+	t := &recorder{}
+	f.AssertLookupCalledWith(t, DifferEq("users"), DifferAny[int](), DifferAny[[]string]())
+	expectError("AssertLookupCalledWith", t,
+		"FakeDiffer.Lookup not called with expected parameters",
+		"expected:",
+		`	Table: "users"`,
+		"	Id: any value",
+		"	Fields: any value",
+		"recorded calls: none",
+	)
+
+	f.Lookup("users", 1, []string{"name"})
+	f.Lookup("groups", 2, nil)
+	f.Lookup("users", 3, []string{"email"})
+
+	t = &recorder{}
+	f.AssertLookupCalledWith(t, DifferEq("users"), DifferNot(DifferEq(3)), DifferEq([]string{"email"}))
+	expectError("AssertLookupCalledWith", t,
+		"	Id: not 3",
+		`	Fields: []string{"email"}`,
+		"recorded calls:",
+		"	FakeDiffer.Lookup{Table:users Id:1 Fields:[name]}",
+		"	FakeDiffer.Lookup{Table:groups Id:2 Fields:[]}",
+		"	FakeDiffer.Lookup{Table:users Id:3 Fields:[email]}",
+		"closest call FakeDiffer.Lookup{Table:users Id:1 Fields:[name]} differs in:",
+		`	Fields: got []string{"name"}, want []string{"email"}`,
+	)
+	if strings.Contains(t.errors[0], "Table: got") || strings.Contains(t.errors[0], "Id: got") {
+		panic(fmt.Sprintf("AssertLookupCalledWith: matching fields reported as differing: %q", t.errors[0]))
+	}
+
+	t = &recorder{}
+	f.AssertLookupCalledOnceWith(t, DifferEq("users"), even{}, DifferAnyOfType[[]string, []string]())
+	expectError("AssertLookupCalledOnceWith", t,
+		"FakeDiffer.Lookup called 0 times with expected parameters, expected one",
+		"	Id: a value matched by main.even",
+		"	Fields: any []string",
+		"closest call FakeDiffer.Lookup{Table:users Id:1 Fields:[name]} differs in:",
+		"	Id: got 1, want a value matched by main.even",
+	)
+
+	t = &recorder{}
+	f.AssertLookupCalledOnceWith(t, DifferEq("users"), DifferAny[int](), DifferPred(func(fields []string) bool { return len(fields) == 1 }))
+	expectError("AssertLookupCalledOnceWith", t,
+		"FakeDiffer.Lookup called 2 times with expected parameters, expected one",
+		"	Fields: a value accepted by the predicate",
+		"	FakeDiffer.Lookup{Table:users Id:1 Fields:[name]} (matches)",
+		"	FakeDiffer.Lookup{Table:groups Id:2 Fields:[]}",
+		"	FakeDiffer.Lookup{Table:users Id:3 Fields:[email]} (matches)",
+	)
+	if strings.Contains(t.errors[0], "closest call") {
+		panic(fmt.Sprintf("AssertLookupCalledOnceWith: closest call reported despite matches: %q", t.errors[0]))
+	}
+
+	// nil matchers match any value
+	t = &recorder{}
+	f.AssertLookupCalledWith(t, DifferEq("groups"), nil, nil)
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("AssertLookupCalledWith: nil matchers did not match: %q", t.errors))
+	}
+	f.AssertLookupCalledOnceWith(t, nil, DifferEq(2), nil)
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("AssertLookupCalledOnceWith: nil matchers did not match: %q", t.errors))
+	}
+	if !f.LookupCalledWith(nil, nil, nil) || f.LookupCalledOnceWith(nil, nil, nil) {
+		panic("LookupCalledWith: nil matchers did not match every call")
+	}
+	if _, _, found := f.LookupResultsForCall(nil, DifferEq(3), nil); !found {
+		panic("LookupResultsForCall: nil matchers did not match")
+	}
+
+	t = &recorder{}
+	f.AssertLookupCalledWith(t, DifferEq("roles"), nil, nil)
+	expectError("AssertLookupCalledWith", t,
+		`	Table: "roles"`,
+		"	Id: any value",
+		"	Fields: any value",
+		"closest call FakeDiffer.Lookup{Table:users Id:1 Fields:[name]} differs in:",
+		`	Table: got "users", want "roles"`,
+	)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var _ Documenter = &FakeDocumenter{}
+
+func main() {
+	f := NewFakeDocumenterDefaultPanic()
+	missing := errors.New("missing")
+	f.SetGetError(missing)
+	f.SetPutStub(nil)
+	f.SetCloseStub(nil)
+
+	if err := f.Put("readme", []byte("hello")); err != nil {
+		panic(fmt.Sprintf("Put: unexpected error %s", err))
+	}
+	if _, err := f.Get("license"); err != missing {
+		panic(fmt.Sprintf("Get: %v, expected missing", err))
+	}
+	if err := f.Close(); err != nil {
+		panic(fmt.Sprintf("Close: unexpected error %s", err))
+	}
+	if !f.DeleteNotCalled() {
+		panic("DeleteNotCalled: Delete called")
+	}
+}
@@ -51,10 +51,10 @@ func main() {
 	if !f.EmbedCalledN(1) {
 		panic("EmbedCalledN: Embed not called once")
 	}
-	if !f.EmbedCalledWith(answer) {
+	if !f.EmbedCalledWith(EmbedderEq(answer)) {
 		panic(fmt.Sprintf("EmbedCalledWith: Embed not called with %s", answer))
 	}
-	if !f.EmbedCalledOnceWith(answer) {
+	if !f.EmbedCalledOnceWith(EmbedderEq(answer)) {
 		panic(fmt.Sprintf("EmbedCalledOnceWith: Embed not called once with %s", answer))
 	}
 
@@ -81,10 +81,10 @@ func main() {
 	if !f.OtherCalledN(1) {
 		panic("OtherCalledN: Other not called once")
 	}
-	if !f.OtherCalledWith(answer) {
+	if !f.OtherCalledWith(EmbedderEq(answer)) {
 		panic(fmt.Sprintf("OtherCalledWith: Other not called with %s", answer))
 	}
-	if !f.OtherCalledOnceWith(answer) {
+	if !f.OtherCalledOnceWith(EmbedderEq(answer)) {
 		panic(fmt.Sprintf("OtherCalledOnceWith: Other not called once with %s", answer))
 	}
 
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var _ Expecter = &FakeExpecter{}
+
+func main() {
+	errFull := errors.New("full")
+
+	// met expectations report nothing
+	t := &recorder{}
+	f := &FakeExpecter{}
+	f.ExpectStore(t).With(ExpecterEq("a"), ExpecterAny[int]()).Times(2)
+	f.ExpectStore(t).With(ExpecterEq("b"), ExpecterEq(2)).Return(errFull)
+	f.ExpectFlush(t)
+
+	if err := f.Store("a", 1); err != nil {
+		panic(fmt.Sprintf("Store: unexpected error %v", err))
+	}
+	if err := f.Store("b", 2); err != errFull {
+		panic(fmt.Sprintf("Store: %v, expected %v", err, errFull))
+	}
+	if err := f.Store("a", 3); err != nil {
+		panic(fmt.Sprintf("Store: unexpected error %v", err))
+	}
+	f.Flush()
+	t.finish()
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("unexpected errors: %q", t.errors))
+	}
+
+	// unmet expectations and unexpected calls are reported
+	t = &recorder{}
+	f = &FakeExpecter{}
+	f.ExpectStore(t).With(ExpecterEq("a"), ExpecterEq(1))
+	f.ExpectFlush(t).Times(2)
+
+	f.Store("z", 26)
+	f.Flush()
+	t.finish()
+	if len(t.errors) != 3 {
+		panic(fmt.Sprintf("errors: %q", t.errors))
+	}
+	if !strings.Contains(t.errors[0], "Key:z Value:26") {
+		panic(fmt.Sprintf("unexpected call not reported with its parameters: %q", t.errors[0]))
+	}
+	if !strings.Contains(t.errors[1], "FakeExpecter.Flush called 1 times matching an expectation, expected 2") {
+		panic(fmt.Sprintf("unmet Flush expectation not reported: %q", t.errors[1]))
+	}
+	if !strings.Contains(t.errors[2], "FakeExpecter.Store called 0 times matching an expectation, expected 1") {
+		panic(fmt.Sprintf("unmet Store expectation not reported: %q", t.errors[2]))
+	}
+
+	// hooks are called for expected calls without results
+	t = &recorder{}
+	f = NewFakeExpecterDefaultPanic()
+	f.SetStoreStub(errFull)
+	f.ExpectStore(t)
+	if err := f.Store("a", 1); err != errFull {
+		panic(fmt.Sprintf("Store: %v, expected the hook's %v", err, errFull))
+	}
+	t.finish()
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("unexpected errors: %q", t.errors))
+	}
+}
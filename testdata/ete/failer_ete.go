@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var _ Failer = &FakeFailer{}
+
+func main() {
+	errBroken := errors.New("broken")
+
+	f := NewFakeFailerDefaultPanic()
+	f.SetOpenError(errBroken)
+	if m, n, err := f.Open("a"); m != nil || n != 0 || err != errBroken {
+		panic(fmt.Sprintf("SetOpenError: Open returned %v, %d, %v", m, n, err))
+	}
+
+	f.SetReadStub(3, nil)
+	f.SetReadErrorOnCall(1, errBroken)
+	for i, want := range []error{nil, errBroken, nil} {
+		n, err := f.Read(nil)
+		if err != want || (err == nil) != (n == 3) {
+			panic(fmt.Sprintf("SetReadErrorOnCall: call %d returned %d, %v", i, n, err))
+		}
+	}
+
+	coded := &CodedError{Code: 7}
+	f.SetCheckError(coded)
+	if err := f.Check(); err != coded {
+		panic(fmt.Sprintf("SetCheckError: Check returned %v", err))
+	}
+
+	// FailAll configures the methods returning error, leaving the others alone
+	f = NewFakeFailerDefaultPanic()
+	f.SetNameStub("failer")
+	f.FailAll(errBroken)
+	if _, _, err := f.Open("a"); err != errBroken {
+		panic(fmt.Sprintf("FailAll: Open returned %v", err))
+	}
+	if n, err := f.Read(nil); n != 0 || err != errBroken {
+		panic(fmt.Sprintf("FailAll: Read returned %d, %v", n, err))
+	}
+	if err := f.Close(); err != errBroken {
+		panic(fmt.Sprintf("FailAll: Close returned %v", err))
+	}
+	if name := f.Name(); name != "failer" {
+		panic(fmt.Sprintf("FailAll: Name returned %q", name))
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				panic("FailAll: Check did not panic")
+			}
+		}()
+		f.Check()
+	}()
+
+	// FailAll replaces the hooks, leaving the results set for given calls
+	f = NewFakeFailerDefaultPanic()
+	f.SetCloseReturnsOnCall(1, nil)
+	f.FailAll(errBroken)
+	for i, want := range []error{errBroken, nil, errBroken} {
+		if err := f.Close(); err != want {
+			panic(fmt.Sprintf("FailAll: Close call %d returned %v", i, err))
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+)
+
+var _ Friend = &FakeFriend{}
+
+func main() {
+	z := NewFakeFriendDefaultZero()
+	if names, err := z.Names("a"); names != nil || err != nil {
+		panic(fmt.Sprintf("DefaultZero: Names returned %v, %v", names, err))
+	}
+	if z.Scores() != nil || z.Tags() != nil || z.Next() != nil || z.Count() != nil {
+		panic("DefaultZero: non-zero results")
+	}
+	if !z.NextCalledOnce() {
+		panic("DefaultZero: calls not recorded")
+	}
+
+	f := NewFakeFriendDefaultFriendly()
+	if names, err := f.Names("a"); names == nil || len(names) != 0 || err != nil {
+		panic(fmt.Sprintf("DefaultFriendly: Names returned %#v, %v", names, err))
+	}
+	scores := f.Scores()
+	if scores == nil || len(scores) != 0 {
+		panic(fmt.Sprintf("DefaultFriendly: Scores returned %#v", scores))
+	}
+	scores["a"] = 1
+	if len(f.Scores()) != 0 {
+		panic("DefaultFriendly: Scores shared between calls")
+	}
+	if tags := f.Tags(); tags == nil || len(tags) != 0 {
+		panic(fmt.Sprintf("DefaultFriendly: Tags returned %#v", tags))
+	}
+	if f.Count() != nil {
+		panic("DefaultFriendly: Count returned non-nil pointer")
+	}
+
+	next, ok := f.Next().(*FakeFriend)
+	if !ok || next == nil {
+		panic(fmt.Sprintf("DefaultFriendly: Next returned %#v", next))
+	}
+	if f.Next() != next {
+		panic("DefaultFriendly: Next returned a different fake")
+	}
+	if names, _ := next.Next().Names("b"); names == nil {
+		panic("DefaultFriendly: nested fake not friendly")
+	}
+	if !next.NextCalledOnce() {
+		panic("DefaultFriendly: nested fake calls not recorded")
+	}
+}
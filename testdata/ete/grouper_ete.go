@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+)
+
+var _ Grouper = &FakeGrouper{}
+
+func main() {
+	answer := "42"
+
+	f := NewFakeGrouperDefaultPanic()
+	f.SetGroupStub(answer)
+	f.SetUngroupStub(true)
+
+	if g := f.Group(); g != answer {
+		panic(fmt.Sprintf("unexpected result from Group: %s", g))
+	}
+	if !f.GroupCalledOnce() {
+		panic("GroupCalledOnce: Group not called once")
+	}
+
+	if !f.Ungroup(answer) {
+		panic("unexpected result from Ungroup")
+	}
+	if !f.UngroupCalledOnceWith(GrouperEq(answer)) {
+		panic(fmt.Sprintf("UngroupCalledOnceWith: Ungroup not called once with %s", answer))
+	}
+}
@@ -48,7 +48,7 @@ func main() {
 		panic("InterfaceCalledN: Interface not called once")
 	}
 
-	res, found := f.InterfaceResultsForCall(b)
+	res, found := f.InterfaceResultsForCall(InterfacerEq[interface{}](b))
 	if res != a || found != true {
 		panic(fmt.Sprintf("NamedQualifyResultsForCall: NamedQualify results for %s not %s, found: %s", b, a, res, found))
 	}
@@ -77,8 +77,13 @@ func main() {
 		panic("NamedInterfaceCalledN: NamedInterface not called once")
 	}
 
-	res, found = f.NamedInterfaceResultsForCall(a)
+	res, found = f.NamedInterfaceResultsForCall(InterfacerAnyOfType[interface{}, string]())
 	if res != b || found != true {
 		panic(fmt.Sprintf("NamedQualifyResultsForCall: NamedQualify results for %s not %s, found: %s", a, b, res, found))
 	}
+
+	_, found = f.NamedInterfaceResultsForCall(InterfacerAnyOfType[interface{}, int]())
+	if found {
+		panic("NamedInterfaceResultsForCall: NamedInterface results found for an int parameter")
+	}
 }
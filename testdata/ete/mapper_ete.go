@@ -40,10 +40,10 @@ func main() {
 	if !f.MapParameterCalledN(1) {
 		panic("MapParameterCalledN: MapParameter not called once")
 	}
-	if !f.MapParameterCalledWith(m) {
+	if !f.MapParameterCalledWith(MapperEq(m)) {
 		panic(fmt.Sprintf("MapParameterCalledWith: MapParameter not called with %s", m))
 	}
-	if !f.MapParameterCalledOnceWith(m) {
+	if !f.MapParameterCalledOnceWith(MapperEq(m)) {
 		panic(fmt.Sprintf("MapParameterCalledOnceWith: MapParameter not called once with %s", m))
 	}
 
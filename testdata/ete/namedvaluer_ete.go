@@ -50,10 +50,10 @@ func main() {
 	if !f.NamedCalledN(1) {
 		panic("NamedCalledN: Named not called once")
 	}
-	if !f.NamedCalledWith(c, a) {
+	if !f.NamedCalledWith(NamedvaluerEq(c), NamedvaluerEq(a)) {
 		panic(fmt.Sprintf("NamedCalledWith: Named not called with %s, %s", c, a))
 	}
-	if !f.NamedCalledOnceWith(c, a) {
+	if !f.NamedCalledOnceWith(NamedvaluerEq(c), NamedvaluerEq(a)) {
 		panic(fmt.Sprintf("NamedCalledOnceWith: Named not called once with %s, %s", c, a))
 	}
 
@@ -77,19 +77,19 @@ func main() {
 	if !f.ManyNamedCalledN(1) {
 		panic("ManyNamedCalledN: ManyNamed not called once")
 	}
-	if !f.ManyNamedCalledWith(a, b, c, d) {
+	if !f.ManyNamedCalledWith(NamedvaluerEq(a), NamedvaluerEq(b), NamedvaluerEq(c), NamedvaluerEq(d)) {
 		panic(fmt.Sprintf("ManyNamedCalledWith: ManyNamed not called once with %s, %s, %s", a, b, c, d))
 	}
-	if !f.ManyNamedCalledOnceWith(a, b, c, d) {
+	if !f.ManyNamedCalledOnceWith(NamedvaluerEq(a), NamedvaluerEq(b), NamedvaluerEq(c), NamedvaluerEq(d)) {
 		panic(fmt.Sprintf("ManyNamedCalledOnceWith: ManyNamed not called once with %s, %s, %s", a, b, c, d))
 	}
 
-	res, found := f.ManyNamedResultsForCall(a, b, c, d)
+	res, found := f.ManyNamedResultsForCall(NamedvaluerEq(a), NamedvaluerEq(b), NamedvaluerEq(c), NamedvaluerEq(d))
 	if res != true || found != true {
 		panic(fmt.Sprintf("ManyNamedResultsForCall: ManyNamed results for %s, %s, %s not %s, found: %s", a, b, c, d, true, found))
 	}
 
-	res, found = f.ManyNamedResultsForCall(b, a, d, c)
+	res, found = f.ManyNamedResultsForCall(NamedvaluerEq(b), NamedvaluerEq(a), NamedvaluerEq(d), NamedvaluerEq(c))
 	if found != false {
 		panic(fmt.Sprintf("ManyNamedResultsForCall: ManyNamed results for %s, %s, %s, %s found", b, a, d, c))
 	}
@@ -104,7 +104,7 @@ func main() {
 		panic("ManyNamedCalledN: ManyNamed not called twice")
 	}
 
-	res, found = f.ManyNamedResultsForCall(b, a, d, c)
+	res, found = f.ManyNamedResultsForCall(NamedvaluerEq(b), NamedvaluerEq(a), NamedvaluerEq(d), NamedvaluerEq(c))
 	if res != false || found != true {
 		panic(fmt.Sprintf("ManyNamedResultsForCall: ManyNamed results for %s, %s, %s not %s, found: %s", b, a, d, c, false, found))
 	}
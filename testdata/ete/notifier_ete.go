@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var _ Notifier = &FakeNotifier{}
+
+// worker notifies each topic it receives from a background goroutine
+func worker(n Notifier, topics <-chan string) {
+	go func() {
+		i := 0
+		for topic := range topics {
+			i++
+			n.Notify(topic, i)
+		}
+		n.Close()
+	}()
+}
+
+func main() {
+	f := NewFakeNotifierDefaultPanic()
+	f.SetNotifyStub(nil)
+	f.SetCloseHook(func() {})
+
+	topics := make(chan string)
+	worker(f, topics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	go func() { topics <- "a" }()
+	if err := f.WaitForNotifyCalled(ctx); err != nil {
+		panic(fmt.Sprintf("WaitForNotifyCalled: %v", err))
+	}
+
+	go func() {
+		topics <- "b"
+		topics <- "c"
+		close(topics)
+	}()
+	if err := f.WaitForNotifyCalledN(ctx, 3); err != nil {
+		panic(fmt.Sprintf("WaitForNotifyCalledN: %v", err))
+	}
+
+	t := &recorder{}
+	f.AssertCloseEventuallyCalled(t, 10*time.Second)
+	f.AssertNotifyEventuallyCalledWith(t, 10*time.Second, NotifierEq("c"), NotifierEq(3))
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("unexpected errors: %q", t.errors))
+	}
+
+	// waits end with the context, and assertions report their timeout
+	short, cancelShort := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelShort()
+	if err := f.WaitForNotifyCalledN(short, 4); !errors.Is(err, context.DeadlineExceeded) {
+		panic(fmt.Sprintf("WaitForNotifyCalledN: %v, expected %v", err, context.DeadlineExceeded))
+	}
+
+	f.AssertNotifyEventuallyCalledWith(t, 10*time.Millisecond, NotifierEq("d"), NotifierAny[int]())
+	if len(t.errors) != 1 || !strings.Contains(t.errors[0], "FakeNotifier.Notify not called with expected parameters within 10ms, called 3 times") {
+		panic(fmt.Sprintf("AssertNotifyEventuallyCalledWith: unexpected errors %q", t.errors))
+	}
+
+	f.Reset()
+	t = &recorder{}
+	f.AssertCloseEventuallyCalled(t, 10*time.Millisecond)
+	if len(t.errors) != 1 || !strings.Contains(t.errors[0], "FakeNotifier.Close not called within 10ms") {
+		panic(fmt.Sprintf("AssertCloseEventuallyCalled: unexpected errors %q", t.errors))
+	}
+}
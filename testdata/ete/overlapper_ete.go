@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing/fstest"
+)
+
+var (
+	_ Overlapper    = &FakeOverlapper{}
+	_ io.ReadCloser = &FakeOverlapper{}
+	_ fs.FS         = &FakeOverlapper{}
+	_ error         = &FakeOverlapper{}
+)
+
+func main() {
+	ctx := context.Background()
+	files := fstest.MapFS{"answer": {Data: []byte("42")}}
+
+	f := NewFakeOverlapperDefaultPanic()
+	f.SetCloseStub(nil)
+	f.SetReadStub(2, io.EOF)
+	f.SetErrorStub("overlapped")
+	f.SetContextStub(ctx)
+	f.OpenHook = files.Open
+
+	if err := f.Close(); err != nil {
+		panic(fmt.Sprintf("unexpected result from Close: %s", err))
+	}
+	if !f.CloseCalledOnce() {
+		panic("CloseCalledOnce: Close not called once")
+	}
+
+	buf := make([]byte, 2)
+	if n, err := f.Read(buf); n != 2 || !errors.Is(err, io.EOF) {
+		panic(fmt.Sprintf("unexpected results from Read: %d, %v", n, err))
+	}
+	if !f.ReadCalledWith(OverlapperEq(buf)) {
+		panic("ReadCalledWith: Read not called with buffer")
+	}
+
+	if file, err := f.Open("answer"); err != nil || file == nil {
+		panic(fmt.Sprintf("unexpected results from Open: %v, %v", file, err))
+	}
+	if _, err := f.Open("question"); !errors.Is(err, fs.ErrNotExist) {
+		panic(fmt.Sprintf("unexpected error from Open: %v", err))
+	}
+	if !f.OpenCalledN(2) {
+		panic("OpenCalledN: Open not called twice")
+	}
+
+	if e := f.Error(); e != "overlapped" {
+		panic(fmt.Sprintf("unexpected result from Error: %s", e))
+	}
+
+	if c := f.Context(); c != ctx {
+		panic("unexpected result from Context")
+	}
+}
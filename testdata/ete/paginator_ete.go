@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+	"sync/atomic"
+)
+
+var _ Paginator = &FakePaginator{}
+
+func main() {
+	page := Page[User]{Items: []User{{Name: "one"}}, Next: "2"}
+	cache := Cache[string, int]{"answer": 42}
+	current := new(atomic.Pointer[Page[User]])
+	current.Store(&page)
+
+	f := NewFakePaginatorDefaultPanic()
+	f.SetListStub(page, nil)
+	f.SetCacheStub(&cache)
+	f.SetCurrentStub(current)
+	f.AllHook = func(pages []Page[*User]) iter.Seq[User] {
+		return func(yield func(User) bool) {
+			for _, p := range pages {
+				for _, u := range p.Items {
+					if !yield(*u) {
+						return
+					}
+				}
+			}
+		}
+	}
+
+	p, err := f.List("1")
+	if err != nil || p.Next != page.Next {
+		panic(fmt.Sprintf("unexpected results from List: %v, %v", p, err))
+	}
+	if !f.ListCalledOnceWith(PaginatorEq("1")) {
+		panic("ListCalledOnceWith: List not called once with 1")
+	}
+
+	if c := f.Cache(); (*c)["answer"] != 42 {
+		panic(fmt.Sprintf("unexpected result from Cache: %v", c))
+	}
+
+	if c := f.Current(); c.Load() != &page {
+		panic("unexpected result from Current")
+	}
+
+	var names []string
+	for u := range f.All([]Page[*User]{{Items: []*User{{Name: "a"}, {Name: "b"}}}}) {
+		names = append(names, u.Name)
+	}
+	if len(names) != 2 {
+		panic(fmt.Sprintf("unexpected results from All: %v", names))
+	}
+	if !f.AllCalledOnce() {
+		panic("AllCalledOnce: All not called once")
+	}
+}
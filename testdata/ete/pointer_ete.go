@@ -41,10 +41,10 @@ func main() {
 	if !f.PointCalledN(1) {
 		panic("PointCalledN: Point not called once")
 	}
-	if !f.PointCalledWith(ps) {
+	if !f.PointCalledWith(PointerEq(ps)) {
 		panic(fmt.Sprintf("PointCalledWith: Point not called with %s", ps))
 	}
-	if !f.PointCalledOnceWith(ps) {
+	if !f.PointCalledOnceWith(PointerEq(ps)) {
 		panic(fmt.Sprintf("PointCalledOnceWith: Point not called once with %s", ps))
 	}
 }
@@ -12,6 +12,11 @@ func (n *NiceScanner) Scan(state fmt.ScanState, verb rune) error {
 	return nil
 }
 
+func isThree(s fmt.Scanner) bool {
+	n, ok := s.(*NiceScanner)
+	return ok && n.name == "three"
+}
+
 func main() {
 	qualifiyHookCalled := false
 	namedQualifyHookCalled := false
@@ -51,10 +56,10 @@ func main() {
 	if !f.QualifyCalledN(1) {
 		panic("QualifyCalledN: Qualify not called once")
 	}
-	if !f.QualifyCalledWith(scannerOne) {
+	if !f.QualifyCalledWith(QualifierAnyOfType[fmt.Scanner, *NiceScanner]()) {
 		panic(fmt.Sprintf("QualifyCalledWith: Qualify not called with %s", scannerOne))
 	}
-	if !f.QualifyCalledOnceWith(scannerOne) {
+	if !f.QualifyCalledOnceWith(QualifierEq[fmt.Scanner](scannerOne)) {
 		panic(fmt.Sprintf("QualifyCalledOnceWith: Qualify not called once with %s", scannerOne))
 	}
 
@@ -78,19 +83,19 @@ func main() {
 	if !f.NamedQualifyCalledN(1) {
 		panic("NamedQualifyCalledN: NamedQualify not called once")
 	}
-	if !f.NamedQualifyCalledWith(scannerOne, scannerTwo, scannerThree) {
+	if !f.NamedQualifyCalledWith(QualifierAny[fmt.Scanner](), QualifierNot(QualifierEq[fmt.Scanner](scannerOne)), QualifierPred(isThree)) {
 		panic(fmt.Sprintf("NamedQualifyCalledWith: NamedQualify not called once with %s, %s, %s", scannerOne, scannerTwo, scannerThree))
 	}
-	if !f.NamedQualifyCalledOnceWith(scannerOne, scannerTwo, scannerThree) {
+	if !f.NamedQualifyCalledOnceWith(QualifierEq[fmt.Scanner](scannerOne), QualifierEq[fmt.Scanner](scannerTwo), QualifierEq[fmt.Scanner](scannerThree)) {
 		panic(fmt.Sprintf("NamedQualifyCalledOnceWith: NamedQualify not called once with %s, %s, %s", scannerOne, scannerTwo, scannerThree))
 	}
 
-	res, found := f.NamedQualifyResultsForCall(scannerOne, scannerTwo, scannerThree)
+	res, found := f.NamedQualifyResultsForCall(QualifierEq[fmt.Scanner](scannerOne), QualifierEq[fmt.Scanner](scannerTwo), QualifierEq[fmt.Scanner](scannerThree))
 	if res != scannerThree || found != true {
 		panic(fmt.Sprintf("NamedQualifyResultsForCall: NamedQualify results for %s, %s, %s not %s, found: %s", scannerOne, scannerTwo, scannerThree, scannerThree, found))
 	}
 
-	res, found = f.NamedQualifyResultsForCall(scannerTwo, scannerThree, scannerOne)
+	res, found = f.NamedQualifyResultsForCall(QualifierEq[fmt.Scanner](scannerTwo), QualifierEq[fmt.Scanner](scannerThree), QualifierEq[fmt.Scanner](scannerOne))
 	if found != false {
 		panic(fmt.Sprintf("NamedQualifyResultsForCall: NamedQualify results for %s found", scannerTwo, scannerThree, scannerOne))
 	}
@@ -105,7 +110,7 @@ func main() {
 		panic("NamedQualifyCalledN: NamedQualify not called twice")
 	}
 
-	res, found = f.NamedQualifyResultsForCall(scannerTwo, scannerThree, scannerOne)
+	res, found = f.NamedQualifyResultsForCall(QualifierEq[fmt.Scanner](scannerTwo), QualifierEq[fmt.Scanner](scannerThree), QualifierEq[fmt.Scanner](scannerOne))
 	if res != scannerOne || found != true {
 		panic(fmt.Sprintf("NamedQualifyResultsForCall: NamedQualify results for %s, %s, %s not %s, found: %s", scannerTwo, scannerThree, scannerOne, scannerOne, found))
 	}
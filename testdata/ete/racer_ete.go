@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+var _ Racer = &FakeRacer{}
+
+const racers = 16
+
+func main() {
+	double := func(n int) (int, error) {
+		return n * 2, nil
+	}
+	f := NewFakeRacerDefaultPanic()
+	f.SetLapHook(double)
+	f.SetFinishHook(func(string) {})
+
+	var wg sync.WaitGroup
+	for i := 0; i < racers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if n, err := f.Lap(i); n != i*2 || err != nil {
+				panic(fmt.Sprintf("Lap(%d): %d, %v", i, n, err))
+			}
+			f.LapCalledWith(RacerEq(i))
+			f.LapCallsSnapshot()
+			f.Finish(fmt.Sprint(i))
+			f.SetFinishHook(func(string) {})
+		}(i)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < racers; i++ {
+			f.SetLapHook(double)
+			f.FinishCalledN(i)
+		}
+	}()
+	wg.Wait()
+
+	if !f.LapCalledN(racers) {
+		panic(fmt.Sprintf("LapCalledN: Lap not called %d times", racers))
+	}
+	if !f.FinishCalledN(racers) {
+		panic(fmt.Sprintf("FinishCalledN: Finish not called %d times", racers))
+	}
+	for i := 0; i < racers; i++ {
+		if !f.LapCalledOnceWith(RacerEq(i)) {
+			panic(fmt.Sprintf("LapCalledOnceWith: Lap not called once with %d", i))
+		}
+	}
+
+	calls := f.LapCallsSnapshot()
+	if len(calls) != racers {
+		panic(fmt.Sprintf("LapCallsSnapshot: %d calls", len(calls)))
+	}
+	calls[0].Results.Ident1 = -1
+	if f.LapCalls[0].Results.Ident1 == -1 {
+		panic("LapCallsSnapshot: snapshot shares invocations with the fake")
+	}
+
+	f.Reset()
+	if !f.LapNotCalled() {
+		panic("LapNotCalled: Lap called after Reset")
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import "fmt"
+
+// recorder is a TestingT for the fakes of every end-to-end test, which keeps
+// the errors reported to it and runs its cleanups on demand
+type recorder struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (r *recorder) Error(args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatal(args ...interface{}) {
+	panic(fmt.Sprint(args...))
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+// finish runs the cleanups in reverse order, as the testing package does
+func (r *recorder) finish() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+type name string
+
+func (n name) String() string {
+	return string(n)
+}
+
+var _ Repository[int, name] = &FakeRepository[int, name]{}
+
+// mapRepository is a real Repository backed by a map
+type mapRepository map[int]name
+
+func (m mapRepository) Get(k int) (name, error) {
+	v, ok := m[k]
+	if !ok {
+		return "", errors.New("missing")
+	}
+	return v, nil
+}
+
+func (m mapRepository) Put(k int, v name) error {
+	m[k] = v
+	return nil
+}
+
+func (m mapRepository) Keys() []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func main() {
+	getHookCalled := false
+	putHookCalled := false
+
+	key, value := 42, name("answer")
+	missing := errors.New("missing")
+
+	f := &FakeRepository[int, name]{
+		GetHook: func(k int) (name, error) {
+			getHookCalled = true
+			if k != key {
+				return "", missing
+			}
+			return value, nil
+		},
+		PutHook: func(k int, v name) error {
+			putHookCalled = true
+			return nil
+		},
+	}
+
+	if err := f.Put(key, value); err != nil {
+		panic(fmt.Sprintf("unexpected error from Put: %s", err))
+	}
+	if !putHookCalled {
+		panic("PutHook not called")
+	}
+	if !f.PutCalledOnceWith(RepositoryEq(key), RepositoryEq(value)) {
+		panic(fmt.Sprintf("PutCalledOnceWith: Put not called once with %d, %s", key, value))
+	}
+
+	v, err := f.Get(key)
+
+	if err != nil || v != value {
+		panic(fmt.Sprintf("unexpected results from Get: %s, %v", v, err))
+	}
+	if !getHookCalled {
+		panic("GetHook not called")
+	}
+	if !f.GetCalledWith(RepositoryEq(key)) {
+		panic(fmt.Sprintf("GetCalledWith: Get not called with %d", key))
+	}
+	if rv, rerr, found := f.GetResultsForCall(RepositoryEq(key)); !found || rv != value || rerr != nil {
+		panic(fmt.Sprintf("GetResultsForCall: unexpected results %s, %v, %t", rv, rerr, found))
+	}
+
+	d := NewFakeRepositoryDefaultPanic[string, name]()
+	d.SetKeysStub([]string{"a", "b"})
+
+	keys := d.Keys()
+	if len(keys) != 2 {
+		panic(fmt.Sprintf("unexpected results from Keys: %v", keys))
+	}
+	if !d.KeysCalledOnce() {
+		panic("KeysCalledOnce: Keys not called once")
+	}
+
+	backing := mapRepository{}
+	spy := NewFakeRepositorySpy[int, name](backing)
+	if err := spy.Put(key, value); err != nil {
+		panic(fmt.Sprintf("unexpected error from spy Put: %s", err))
+	}
+	if backing[key] != value {
+		panic("spy Put not forwarded to the real implementation")
+	}
+	if v, err := spy.Get(key); v != value || err != nil {
+		panic(fmt.Sprintf("unexpected results from spy Get: %s, %v", v, err))
+	}
+	if rv, _, found := spy.GetResultsForCall(RepositoryEq(key)); !found || rv != value {
+		panic(fmt.Sprintf("GetResultsForCall: spy results not recorded: %s, %t", rv, found))
+	}
+
+	spy.SetGetStub("", missing)
+	if _, err := spy.Get(key); err != missing {
+		panic(fmt.Sprintf("spy Get hook not overridden: %v", err))
+	}
+	if keys := spy.Keys(); len(keys) != 1 || keys[0] != key {
+		panic(fmt.Sprintf("unexpected results from spy Keys: %v", keys))
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var _ Sequencer = &FakeSequencer{}
+
+var errUnavailable = errors.New("unavailable")
+
+func expectPanic(name string, call func()) {
+	defer func() {
+		if recover() == nil {
+			panic(fmt.Sprintf("%s: expected a panic", name))
+		}
+	}()
+	call()
+}
+
+func main() {
+	f := NewFakeSequencerDefaultPanic()
+
+	f.SetPingReturnsSequence(SequencerRepeatLast,
+		SequencerPingResults{Ident1: errUnavailable},
+		SequencerPingResults{Ident1: errUnavailable},
+		SequencerPingResults{},
+	)
+	for i, expected := range []error{errUnavailable, errUnavailable, nil, nil} {
+		if err := f.Ping(); err != expected {
+			panic(fmt.Sprintf("Ping call %d: %v, expected %v", i, err, expected))
+		}
+	}
+	if calls := f.PingCallsSnapshot(); calls[0].Results.Ident1 != errUnavailable || calls[3].Results.Ident1 != nil {
+		panic("PingCalls: sequence results not recorded")
+	}
+
+	f.SetPageReturnsSequence(SequencerPanicAfterSequence,
+		SequencerPageResults{Items: []string{"a", "b"}, Next: "2"},
+		SequencerPageResults{Items: []string{"c"}},
+	)
+	if items, next, _ := f.Page(""); !reflect.DeepEqual(items, []string{"a", "b"}) || next != "2" {
+		panic(fmt.Sprintf("Page: unexpected first page %v, %q", items, next))
+	}
+	if items, next, _ := f.Page("2"); !reflect.DeepEqual(items, []string{"c"}) || next != "" {
+		panic(fmt.Sprintf("Page: unexpected second page %v, %q", items, next))
+	}
+	expectPanic("Page after sequence", func() { f.Page("") })
+
+	f.SetPageStub(nil, "", errUnavailable)
+	f.SetPageReturnsSequence(SequencerHookAfterSequence, SequencerPageResults{Next: "more"})
+	f.SetPageReturnsOnCall(4, []string{"z"}, "", nil)
+	if _, next, err := f.Page(""); next != "more" || err != nil {
+		panic(fmt.Sprintf("Page: unexpected results %q, %v", next, err))
+	}
+	if _, _, err := f.Page(""); err != errUnavailable {
+		panic(fmt.Sprintf("Page: %v, expected hook results after sequence", err))
+	}
+	if items, _, err := f.Page(""); !reflect.DeepEqual(items, []string{"z"}) || err != nil {
+		panic(fmt.Sprintf("Page: unexpected results on call 4: %v, %v", items, err))
+	}
+
+	g := &FakeSequencer{}
+	g.SetPingReturnsOnCall(0, errUnavailable)
+	if err := g.Ping(); err != errUnavailable {
+		panic(fmt.Sprintf("Ping call 0: %v, expected %v", err, errUnavailable))
+	}
+	expectPanic("Ping without hook", func() { g.Ping() })
+
+	// results set for calls not yet made stay with them after Reset
+	h := &FakeSequencer{}
+	h.SetPingStub(nil)
+	for i := 0; i < 3; i++ {
+		h.Ping()
+	}
+	h.SetPingReturnsSequence(SequencerHookAfterSequence, SequencerPingResults{Ident1: errUnavailable})
+	h.SetPingReturnsOnCall(5, errUnavailable)
+	h.Reset()
+	for i, expected := range []error{errUnavailable, nil, errUnavailable, nil} {
+		if err := h.Ping(); err != expected {
+			panic(fmt.Sprintf("Ping call %d after Reset: %v, expected %v", i, err, expected))
+		}
+	}
+	if calls := h.PingCallsSnapshot(); len(calls) != 4 {
+		panic(fmt.Sprintf("PingCalls: %d calls after Reset, expected 4", len(calls)))
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var _ Shadower = &FakeShadower{}
+
+func main() {
+	f := NewFakeShadowerDefaultPanic()
+	double := func(n int) int { return n * 2 }
+	f.SetApplyHook(func(f func(int) int, t int) int { return f(t) })
+	if n := f.Apply(double, 21); n != 42 {
+		panic(fmt.Sprintf("Apply: %d, expected 42", n))
+	}
+	t := &recorder{}
+	f.AssertApplyCalledOnceWith(t, ShadowerAny[func(int) int](), ShadowerEq(21))
+	f.AssertApplyCalledWith(t, ShadowerAny[func(int) int](), ShadowerEq(12))
+	if len(t.errors) != 1 {
+		panic(fmt.Sprintf("AssertApplyCalledWith: %d errors, expected 1", len(t.errors)))
+	}
+
+	failure := errors.New("failure")
+	t = &recorder{}
+	f.ExpectMatch(t).With(ShadowerEq("call"), ShadowerEq(1), ShadowerEq(true)).Return(failure)
+	if err := f.Match("call", 1, true); err != failure {
+		panic(fmt.Sprintf("Match: %v, expected failure", err))
+	}
+	t.finish()
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("ExpectMatch: unexpected errors %q", t.errors))
+	}
+
+	f.SetWaitStub(true, nil)
+	go f.Wait(context.Background(), time.Second, failure)
+	if err := f.WaitForWaitCalled(context.Background()); err != nil {
+		panic(fmt.Sprintf("WaitForWaitCalled: unexpected error %s", err))
+	}
+	if !f.WaitCalledWith(ShadowerAny[context.Context](), ShadowerEq(time.Second), ShadowerEq(failure)) {
+		panic("WaitCalledWith: Wait not called with a second and failure")
+	}
+
+	f.ExpectPlain(t).Times(2).Return(1)
+	if n := f.Plain(1) + f.Plain(2); n != 2 {
+		panic(fmt.Sprintf("Plain: %d, expected 2", n))
+	}
+}
@@ -71,10 +71,10 @@ func main() {
 	if !f.StructCalledN(1) {
 		panic("StructCalledN: Struct not called once")
 	}
-	if !f.StructCalledWith(s) {
+	if !f.StructCalledWith(StructerEq(s)) {
 		panic(fmt.Sprintf("StructCalledWith: Struct not called with %s", s))
 	}
-	if !f.StructCalledOnceWith(s) {
+	if !f.StructCalledOnceWith(StructerEq(s)) {
 		panic(fmt.Sprintf("StructCalledOnceWith: Struct not called once with %s", s))
 	}
 
@@ -98,19 +98,19 @@ func main() {
 	if !f.NamedStructCalledN(1) {
 		panic("NamedStructCalledN: NamedStruct not called once")
 	}
-	if !f.NamedStructCalledWith(s) {
+	if !f.NamedStructCalledWith(StructerEq(s)) {
 		panic(fmt.Sprintf("NamedStructCalledWith: NamedStruct not called once with %s", s))
 	}
-	if !f.NamedStructCalledOnceWith(s) {
+	if !f.NamedStructCalledOnceWith(StructerEq(s)) {
 		panic(fmt.Sprintf("NamedStructCalledOnceWith: NamedStruct not called once with %s", s))
 	}
 
-	res, found := f.NamedStructResultsForCall(s)
+	res, found := f.NamedStructResultsForCall(StructerEq(s))
 	if res != u || found != true {
 		panic(fmt.Sprintf("NamedStructResultsForCall: NamedStruct results for %s not %s, found: %s", s, u, found))
 	}
 
-	res, found = f.NamedStructResultsForCall(t)
+	res, found = f.NamedStructResultsForCall(StructerEq(t))
 	if found != false {
 		panic(fmt.Sprintf("NamedStructResultsForCall: NamedStruct results for %s found", t))
 	}
@@ -125,7 +125,7 @@ func main() {
 		panic("NamedStructCalledN: NamedStruct not called twice")
 	}
 
-	res, found = f.NamedStructResultsForCall(t)
+	res, found = f.NamedStructResultsForCall(StructerEq(t))
 	if res != v || found != true {
 		panic(fmt.Sprintf("NamedStructResultsForCall: NamedStruct results for %s not %s, found: %s", t, v, found))
 	}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ Transactor = &FakeTransactor{}
+
+// journal is a call matcher implemented outside the generated code, as one for another interface would be
+type journal struct {
+	sequence int64
+}
+
+func (j journal) RecordedCalls() (map[int64]string, []int64) {
+	return map[int64]string{j.sequence: "Journal.Write()"}, []int64{j.sequence}
+}
+
+func (j journal) String() string {
+	return "Journal.Write()"
+}
+
+func expectNoErrors(name string, t *recorder) {
+	if len(t.errors) != 0 {
+		panic(fmt.Sprintf("%s: unexpected errors: %q", name, t.errors))
+	}
+}
+
+func expectError(name string, t *recorder, contains ...string) {
+	if len(t.errors) != 1 {
+		panic(fmt.Sprintf("%s: %d errors, expected 1: %q", name, len(t.errors), t.errors))
+	}
+	for _, s := range contains {
+		if !strings.Contains(t.errors[0], s) {
+			panic(fmt.Sprintf("%s: error %q does not contain %q", name, t.errors[0], s))
+		}
+	}
+}
+
+func main() {
+	f := &FakeTransactor{}
+	f.SetBeginStub(nil)
+	f.SetExecStub(1, nil)
+	f.SetCommitStub(nil)
+	f.SetRollbackHook(func() {})
+
+	f.Begin()
+	f.Exec("insert")
+	f.Exec("update")
+	f.Commit()
+
+	calls := f.ExecCallsSnapshot()
+	if calls[0].Sequence <= f.BeginCallsSnapshot()[0].Sequence || calls[1].Sequence <= calls[0].Sequence {
+		panic(fmt.Sprintf("Sequence: not increasing: %d, %d", calls[0].Sequence, calls[1].Sequence))
+	}
+
+	t := &recorder{}
+	TransactorInOrder(t, f.BeginCall(), f.ExecCall(TransactorEq("insert")), f.ExecCall(nil), f.CommitCall())
+	TransactorInOrder(t, f.BeginCall(), f.CommitCall())
+	expectNoErrors("TransactorInOrder", t)
+
+	t = &recorder{}
+	TransactorInOrder(t, f.ExecCall(TransactorEq("update")), f.ExecCall(TransactorEq("insert")))
+	expectError("TransactorInOrder", t,
+		`no call of FakeTransactor.Exec(...) after FakeTransactor.Exec(...)`,
+		"FakeTransactor.Exec{Query:insert}",
+		"FakeTransactor.Exec{Query:update}",
+	)
+
+	t = &recorder{}
+	TransactorInOrder(t, f.BeginCall(), f.RollbackCall())
+	expectError("TransactorInOrder", t, "no call of FakeTransactor.Rollback()", "FakeTransactor.Begin()")
+
+	t = &recorder{}
+	TransactorUnordered(t, f.CommitCall(), f.ExecCall(nil), f.ExecCall(TransactorEq("insert")), f.BeginCall())
+	expectNoErrors("TransactorUnordered", t)
+
+	t = &recorder{}
+	TransactorUnordered(t, f.ExecCall(TransactorEq("insert")), f.ExecCall(TransactorEq("insert")))
+	expectError("TransactorUnordered", t, "no distinct call of FakeTransactor.Exec(...)", "FakeTransactor.Exec{Query:insert}")
+
+	t = &recorder{}
+	TransactorUnordered(t, f.RollbackCall())
+	expectError("TransactorUnordered", t, "no distinct call of FakeTransactor.Rollback()", "actual: no calls")
+
+	// calls of different fakes, and of matchers implemented elsewhere, are ordered together
+	other := &FakeTransactor{}
+	other.SetBeginStub(nil)
+	other.SetRollbackHook(func() {})
+	other.Begin()
+	written := journal{sequence: other.BeginCallsSnapshot()[0].Sequence}
+	other.Rollback()
+
+	t = &recorder{}
+	TransactorInOrder(t, f.CommitCall(), other.BeginCall(), other.RollbackCall())
+	TransactorInOrder(t, f.CommitCall(), written, other.RollbackCall())
+	expectNoErrors("TransactorInOrder", t)
+
+	t = &recorder{}
+	TransactorInOrder(t, other.RollbackCall(), f.BeginCall())
+	expectError("TransactorInOrder", t, "no call of FakeTransactor.Begin() after FakeTransactor.Rollback()")
+
+	// calls forgotten by Reset are no longer matched
+	f.Reset()
+	t = &recorder{}
+	TransactorUnordered(t, f.BeginCall())
+	expectError("TransactorUnordered", t, "no distinct call of FakeTransactor.Begin()")
+}
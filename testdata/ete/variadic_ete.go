@@ -37,10 +37,10 @@ func main() {
 	if !f.SingleVariadicCalledN(1) {
 		panic("SingleVariadicCalledN: SingleVariadic not called once")
 	}
-	if !f.SingleVariadicCalledWith(s...) {
+	if !f.SingleVariadicCalledWith(VariadicEq(s)) {
 		panic(fmt.Sprintf("SingleVariadicCalledWith: SingleVariadic not called with %s", s))
 	}
-	if !f.SingleVariadicCalledOnceWith(s...) {
+	if !f.SingleVariadicCalledOnceWith(VariadicEq(s)) {
 		panic(fmt.Sprintf("SingleVariadicCalledOnceWith: SingleVariadic not called once with %s", s))
 	}
 
@@ -64,10 +64,10 @@ func main() {
 	if !f.MixedVariadicCalledN(1) {
 		panic("MixedVariadicCalledN: MixedVariadic not called once")
 	}
-	if !f.MixedVariadicCalledWith(1, 2, 3, s...) {
+	if !f.MixedVariadicCalledWith(VariadicEq(1), VariadicEq(2), VariadicEq(3), VariadicEq(s)) {
 		panic(fmt.Sprintf("MixedVariadicCalledWith: MixedVariadic not called once with 1, 2, 3, %s", s))
 	}
-	if !f.MixedVariadicCalledOnceWith(1, 2, 3, s...) {
+	if !f.MixedVariadicCalledOnceWith(VariadicEq(1), VariadicEq(2), VariadicEq(3), VariadicEq(s)) {
 		panic(fmt.Sprintf("MixedVariadicCalledOnceWith: MixedVariadic not called once with 1, 2, 3, %s", s))
 	}
 }
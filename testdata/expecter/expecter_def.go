@@ -0,0 +1,6 @@
+package main
+
+type Expecter interface {
+	Store(key string, value int) error
+	Flush()
+}
@@ -0,0 +1,24 @@
+package exported
+
+import . "time"
+
+type Key string
+
+type Value struct {
+	Expires Time
+}
+
+type Store interface {
+	Get(Key) (*Value, Duration)
+	Put(map[Key]Value) error
+}
+
+type secret struct{}
+
+type Hider interface {
+	Peek() []secret
+}
+
+type flusher interface {
+	Flush() error
+}
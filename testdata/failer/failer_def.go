@@ -0,0 +1,17 @@
+package main
+
+type CodedError struct {
+	Code int
+}
+
+func (e *CodedError) Error() string {
+	return "coded error"
+}
+
+type Failer interface {
+	Open(name string) (map[string]int, int, error)
+	Read(p []byte) (n int, err error)
+	Check() *CodedError
+	Close() error
+	Name() string
+}
@@ -0,0 +1,16 @@
+package main
+
+type Tags []string
+
+type Friend interface {
+	Names(prefix string) ([]string, error)
+	Scores() map[string]int
+	Tags() Tags
+	Next() Friend
+	Count() *int
+}
+
+type Circle interface {
+	Leader() Friend
+	Members() []Friend
+}
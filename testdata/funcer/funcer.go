@@ -2,30 +2,183 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // FuncerFuncParameterInvocation represents a single call of FakeFuncer.FuncParameter
 type FuncerFuncParameterInvocation struct {
 	Parameters struct {
 		Ident1 func(string) string
 	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetFuncParameterInvocation
+	Matchers struct {
+		Ident1 FuncerMatcher[func(string) string]
+	}
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *FuncerFuncParameterInvocation) String() string {
+	return fmt.Sprintf("FakeFuncer.FuncParameter%+v", i.Parameters)
+}
+
+// FuncerFuncParameterExpectation is a call of FakeFuncer.FuncParameter expected by a test, created by FakeFuncer.ExpectFuncParameter
+type FuncerFuncParameterExpectation struct {
+	fake     *FakeFuncer
+	t        FuncerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 FuncerMatcher[func(string) string]
+	}
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *FuncerFuncParameterExpectation) With(ident1 FuncerMatcher[func(string) string]) *FuncerFuncParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *FuncerFuncParameterExpectation) matches(ident1 func(string) string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *FuncerFuncParameterExpectation) Times(n int) *FuncerFuncParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // FuncerFuncReturnInvocation represents a single call of FakeFuncer.FuncReturn
 type FuncerFuncReturnInvocation struct {
-	Results struct {
-		Ident1 func(string) string
-	}
+	Results FuncerFuncReturnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *FuncerFuncReturnInvocation) String() string {
+	return "FakeFuncer.FuncReturn()"
+}
+
+// FuncerFuncReturnResults holds the results of a single call of FakeFuncer.FuncReturn
+type FuncerFuncReturnResults struct {
+	Ident1 func(string) string
+}
+
+// FuncerFuncReturnExpectation is a call of FakeFuncer.FuncReturn expected by a test, created by FakeFuncer.ExpectFuncReturn
+type FuncerFuncReturnExpectation struct {
+	fake    *FakeFuncer
+	t       FuncerTestingT
+	times   int
+	count   int
+	returns bool
+	results FuncerFuncReturnResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *FuncerFuncReturnExpectation) Times(n int) *FuncerFuncReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *FuncerFuncReturnExpectation) Return(ident1 func(string) string) *FuncerFuncReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = FuncerFuncReturnResults{Ident1: ident1}
+	return e
 }
 
 // FuncerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type FuncerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type FuncerTestingT = fake.TestingT
+
+// FuncerMatcher matches a parameter of a call to FakeFuncer
+type FuncerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// FuncerMatcherFunc is a FuncerMatcher implemented by a predicate function
+type FuncerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m FuncerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// FuncerAny returns a FuncerMatcher that matches any value
+func FuncerAny[T any]() FuncerMatcher[T] {
+	return fake.Any[T]()
+}
+
+// FuncerEq returns a FuncerMatcher that matches values deeply equal to want
+func FuncerEq[T any](want T) FuncerMatcher[T] {
+	return fake.Eq(want)
 }
 
+// FuncerNot returns a FuncerMatcher that matches the values the given matcher does not
+func FuncerNot[T any](m FuncerMatcher[T]) FuncerMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// FuncerPred returns a FuncerMatcher that matches values for which the given predicate returns true
+func FuncerPred[T any](pred func(T) bool) FuncerMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// FuncerAnyOfType returns a FuncerMatcher that matches values whose dynamic type is, or implements, U
+func FuncerAnyOfType[T, U any]() FuncerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// FuncerCallMatcher selects calls of a method of a fake for FuncerInOrder and FuncerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeFuncer
+type FuncerCallMatcher = fake.CallMatcher
+
+// FuncerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func FuncerInOrder(t FuncerTestingT, calls ...FuncerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// FuncerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func FuncerUnordered(t FuncerTestingT, calls ...FuncerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// FuncerExhausted selects how a method of FakeFuncer behaves once the results given to its SetXReturnsSequence method are used up
+type FuncerExhausted = fake.Exhausted
+
+const (
+	// FuncerRepeatLast returns the last results in the sequence from all later calls
+	FuncerRepeatLast = fake.RepeatLast
+	// FuncerPanicAfterSequence panics on all later calls
+	FuncerPanicAfterSequence = fake.PanicAfterSequence
+	// FuncerHookAfterSequence calls the method's hook on all later calls
+	FuncerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeFuncer is a mock implementation of Funcer for testing.
 Use it in your tests as in this example:
@@ -42,13 +195,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeFuncParameter ...
+		// assert state of FakeFuncer ...
 		f.AssertFuncParameterCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeFuncParameter.
+unexpected calls are made to FakeFuncer.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeFuncer struct {
 	FuncParameterHook func(func(string) string)
@@ -56,6 +213,13 @@ type FakeFuncer struct {
 
 	FuncParameterCalls []*FuncerFuncParameterInvocation
 	FuncReturnCalls    []*FuncerFuncReturnInvocation
+
+	returnsFuncReturn         fake.Returns[FuncerFuncReturnResults]
+	expectationsFuncParameter []*FuncerFuncParameterExpectation
+	expectationsFuncReturn    []*FuncerFuncReturnExpectation
+	mutex                     sync.Mutex
+	recorded                  chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters            bool
 }
 
 // NewFakeFuncerDefaultPanic returns an instance of FakeFuncer with all hooks configured to panic
@@ -71,61 +235,230 @@ func NewFakeFuncerDefaultPanic() *FakeFuncer {
 }
 
 // NewFakeFuncerDefaultFatal returns an instance of FakeFuncer with all hooks configured to call t.Fatal
-func NewFakeFuncerDefaultFatal(t_sym1 FuncerTestingT) *FakeFuncer {
+func NewFakeFuncerDefaultFatal(t FuncerTestingT) *FakeFuncer {
 	return &FakeFuncer{
 		FuncParameterHook: func(func(string) string) {
-			t_sym1.Fatal("Unexpected call to Funcer.FuncParameter")
+			t.Fatal("Unexpected call to Funcer.FuncParameter")
 			return
 		},
 		FuncReturnHook: func() (ident1 func(string) string) {
-			t_sym1.Fatal("Unexpected call to Funcer.FuncReturn")
+			t.Fatal("Unexpected call to Funcer.FuncReturn")
 			return
 		},
 	}
 }
 
 // NewFakeFuncerDefaultError returns an instance of FakeFuncer with all hooks configured to call t.Error
-func NewFakeFuncerDefaultError(t_sym2 FuncerTestingT) *FakeFuncer {
+func NewFakeFuncerDefaultError(t FuncerTestingT) *FakeFuncer {
 	return &FakeFuncer{
 		FuncParameterHook: func(func(string) string) {
-			t_sym2.Error("Unexpected call to Funcer.FuncParameter")
+			t.Error("Unexpected call to Funcer.FuncParameter")
 			return
 		},
 		FuncReturnHook: func() (ident1 func(string) string) {
-			t_sym2.Error("Unexpected call to Funcer.FuncReturn")
+			t.Error("Unexpected call to Funcer.FuncReturn")
 			return
 		},
 	}
 }
 
+// NewFakeFuncerDefaultZero returns an instance of FakeFuncer with all hooks configured to return zero values
+func NewFakeFuncerDefaultZero() *FakeFuncer {
+	return &FakeFuncer{
+		FuncParameterHook: func(func(string) string) {
+			return
+		},
+		FuncReturnHook: func() (ident1 func(string) string) {
+			return
+		},
+	}
+}
+
+// NewFakeFuncerDefaultFriendly returns an instance of FakeFuncer with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeFuncer are friendly fakes, one for each method and result, created on first use
+func NewFakeFuncerDefaultFriendly() *FakeFuncer {
+
+	return &FakeFuncer{
+		FuncParameterHook: func(func(string) string) {
+			return
+		},
+		FuncReturnHook: func() (ident1 func(string) string) {
+			return
+		},
+	}
+}
+
+// NewFakeFuncerSpy returns an instance of FakeFuncer with all hooks configured to call the given implementation
+func NewFakeFuncerSpy(real Funcer) *FakeFuncer {
+	return &FakeFuncer{
+		FuncParameterHook: real.FuncParameter,
+		FuncReturnHook:    real.FuncReturn,
+	}
+}
+
+// Reset forgets all calls made to FakeFuncer
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeFuncer) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.FuncParameterCalls = []*FuncerFuncParameterInvocation{}
+	f.returnsFuncReturn.Rebase(len(f.FuncReturnCalls))
 	f.FuncReturnCalls = []*FuncerFuncReturnInvocation{}
 }
 
-func (f_sym3 *FakeFuncer) FuncParameter(ident1 func(string) string) {
-	if f_sym3.FuncParameterHook == nil {
+// SetCopyParameters configures FakeFuncer to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeFuncer) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeFuncer) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeFuncer) FuncParameter(ident1 func(string) string) {
+	f.mutex.Lock()
+	hook := f.FuncParameterHook
+	expectation, t := f.expectedFuncParameter(ident1)
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Funcer.FuncParameter() called but FakeFuncer.FuncParameterHook is nil")
 	}
 
-	invocation_sym3 := new(FuncerFuncParameterInvocation)
-	f_sym3.FuncParameterCalls = append(f_sym3.FuncParameterCalls, invocation_sym3)
+	invocation := new(FuncerFuncParameterInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.FuncParameterCalls = append(f.FuncParameterCalls, invocation)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	f_sym3.FuncParameterHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeFuncer.FuncParameter called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if hook != nil {
+		hook(ident1)
+	}
 
 	return
 }
 
+// expectedFuncParameter returns the first unsatisfied expectation of FakeFuncer.FuncParameter matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeFuncer) expectedFuncParameter(ident1 func(string) string) (*FuncerFuncParameterExpectation, FuncerTestingT) {
+	if len(f.expectationsFuncParameter) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsFuncParameter {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsFuncParameter[0].t
+}
+
+// ExpectFuncParameter expects calls of FakeFuncer.FuncParameter, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeFuncer) ExpectFuncParameter(t FuncerTestingT) *FuncerFuncParameterExpectation {
+	t.Helper()
+	expectation := &FuncerFuncParameterExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsFuncParameter = append(f.expectationsFuncParameter, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeFuncer.FuncParameter called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetFuncParameterHook configures Funcer.FuncParameter to call the given function
+func (f *FakeFuncer) SetFuncParameterHook(hook func(func(string) string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.FuncParameterHook = hook
+}
+
+// FuncParameterCallsSnapshot returns a copy of the calls made to FakeFuncer.FuncParameter
+func (f *FakeFuncer) FuncParameterCallsSnapshot() []*FuncerFuncParameterInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*FuncerFuncParameterInvocation, len(f.FuncParameterCalls))
+	for i, call := range f.FuncParameterCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// FuncParameterCall returns a FuncerCallMatcher selecting the calls of FakeFuncer.FuncParameter with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeFuncer) FuncParameterCall(ident1 FuncerMatcher[func(string) string]) FuncerCallMatcher {
+	return fake.NewCallMatcher("FakeFuncer.FuncParameter(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*FuncerFuncParameterInvocation(nil), f.FuncParameterCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
 // FuncParameterCalled returns true if FakeFuncer.FuncParameter was called
 func (f *FakeFuncer) FuncParameterCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncParameterCalls) != 0
 }
 
 // AssertFuncParameterCalled calls t.Error if FakeFuncer.FuncParameter was not called
 func (f *FakeFuncer) AssertFuncParameterCalled(t FuncerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncParameterCalls) == 0 {
 		t.Error("FakeFuncer.FuncParameter not called, expected at least one")
 	}
@@ -133,12 +466,16 @@ func (f *FakeFuncer) AssertFuncParameterCalled(t FuncerTestingT) {
 
 // FuncParameterNotCalled returns true if FakeFuncer.FuncParameter was not called
 func (f *FakeFuncer) FuncParameterNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncParameterCalls) == 0
 }
 
 // AssertFuncParameterNotCalled calls t.Error if FakeFuncer.FuncParameter was called
 func (f *FakeFuncer) AssertFuncParameterNotCalled(t FuncerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncParameterCalls) != 0 {
 		t.Error("FakeFuncer.FuncParameter called, expected none")
 	}
@@ -146,12 +483,16 @@ func (f *FakeFuncer) AssertFuncParameterNotCalled(t FuncerTestingT) {
 
 // FuncParameterCalledOnce returns true if FakeFuncer.FuncParameter was called exactly once
 func (f *FakeFuncer) FuncParameterCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncParameterCalls) == 1
 }
 
 // AssertFuncParameterCalledOnce calls t.Error if FakeFuncer.FuncParameter was not called exactly once
 func (f *FakeFuncer) AssertFuncParameterCalledOnce(t FuncerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncParameterCalls) != 1 {
 		t.Errorf("FakeFuncer.FuncParameter called %d times, expected 1", len(f.FuncParameterCalls))
 	}
@@ -159,21 +500,92 @@ func (f *FakeFuncer) AssertFuncParameterCalledOnce(t FuncerTestingT) {
 
 // FuncParameterCalledN returns true if FakeFuncer.FuncParameter was called at least n times
 func (f *FakeFuncer) FuncParameterCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncParameterCalls) >= n
 }
 
 // AssertFuncParameterCalledN calls t.Error if FakeFuncer.FuncParameter was called less than n times
 func (f *FakeFuncer) AssertFuncParameterCalledN(t FuncerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncParameterCalls) < n {
 		t.Errorf("FakeFuncer.FuncParameter called %d times, expected >= %d", len(f.FuncParameterCalls), n)
 	}
 }
 
-// FuncParameterCalledWith returns true if FakeFuncer.FuncParameter was called with the given values
-func (f_sym4 *FakeFuncer) FuncParameterCalledWith(ident1 func(string) string) bool {
-	for _, call_sym4 := range f_sym4.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.Ident1, ident1) {
+// WaitForFuncParameterCalled blocks until FakeFuncer.FuncParameter has been called, returning the error of ctx if it is done first
+func (f *FakeFuncer) WaitForFuncParameterCalled(ctx context.Context) error {
+	return f.WaitForFuncParameterCalledN(ctx, 1)
+}
+
+// WaitForFuncParameterCalledN blocks until FakeFuncer.FuncParameter has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeFuncer) WaitForFuncParameterCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.FuncParameterCalls) >= n
+	})
+}
+
+// AssertFuncParameterEventuallyCalled calls t.Error if FakeFuncer.FuncParameter is not called within the timeout
+func (f *FakeFuncer) AssertFuncParameterEventuallyCalled(t FuncerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForFuncParameterCalled(ctx) != nil {
+		t.Errorf("FakeFuncer.FuncParameter not called within %v", timeout)
+	}
+}
+
+// describeFuncParameterCalls describes the calls of FakeFuncer.FuncParameter against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeFuncer) describeFuncParameterCalls(ident1 FuncerMatcher[func(string) string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.FuncParameterCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.FuncParameterCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.FuncParameterCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// FuncParameterCalledWith returns true if FakeFuncer.FuncParameter was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeFuncer) FuncParameterCalledWith(ident1 FuncerMatcher[func(string) string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.FuncParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -181,79 +593,232 @@ func (f_sym4 *FakeFuncer) FuncParameterCalledWith(ident1 func(string) string) bo
 	return false
 }
 
-// AssertFuncParameterCalledWith calls t.Error if FakeFuncer.FuncParameter was not called with the given values
-func (f_sym5 *FakeFuncer) AssertFuncParameterCalledWith(t FuncerTestingT, ident1 func(string) string) {
+// AssertFuncParameterCalledWith calls t.Error if FakeFuncer.FuncParameter was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeFuncer) AssertFuncParameterCalledWith(t FuncerTestingT, ident1 FuncerMatcher[func(string) string]) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-			found_sym5 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.FuncParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym5 {
-		t.Error("FakeFuncer.FuncParameter not called with expected parameters")
+	if !found {
+		t.Errorf("FakeFuncer.FuncParameter not called with expected parameters\n%s", f.describeFuncParameterCalls(ident1))
 	}
 }
 
-// FuncParameterCalledOnceWith returns true if FakeFuncer.FuncParameter was called exactly once with the given values
-func (f_sym6 *FakeFuncer) FuncParameterCalledOnceWith(ident1 func(string) string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
-			count_sym6++
+// FuncParameterCalledOnceWith returns true if FakeFuncer.FuncParameter was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeFuncer) FuncParameterCalledOnceWith(ident1 FuncerMatcher[func(string) string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.FuncParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym6 == 1
+	return count == 1
 }
 
-// AssertFuncParameterCalledOnceWith calls t.Error if FakeFuncer.FuncParameter was not called exactly once with the given values
-func (f_sym7 *FakeFuncer) AssertFuncParameterCalledOnceWith(t FuncerTestingT, ident1 func(string) string) {
+// AssertFuncParameterCalledOnceWith calls t.Error if FakeFuncer.FuncParameter was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeFuncer) AssertFuncParameterCalledOnceWith(t FuncerTestingT, ident1 FuncerMatcher[func(string) string]) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.FuncParameterCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			count_sym7++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.FuncParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeFuncer.FuncParameter called %d times with expected parameters, expected one", count_sym7)
+	if count != 1 {
+		t.Errorf("FakeFuncer.FuncParameter called %d times with expected parameters, expected one\n%s", count, f.describeFuncParameterCalls(ident1))
 	}
 }
 
-func (f_sym8 *FakeFuncer) FuncReturn() (ident1 func(string) string) {
-	if f_sym8.FuncReturnHook == nil {
+// AssertFuncParameterEventuallyCalledWith calls t.Error if FakeFuncer.FuncParameter is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeFuncer) AssertFuncParameterEventuallyCalledWith(t FuncerTestingT, timeout time.Duration, ident1 FuncerMatcher[func(string) string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.FuncParameterCalls)
+		for _, call := range f.FuncParameterCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeFuncer.FuncParameter not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeFuncParameterCalls(ident1))
+	}
+}
+
+func (f *FakeFuncer) FuncReturn() (ident1 func(string) string) {
+	f.mutex.Lock()
+	hook := f.FuncReturnHook
+	expectation, t := f.expectedFuncReturn()
+	var results FuncerFuncReturnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsFuncReturn.Lookup(len(f.FuncReturnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Funcer.FuncReturn() called after the results given to FakeFuncer.SetFuncReturnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Funcer.FuncReturn() called but FakeFuncer.FuncReturnHook is nil")
 	}
 
-	invocation_sym8 := new(FuncerFuncReturnInvocation)
-	f_sym8.FuncReturnCalls = append(f_sym8.FuncReturnCalls, invocation_sym8)
+	invocation := new(FuncerFuncReturnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.FuncReturnCalls = append(f.FuncReturnCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	ident1 = f_sym8.FuncReturnHook()
+	if t != nil && expectation == nil {
+		t.Error("FakeFuncer.FuncReturn called more times than expected")
+	}
 
-	invocation_sym8.Results.Ident1 = ident1
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedFuncReturn returns the first unsatisfied expectation of FakeFuncer.FuncReturn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeFuncer) expectedFuncReturn() (*FuncerFuncReturnExpectation, FuncerTestingT) {
+	if len(f.expectationsFuncReturn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsFuncReturn {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsFuncReturn[0].t
+}
+
+// ExpectFuncReturn expects calls of FakeFuncer.FuncReturn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeFuncer) ExpectFuncReturn(t FuncerTestingT) *FuncerFuncReturnExpectation {
+	t.Helper()
+	expectation := &FuncerFuncReturnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsFuncReturn = append(f.expectationsFuncReturn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeFuncer.FuncReturn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetFuncReturnHook configures Funcer.FuncReturn to call the given function
+func (f *FakeFuncer) SetFuncReturnHook(hook func() func(string) string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.FuncReturnHook = hook
+}
+
 // SetFuncReturnStub configures Funcer.FuncReturn to always return the given values
-func (f_sym9 *FakeFuncer) SetFuncReturnStub(ident1 func(string) string) {
-	f_sym9.FuncReturnHook = func() func(string) string {
+func (f *FakeFuncer) SetFuncReturnStub(ident1 func(string) string) {
+	f.SetFuncReturnHook(func() func(string) string {
 		return ident1
+	})
+}
+
+// SetFuncReturnReturnsOnCall configures Funcer.FuncReturn to return the given values from the call with the given index in FuncReturnCalls, rather than calling the hook
+func (f *FakeFuncer) SetFuncReturnReturnsOnCall(call int, ident1 func(string) string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsFuncReturn.Set(call, FuncerFuncReturnResults{Ident1: ident1})
+}
+
+// SetFuncReturnReturnsSequence configures the following calls of Funcer.FuncReturn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeFuncer) SetFuncReturnReturnsSequence(exhausted FuncerExhausted, results ...FuncerFuncReturnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsFuncReturn.Sequence(len(f.FuncReturnCalls), exhausted, results)
+}
+
+// FuncReturnCallsSnapshot returns a copy of the calls made to FakeFuncer.FuncReturn
+func (f *FakeFuncer) FuncReturnCallsSnapshot() []*FuncerFuncReturnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*FuncerFuncReturnInvocation, len(f.FuncReturnCalls))
+	for i, call := range f.FuncReturnCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// FuncReturnCall returns a FuncerCallMatcher selecting the calls of FakeFuncer.FuncReturn
+func (f *FakeFuncer) FuncReturnCall() FuncerCallMatcher {
+	return fake.NewCallMatcher("FakeFuncer.FuncReturn()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*FuncerFuncReturnInvocation(nil), f.FuncReturnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // FuncReturnCalled returns true if FakeFuncer.FuncReturn was called
 func (f *FakeFuncer) FuncReturnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncReturnCalls) != 0
 }
 
 // AssertFuncReturnCalled calls t.Error if FakeFuncer.FuncReturn was not called
 func (f *FakeFuncer) AssertFuncReturnCalled(t FuncerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncReturnCalls) == 0 {
 		t.Error("FakeFuncer.FuncReturn not called, expected at least one")
 	}
@@ -261,12 +826,16 @@ func (f *FakeFuncer) AssertFuncReturnCalled(t FuncerTestingT) {
 
 // FuncReturnNotCalled returns true if FakeFuncer.FuncReturn was not called
 func (f *FakeFuncer) FuncReturnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncReturnCalls) == 0
 }
 
 // AssertFuncReturnNotCalled calls t.Error if FakeFuncer.FuncReturn was called
 func (f *FakeFuncer) AssertFuncReturnNotCalled(t FuncerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncReturnCalls) != 0 {
 		t.Error("FakeFuncer.FuncReturn called, expected none")
 	}
@@ -274,12 +843,16 @@ func (f *FakeFuncer) AssertFuncReturnNotCalled(t FuncerTestingT) {
 
 // FuncReturnCalledOnce returns true if FakeFuncer.FuncReturn was called exactly once
 func (f *FakeFuncer) FuncReturnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncReturnCalls) == 1
 }
 
 // AssertFuncReturnCalledOnce calls t.Error if FakeFuncer.FuncReturn was not called exactly once
 func (f *FakeFuncer) AssertFuncReturnCalledOnce(t FuncerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncReturnCalls) != 1 {
 		t.Errorf("FakeFuncer.FuncReturn called %d times, expected 1", len(f.FuncReturnCalls))
 	}
@@ -287,13 +860,39 @@ func (f *FakeFuncer) AssertFuncReturnCalledOnce(t FuncerTestingT) {
 
 // FuncReturnCalledN returns true if FakeFuncer.FuncReturn was called at least n times
 func (f *FakeFuncer) FuncReturnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.FuncReturnCalls) >= n
 }
 
 // AssertFuncReturnCalledN calls t.Error if FakeFuncer.FuncReturn was called less than n times
 func (f *FakeFuncer) AssertFuncReturnCalledN(t FuncerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.FuncReturnCalls) < n {
 		t.Errorf("FakeFuncer.FuncReturn called %d times, expected >= %d", len(f.FuncReturnCalls), n)
 	}
 }
+
+// WaitForFuncReturnCalled blocks until FakeFuncer.FuncReturn has been called, returning the error of ctx if it is done first
+func (f *FakeFuncer) WaitForFuncReturnCalled(ctx context.Context) error {
+	return f.WaitForFuncReturnCalledN(ctx, 1)
+}
+
+// WaitForFuncReturnCalledN blocks until FakeFuncer.FuncReturn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeFuncer) WaitForFuncReturnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.FuncReturnCalls) >= n
+	})
+}
+
+// AssertFuncReturnEventuallyCalled calls t.Error if FakeFuncer.FuncReturn is not called within the timeout
+func (f *FakeFuncer) AssertFuncReturnEventuallyCalled(t FuncerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForFuncReturnCalled(ctx) != nil {
+		t.Errorf("FakeFuncer.FuncReturn not called within %v", timeout)
+	}
+}
@@ -0,0 +1,12 @@
+package main
+
+type (
+	Grouped interface {
+		Group() string
+	}
+
+	Grouper interface {
+		Grouped
+		Ungroup(string) bool
+	}
+)
@@ -2,16 +2,39 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // IdentifierTestConstructorInvocation represents a single call of FakeIdentifier.TestConstructor
 type IdentifierTestConstructorInvocation struct {
 	Parameters struct {
 		Val int64
 	}
-	Results struct {
-		T string
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetTestConstructorInvocation
+	Matchers struct {
+		Val IdentifierMatcher[int64]
 	}
+	Results IdentifierTestConstructorResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *IdentifierTestConstructorInvocation) String() string {
+	return fmt.Sprintf("FakeIdentifier.TestConstructor%+v", i.Parameters)
+}
+
+// IdentifierTestConstructorResults holds the results of a single call of FakeIdentifier.TestConstructor
+type IdentifierTestConstructorResults struct {
+	T string
 }
 
 // NewIdentifierTestConstructorInvocation creates a new instance of IdentifierTestConstructorInvocation
@@ -25,16 +48,73 @@ func NewIdentifierTestConstructorInvocation(val int64, t string) *IdentifierTest
 	return invocation
 }
 
+// IdentifierTestConstructorExpectation is a call of FakeIdentifier.TestConstructor expected by a test, created by FakeIdentifier.ExpectTestConstructor
+type IdentifierTestConstructorExpectation struct {
+	fake     *FakeIdentifier
+	t        IdentifierTestingT
+	times    int
+	count    int
+	matchers struct {
+		Val IdentifierMatcher[int64]
+	}
+	returns bool
+	results IdentifierTestConstructorResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *IdentifierTestConstructorExpectation) With(val IdentifierMatcher[int64]) *IdentifierTestConstructorExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Val = val
+
+	return e
+}
+
+func (e *IdentifierTestConstructorExpectation) matches(val int64) bool {
+	return fake.Matches(e.matchers.Val, val)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *IdentifierTestConstructorExpectation) Times(n int) *IdentifierTestConstructorExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *IdentifierTestConstructorExpectation) Return(t string) *IdentifierTestConstructorExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = IdentifierTestConstructorResults{T: t}
+	return e
+}
+
 // IdentifierInvocationSetterInvocation represents a single call of FakeIdentifier.InvocationSetter
 type IdentifierInvocationSetterInvocation struct {
 	Parameters struct {
 		Val int64
 	}
-	Results struct {
-		Call     string
-		Calls    string
-		Fallback string
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetInvocationSetterInvocation
+	Matchers struct {
+		Val IdentifierMatcher[int64]
 	}
+	Results IdentifierInvocationSetterResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *IdentifierInvocationSetterInvocation) String() string {
+	return fmt.Sprintf("FakeIdentifier.InvocationSetter%+v", i.Parameters)
+}
+
+// IdentifierInvocationSetterResults holds the results of a single call of FakeIdentifier.InvocationSetter
+type IdentifierInvocationSetterResults struct {
+	Call     string
+	Calls    string
+	Fallback string
 }
 
 // NewIdentifierInvocationSetterInvocation creates a new instance of IdentifierInvocationSetterInvocation
@@ -50,14 +130,120 @@ func NewIdentifierInvocationSetterInvocation(val int64, call string, calls strin
 	return invocation
 }
 
+// IdentifierInvocationSetterExpectation is a call of FakeIdentifier.InvocationSetter expected by a test, created by FakeIdentifier.ExpectInvocationSetter
+type IdentifierInvocationSetterExpectation struct {
+	fake     *FakeIdentifier
+	t        IdentifierTestingT
+	times    int
+	count    int
+	matchers struct {
+		Val IdentifierMatcher[int64]
+	}
+	returns bool
+	results IdentifierInvocationSetterResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *IdentifierInvocationSetterExpectation) With(val IdentifierMatcher[int64]) *IdentifierInvocationSetterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Val = val
+
+	return e
+}
+
+func (e *IdentifierInvocationSetterExpectation) matches(val int64) bool {
+	return fake.Matches(e.matchers.Val, val)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *IdentifierInvocationSetterExpectation) Times(n int) *IdentifierInvocationSetterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *IdentifierInvocationSetterExpectation) Return(call string, calls string, fallback string) *IdentifierInvocationSetterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = IdentifierInvocationSetterResults{Call: call, Calls: calls, Fallback: fallback}
+	return e
+}
+
 // IdentifierTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type IdentifierTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type IdentifierTestingT = fake.TestingT
+
+// IdentifierMatcher matches a parameter of a call to FakeIdentifier
+type IdentifierMatcher[T any] interface {
+	Match(T) bool
+}
+
+// IdentifierMatcherFunc is a IdentifierMatcher implemented by a predicate function
+type IdentifierMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m IdentifierMatcherFunc[T]) Match(v T) bool {
+	return m(v)
 }
 
+// IdentifierAny returns a IdentifierMatcher that matches any value
+func IdentifierAny[T any]() IdentifierMatcher[T] {
+	return fake.Any[T]()
+}
+
+// IdentifierEq returns a IdentifierMatcher that matches values deeply equal to want
+func IdentifierEq[T any](want T) IdentifierMatcher[T] {
+	return fake.Eq(want)
+}
+
+// IdentifierNot returns a IdentifierMatcher that matches the values the given matcher does not
+func IdentifierNot[T any](m IdentifierMatcher[T]) IdentifierMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// IdentifierPred returns a IdentifierMatcher that matches values for which the given predicate returns true
+func IdentifierPred[T any](pred func(T) bool) IdentifierMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// IdentifierAnyOfType returns a IdentifierMatcher that matches values whose dynamic type is, or implements, U
+func IdentifierAnyOfType[T, U any]() IdentifierMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// IdentifierCallMatcher selects calls of a method of a fake for IdentifierInOrder and IdentifierUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeIdentifier
+type IdentifierCallMatcher = fake.CallMatcher
+
+// IdentifierInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func IdentifierInOrder(t IdentifierTestingT, calls ...IdentifierCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// IdentifierUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func IdentifierUnordered(t IdentifierTestingT, calls ...IdentifierCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// IdentifierExhausted selects how a method of FakeIdentifier behaves once the results given to its SetXReturnsSequence method are used up
+type IdentifierExhausted = fake.Exhausted
+
+const (
+	// IdentifierRepeatLast returns the last results in the sequence from all later calls
+	IdentifierRepeatLast = fake.RepeatLast
+	// IdentifierPanicAfterSequence panics on all later calls
+	IdentifierPanicAfterSequence = fake.PanicAfterSequence
+	// IdentifierHookAfterSequence calls the method's hook on all later calls
+	IdentifierHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeIdentifier is a mock implementation of Identifier for testing.
 Use it in your tests as in this example:
@@ -74,20 +260,34 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeTestConstructor ...
+		// assert state of FakeIdentifier ...
 		f.AssertTestConstructorCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeTestConstructor.
+unexpected calls are made to FakeIdentifier.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeIdentifier struct {
-	TestConstructorHook  func(int64) string
+	// Issue #20 named return identifier conflicts with test context constructor parameter
+	TestConstructorHook func(int64) string
+	// Issue #21 named return identifer conflicts with Set{Method}Invocation parameter(s)
 	InvocationSetterHook func(int64) (string, string, string)
 
 	TestConstructorCalls  []*IdentifierTestConstructorInvocation
 	InvocationSetterCalls []*IdentifierInvocationSetterInvocation
+
+	returnsTestConstructor       fake.Returns[IdentifierTestConstructorResults]
+	returnsInvocationSetter      fake.Returns[IdentifierInvocationSetterResults]
+	expectationsTestConstructor  []*IdentifierTestConstructorExpectation
+	expectationsInvocationSetter []*IdentifierInvocationSetterExpectation
+	mutex                        sync.Mutex
+	recorded                     chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters               bool
 }
 
 // NewFakeIdentifierDefaultPanic returns an instance of FakeIdentifier with all hooks configured to panic
@@ -103,86 +303,288 @@ func NewFakeIdentifierDefaultPanic() *FakeIdentifier {
 }
 
 // NewFakeIdentifierDefaultFatal returns an instance of FakeIdentifier with all hooks configured to call t.Fatal
-func NewFakeIdentifierDefaultFatal(t_sym1 IdentifierTestingT) *FakeIdentifier {
+func NewFakeIdentifierDefaultFatal(t2 IdentifierTestingT) *FakeIdentifier {
 	return &FakeIdentifier{
 		TestConstructorHook: func(int64) (t string) {
-			t_sym1.Fatal("Unexpected call to Identifier.TestConstructor")
+			t2.Fatal("Unexpected call to Identifier.TestConstructor")
 			return
 		},
 		InvocationSetterHook: func(int64) (call string, calls string, fallback string) {
-			t_sym1.Fatal("Unexpected call to Identifier.InvocationSetter")
+			t2.Fatal("Unexpected call to Identifier.InvocationSetter")
 			return
 		},
 	}
 }
 
 // NewFakeIdentifierDefaultError returns an instance of FakeIdentifier with all hooks configured to call t.Error
-func NewFakeIdentifierDefaultError(t_sym2 IdentifierTestingT) *FakeIdentifier {
+func NewFakeIdentifierDefaultError(t2 IdentifierTestingT) *FakeIdentifier {
 	return &FakeIdentifier{
 		TestConstructorHook: func(int64) (t string) {
-			t_sym2.Error("Unexpected call to Identifier.TestConstructor")
+			t2.Error("Unexpected call to Identifier.TestConstructor")
 			return
 		},
 		InvocationSetterHook: func(int64) (call string, calls string, fallback string) {
-			t_sym2.Error("Unexpected call to Identifier.InvocationSetter")
+			t2.Error("Unexpected call to Identifier.InvocationSetter")
 			return
 		},
 	}
 }
 
+// NewFakeIdentifierDefaultZero returns an instance of FakeIdentifier with all hooks configured to return zero values
+func NewFakeIdentifierDefaultZero() *FakeIdentifier {
+	return &FakeIdentifier{
+		TestConstructorHook: func(int64) (t string) {
+			return
+		},
+		InvocationSetterHook: func(int64) (call string, calls string, fallback string) {
+			return
+		},
+	}
+}
+
+// NewFakeIdentifierDefaultFriendly returns an instance of FakeIdentifier with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeIdentifier are friendly fakes, one for each method and result, created on first use
+func NewFakeIdentifierDefaultFriendly() *FakeIdentifier {
+
+	return &FakeIdentifier{
+		TestConstructorHook: func(int64) (t string) {
+			return
+		},
+		InvocationSetterHook: func(int64) (call string, calls string, fallback string) {
+			return
+		},
+	}
+}
+
+// NewFakeIdentifierSpy returns an instance of FakeIdentifier with all hooks configured to call the given implementation
+func NewFakeIdentifierSpy(real Identifier) *FakeIdentifier {
+	return &FakeIdentifier{
+		TestConstructorHook:  real.TestConstructor,
+		InvocationSetterHook: real.InvocationSetter,
+	}
+}
+
+// Reset forgets all calls made to FakeIdentifier
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeIdentifier) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsTestConstructor.Rebase(len(f.TestConstructorCalls))
 	f.TestConstructorCalls = []*IdentifierTestConstructorInvocation{}
+	f.returnsInvocationSetter.Rebase(len(f.InvocationSetterCalls))
 	f.InvocationSetterCalls = []*IdentifierInvocationSetterInvocation{}
 }
 
-func (f_sym3 *FakeIdentifier) TestConstructor(val int64) (t string) {
-	if f_sym3.TestConstructorHook == nil {
+// SetCopyParameters configures FakeIdentifier to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeIdentifier) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeIdentifier) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Issue #20 named return identifier conflicts with test context constructor parameter
+func (f *FakeIdentifier) TestConstructor(val int64) (t string) {
+	f.mutex.Lock()
+	hook := f.TestConstructorHook
+	expectation, t2 := f.expectedTestConstructor(val)
+	var results IdentifierTestConstructorResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsTestConstructor.Lookup(len(f.TestConstructorCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Identifier.TestConstructor() called after the results given to FakeIdentifier.SetTestConstructorReturnsSequence were used up")
+	}
+	if hook == nil && !found && t2 == nil {
+		f.mutex.Unlock()
 		panic("Identifier.TestConstructor() called but FakeIdentifier.TestConstructorHook is nil")
 	}
 
-	invocation_sym3 := new(IdentifierTestConstructorInvocation)
-	f_sym3.TestConstructorCalls = append(f_sym3.TestConstructorCalls, invocation_sym3)
+	invocation := new(IdentifierTestConstructorInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.TestConstructorCalls = append(f.TestConstructorCalls, invocation)
+
+	invocation.Parameters.Val = val
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Val = fake.CopyParameter(invocation.Parameters.Val, seen)
+	}
 
-	invocation_sym3.Parameters.Val = val
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t2 != nil && expectation == nil {
+		t2.Errorf("FakeIdentifier.TestConstructor called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	t = f_sym3.TestConstructorHook(val)
+	if found {
+		t = results.T
+	} else if hook != nil {
+		t = hook(val)
+	}
 
-	invocation_sym3.Results.T = t
+	f.mutex.Lock()
+	invocation.Results.T = t
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedTestConstructor returns the first unsatisfied expectation of FakeIdentifier.TestConstructor matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeIdentifier) expectedTestConstructor(val int64) (*IdentifierTestConstructorExpectation, IdentifierTestingT) {
+	if len(f.expectationsTestConstructor) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsTestConstructor {
+		if expectation.count < expectation.times && expectation.matches(val) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsTestConstructor[0].t
+}
+
+// ExpectTestConstructor expects calls of FakeIdentifier.TestConstructor, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeIdentifier) ExpectTestConstructor(t IdentifierTestingT) *IdentifierTestConstructorExpectation {
+	t.Helper()
+	expectation := &IdentifierTestConstructorExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsTestConstructor = append(f.expectationsTestConstructor, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeIdentifier.TestConstructor called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetTestConstructorHook configures Identifier.TestConstructor to call the given function
+func (f *FakeIdentifier) SetTestConstructorHook(hook func(int64) string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.TestConstructorHook = hook
+}
+
 // SetTestConstructorStub configures Identifier.TestConstructor to always return the given values
-func (f_sym4 *FakeIdentifier) SetTestConstructorStub(t string) {
-	f_sym4.TestConstructorHook = func(int64) string {
+func (f *FakeIdentifier) SetTestConstructorStub(t string) {
+	f.SetTestConstructorHook(func(int64) string {
 		return t
-	}
+	})
+}
+
+// SetTestConstructorReturnsOnCall configures Identifier.TestConstructor to return the given values from the call with the given index in TestConstructorCalls, rather than calling the hook
+func (f *FakeIdentifier) SetTestConstructorReturnsOnCall(call int, t string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsTestConstructor.Set(call, IdentifierTestConstructorResults{T: t})
+}
+
+// SetTestConstructorReturnsSequence configures the following calls of Identifier.TestConstructor to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeIdentifier) SetTestConstructorReturnsSequence(exhausted IdentifierExhausted, results ...IdentifierTestConstructorResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsTestConstructor.Sequence(len(f.TestConstructorCalls), exhausted, results)
 }
 
 // SetTestConstructorInvocation configures Identifier.TestConstructor to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeIdentifier) SetTestConstructorInvocation(calls_sym5 []*IdentifierTestConstructorInvocation, fallback_sym5 func() string) {
-	f_sym5.TestConstructorHook = func(val int64) (t string) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Val, val) {
-				t = call_sym5.Results.T
+func (f *FakeIdentifier) SetTestConstructorInvocation(calls []*IdentifierTestConstructorInvocation, fallback func() string) {
+	f.SetTestConstructorHook(func(val int64) (t string) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Val, call.Parameters.Val, val) {
+				t = call.Results.T
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// TestConstructorCallsSnapshot returns a copy of the calls made to FakeIdentifier.TestConstructor
+func (f *FakeIdentifier) TestConstructorCallsSnapshot() []*IdentifierTestConstructorInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*IdentifierTestConstructorInvocation, len(f.TestConstructorCalls))
+	for i, call := range f.TestConstructorCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// TestConstructorCall returns a IdentifierCallMatcher selecting the calls of FakeIdentifier.TestConstructor with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) TestConstructorCall(val IdentifierMatcher[int64]) IdentifierCallMatcher {
+	return fake.NewCallMatcher("FakeIdentifier.TestConstructor(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*IdentifierTestConstructorInvocation(nil), f.TestConstructorCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(val, call.Parameters.Val) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // TestConstructorCalled returns true if FakeIdentifier.TestConstructor was called
 func (f *FakeIdentifier) TestConstructorCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.TestConstructorCalls) != 0
 }
 
 // AssertTestConstructorCalled calls t.Error if FakeIdentifier.TestConstructor was not called
 func (f *FakeIdentifier) AssertTestConstructorCalled(t IdentifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.TestConstructorCalls) == 0 {
 		t.Error("FakeIdentifier.TestConstructor not called, expected at least one")
 	}
@@ -190,12 +592,16 @@ func (f *FakeIdentifier) AssertTestConstructorCalled(t IdentifierTestingT) {
 
 // TestConstructorNotCalled returns true if FakeIdentifier.TestConstructor was not called
 func (f *FakeIdentifier) TestConstructorNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.TestConstructorCalls) == 0
 }
 
 // AssertTestConstructorNotCalled calls t.Error if FakeIdentifier.TestConstructor was called
 func (f *FakeIdentifier) AssertTestConstructorNotCalled(t IdentifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.TestConstructorCalls) != 0 {
 		t.Error("FakeIdentifier.TestConstructor called, expected none")
 	}
@@ -203,12 +609,16 @@ func (f *FakeIdentifier) AssertTestConstructorNotCalled(t IdentifierTestingT) {
 
 // TestConstructorCalledOnce returns true if FakeIdentifier.TestConstructor was called exactly once
 func (f *FakeIdentifier) TestConstructorCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.TestConstructorCalls) == 1
 }
 
 // AssertTestConstructorCalledOnce calls t.Error if FakeIdentifier.TestConstructor was not called exactly once
 func (f *FakeIdentifier) AssertTestConstructorCalledOnce(t IdentifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.TestConstructorCalls) != 1 {
 		t.Errorf("FakeIdentifier.TestConstructor called %d times, expected 1", len(f.TestConstructorCalls))
 	}
@@ -216,21 +626,92 @@ func (f *FakeIdentifier) AssertTestConstructorCalledOnce(t IdentifierTestingT) {
 
 // TestConstructorCalledN returns true if FakeIdentifier.TestConstructor was called at least n times
 func (f *FakeIdentifier) TestConstructorCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.TestConstructorCalls) >= n
 }
 
 // AssertTestConstructorCalledN calls t.Error if FakeIdentifier.TestConstructor was called less than n times
 func (f *FakeIdentifier) AssertTestConstructorCalledN(t IdentifierTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.TestConstructorCalls) < n {
 		t.Errorf("FakeIdentifier.TestConstructor called %d times, expected >= %d", len(f.TestConstructorCalls), n)
 	}
 }
 
-// TestConstructorCalledWith returns true if FakeIdentifier.TestConstructor was called with the given values
-func (f_sym6 *FakeIdentifier) TestConstructorCalledWith(val int64) bool {
-	for _, call_sym6 := range f_sym6.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Val, val) {
+// WaitForTestConstructorCalled blocks until FakeIdentifier.TestConstructor has been called, returning the error of ctx if it is done first
+func (f *FakeIdentifier) WaitForTestConstructorCalled(ctx context.Context) error {
+	return f.WaitForTestConstructorCalledN(ctx, 1)
+}
+
+// WaitForTestConstructorCalledN blocks until FakeIdentifier.TestConstructor has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeIdentifier) WaitForTestConstructorCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.TestConstructorCalls) >= n
+	})
+}
+
+// AssertTestConstructorEventuallyCalled calls t.Error if FakeIdentifier.TestConstructor is not called within the timeout
+func (f *FakeIdentifier) AssertTestConstructorEventuallyCalled(t IdentifierTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForTestConstructorCalled(ctx) != nil {
+		t.Errorf("FakeIdentifier.TestConstructor not called within %v", timeout)
+	}
+}
+
+// describeTestConstructorCalls describes the calls of FakeIdentifier.TestConstructor against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeIdentifier) describeTestConstructorCalls(val IdentifierMatcher[int64]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tVal: %s", fake.Describe(val))
+
+	if len(f.TestConstructorCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.TestConstructorCalls {
+		matched := 0
+		if fake.Matches(val, call.Parameters.Val) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.TestConstructorCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(val, call.Parameters.Val) {
+		fmt.Fprintf(&b, "\n\tVal: got %s, want %s", fake.Format(call.Parameters.Val), fake.Describe(val))
+	}
+
+	return b.String()
+}
+
+// TestConstructorCalledWith returns true if FakeIdentifier.TestConstructor was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) TestConstructorCalledWith(val IdentifierMatcher[int64]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.TestConstructorCalls {
+		if fake.Matches(val, call.Parameters.Val) {
 			return true
 		}
 	}
@@ -238,55 +719,86 @@ func (f_sym6 *FakeIdentifier) TestConstructorCalledWith(val int64) bool {
 	return false
 }
 
-// AssertTestConstructorCalledWith calls t.Error if FakeIdentifier.TestConstructor was not called with the given values
-func (f_sym7 *FakeIdentifier) AssertTestConstructorCalledWith(t IdentifierTestingT, val int64) {
-	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Val, val) {
-			found_sym7 = true
+// AssertTestConstructorCalledWith calls t.Error if FakeIdentifier.TestConstructor was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) AssertTestConstructorCalledWith(t2 IdentifierTestingT, val IdentifierMatcher[int64]) {
+	t2.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.TestConstructorCalls {
+		if fake.Matches(val, call.Parameters.Val) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeIdentifier.TestConstructor not called with expected parameters")
+	if !found {
+		t2.Errorf("FakeIdentifier.TestConstructor not called with expected parameters\n%s", f.describeTestConstructorCalls(val))
 	}
 }
 
-// TestConstructorCalledOnceWith returns true if FakeIdentifier.TestConstructor was called exactly once with the given values
-func (f_sym8 *FakeIdentifier) TestConstructorCalledOnceWith(val int64) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Val, val) {
-			count_sym8++
+// TestConstructorCalledOnceWith returns true if FakeIdentifier.TestConstructor was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) TestConstructorCalledOnceWith(val IdentifierMatcher[int64]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.TestConstructorCalls {
+		if fake.Matches(val, call.Parameters.Val) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertTestConstructorCalledOnceWith calls t.Error if FakeIdentifier.TestConstructor was not called exactly once with the given values
-func (f_sym9 *FakeIdentifier) AssertTestConstructorCalledOnceWith(t IdentifierTestingT, val int64) {
-	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Val, val) {
-			count_sym9++
+// AssertTestConstructorCalledOnceWith calls t.Error if FakeIdentifier.TestConstructor was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) AssertTestConstructorCalledOnceWith(t2 IdentifierTestingT, val IdentifierMatcher[int64]) {
+	t2.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.TestConstructorCalls {
+		if fake.Matches(val, call.Parameters.Val) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeIdentifier.TestConstructor called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t2.Errorf("FakeIdentifier.TestConstructor called %d times with expected parameters, expected one\n%s", count, f.describeTestConstructorCalls(val))
+	}
+}
+
+// AssertTestConstructorEventuallyCalledWith calls t.Error if FakeIdentifier.TestConstructor is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeIdentifier) AssertTestConstructorEventuallyCalledWith(t2 IdentifierTestingT, timeout time.Duration, val IdentifierMatcher[int64]) {
+	t2.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.TestConstructorCalls)
+		for _, call := range f.TestConstructorCalls {
+			if fake.Matches(val, call.Parameters.Val) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t2.Errorf("FakeIdentifier.TestConstructor not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeTestConstructorCalls(val))
 	}
 }
 
-// TestConstructorResultsForCall returns the result values for the first call to FakeIdentifier.TestConstructor with the given values
-func (f_sym10 *FakeIdentifier) TestConstructorResultsForCall(val int64) (t string, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.TestConstructorCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Val, val) {
-			t = call_sym10.Results.T
-			found_sym10 = true
+// TestConstructorResultsForCall returns the result values for the first call to FakeIdentifier.TestConstructor with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) TestConstructorResultsForCall(val IdentifierMatcher[int64]) (t string, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.TestConstructorCalls {
+		if fake.Matches(val, call.Parameters.Val) {
+			t = call.Results.T
+			found = true
 			break
 		}
 	}
@@ -294,58 +806,192 @@ func (f_sym10 *FakeIdentifier) TestConstructorResultsForCall(val int64) (t strin
 	return
 }
 
-func (f_sym11 *FakeIdentifier) InvocationSetter(val int64) (call string, calls string, fallback string) {
-	if f_sym11.InvocationSetterHook == nil {
+// Issue #21 named return identifer conflicts with Set{Method}Invocation parameter(s)
+func (f *FakeIdentifier) InvocationSetter(val int64) (call string, calls string, fallback string) {
+	f.mutex.Lock()
+	hook := f.InvocationSetterHook
+	expectation, t := f.expectedInvocationSetter(val)
+	var results IdentifierInvocationSetterResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsInvocationSetter.Lookup(len(f.InvocationSetterCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Identifier.InvocationSetter() called after the results given to FakeIdentifier.SetInvocationSetterReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Identifier.InvocationSetter() called but FakeIdentifier.InvocationSetterHook is nil")
 	}
 
-	invocation_sym11 := new(IdentifierInvocationSetterInvocation)
-	f_sym11.InvocationSetterCalls = append(f_sym11.InvocationSetterCalls, invocation_sym11)
+	invocation := new(IdentifierInvocationSetterInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.InvocationSetterCalls = append(f.InvocationSetterCalls, invocation)
+
+	invocation.Parameters.Val = val
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Val = fake.CopyParameter(invocation.Parameters.Val, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym11.Parameters.Val = val
+	if t != nil && expectation == nil {
+		t.Errorf("FakeIdentifier.InvocationSetter called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	call, calls, fallback = f_sym11.InvocationSetterHook(val)
+	if found {
+		call = results.Call
+		calls = results.Calls
+		fallback = results.Fallback
+	} else if hook != nil {
+		call, calls, fallback = hook(val)
+	}
 
-	invocation_sym11.Results.Call = call
-	invocation_sym11.Results.Calls = calls
-	invocation_sym11.Results.Fallback = fallback
+	f.mutex.Lock()
+	invocation.Results.Call = call
+	invocation.Results.Calls = calls
+	invocation.Results.Fallback = fallback
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedInvocationSetter returns the first unsatisfied expectation of FakeIdentifier.InvocationSetter matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeIdentifier) expectedInvocationSetter(val int64) (*IdentifierInvocationSetterExpectation, IdentifierTestingT) {
+	if len(f.expectationsInvocationSetter) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsInvocationSetter {
+		if expectation.count < expectation.times && expectation.matches(val) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsInvocationSetter[0].t
+}
+
+// ExpectInvocationSetter expects calls of FakeIdentifier.InvocationSetter, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeIdentifier) ExpectInvocationSetter(t IdentifierTestingT) *IdentifierInvocationSetterExpectation {
+	t.Helper()
+	expectation := &IdentifierInvocationSetterExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsInvocationSetter = append(f.expectationsInvocationSetter, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeIdentifier.InvocationSetter called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetInvocationSetterHook configures Identifier.InvocationSetter to call the given function
+func (f *FakeIdentifier) SetInvocationSetterHook(hook func(int64) (string, string, string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.InvocationSetterHook = hook
+}
+
 // SetInvocationSetterStub configures Identifier.InvocationSetter to always return the given values
-func (f_sym12 *FakeIdentifier) SetInvocationSetterStub(call string, calls string, fallback string) {
-	f_sym12.InvocationSetterHook = func(int64) (string, string, string) {
+func (f *FakeIdentifier) SetInvocationSetterStub(call string, calls string, fallback string) {
+	f.SetInvocationSetterHook(func(int64) (string, string, string) {
 		return call, calls, fallback
-	}
+	})
+}
+
+// SetInvocationSetterReturnsOnCall configures Identifier.InvocationSetter to return the given values from the call with the given index in InvocationSetterCalls, rather than calling the hook
+func (f *FakeIdentifier) SetInvocationSetterReturnsOnCall(call2 int, call string, calls string, fallback string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsInvocationSetter.Set(call2, IdentifierInvocationSetterResults{Call: call, Calls: calls, Fallback: fallback})
+}
+
+// SetInvocationSetterReturnsSequence configures the following calls of Identifier.InvocationSetter to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeIdentifier) SetInvocationSetterReturnsSequence(exhausted IdentifierExhausted, results ...IdentifierInvocationSetterResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsInvocationSetter.Sequence(len(f.InvocationSetterCalls), exhausted, results)
 }
 
 // SetInvocationSetterInvocation configures Identifier.InvocationSetter to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeIdentifier) SetInvocationSetterInvocation(calls_sym13 []*IdentifierInvocationSetterInvocation, fallback_sym13 func() (string, string, string)) {
-	f_sym13.InvocationSetterHook = func(val int64) (call string, calls string, fallback string) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.Val, val) {
-				call = call_sym13.Results.Call
-				calls = call_sym13.Results.Calls
-				fallback = call_sym13.Results.Fallback
+func (f *FakeIdentifier) SetInvocationSetterInvocation(calls2 []*IdentifierInvocationSetterInvocation, fallback2 func() (string, string, string)) {
+	f.SetInvocationSetterHook(func(val int64) (call string, calls string, fallback string) {
+		for _, call2 := range calls2 {
+			if fake.MatchParameter(call2.Matchers.Val, call2.Parameters.Val, val) {
+				call = call2.Results.Call
+				calls = call2.Results.Calls
+				fallback = call2.Results.Fallback
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback2()
+	})
+}
+
+// InvocationSetterCallsSnapshot returns a copy of the calls made to FakeIdentifier.InvocationSetter
+func (f *FakeIdentifier) InvocationSetterCallsSnapshot() []*IdentifierInvocationSetterInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls2 := make([]*IdentifierInvocationSetterInvocation, len(f.InvocationSetterCalls))
+	for i, call2 := range f.InvocationSetterCalls {
+		invocation := *call2
+		calls2[i] = &invocation
 	}
+
+	return calls2
+}
+
+// InvocationSetterCall returns a IdentifierCallMatcher selecting the calls of FakeIdentifier.InvocationSetter with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) InvocationSetterCall(val IdentifierMatcher[int64]) IdentifierCallMatcher {
+	return fake.NewCallMatcher("FakeIdentifier.InvocationSetter(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*IdentifierInvocationSetterInvocation(nil), f.InvocationSetterCalls...)
+		f.mutex.Unlock()
+
+		calls2 := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call2 := range snapshot {
+			calls2[call2.Sequence] = call2.String()
+			if fake.Matches(val, call2.Parameters.Val) {
+				matching = append(matching, call2.Sequence)
+			}
+		}
+
+		return calls2, matching
+	})
 }
 
 // InvocationSetterCalled returns true if FakeIdentifier.InvocationSetter was called
 func (f *FakeIdentifier) InvocationSetterCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InvocationSetterCalls) != 0
 }
 
 // AssertInvocationSetterCalled calls t.Error if FakeIdentifier.InvocationSetter was not called
 func (f *FakeIdentifier) AssertInvocationSetterCalled(t IdentifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InvocationSetterCalls) == 0 {
 		t.Error("FakeIdentifier.InvocationSetter not called, expected at least one")
 	}
@@ -353,12 +999,16 @@ func (f *FakeIdentifier) AssertInvocationSetterCalled(t IdentifierTestingT) {
 
 // InvocationSetterNotCalled returns true if FakeIdentifier.InvocationSetter was not called
 func (f *FakeIdentifier) InvocationSetterNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InvocationSetterCalls) == 0
 }
 
 // AssertInvocationSetterNotCalled calls t.Error if FakeIdentifier.InvocationSetter was called
 func (f *FakeIdentifier) AssertInvocationSetterNotCalled(t IdentifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InvocationSetterCalls) != 0 {
 		t.Error("FakeIdentifier.InvocationSetter called, expected none")
 	}
@@ -366,12 +1016,16 @@ func (f *FakeIdentifier) AssertInvocationSetterNotCalled(t IdentifierTestingT) {
 
 // InvocationSetterCalledOnce returns true if FakeIdentifier.InvocationSetter was called exactly once
 func (f *FakeIdentifier) InvocationSetterCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InvocationSetterCalls) == 1
 }
 
 // AssertInvocationSetterCalledOnce calls t.Error if FakeIdentifier.InvocationSetter was not called exactly once
 func (f *FakeIdentifier) AssertInvocationSetterCalledOnce(t IdentifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InvocationSetterCalls) != 1 {
 		t.Errorf("FakeIdentifier.InvocationSetter called %d times, expected 1", len(f.InvocationSetterCalls))
 	}
@@ -379,21 +1033,92 @@ func (f *FakeIdentifier) AssertInvocationSetterCalledOnce(t IdentifierTestingT)
 
 // InvocationSetterCalledN returns true if FakeIdentifier.InvocationSetter was called at least n times
 func (f *FakeIdentifier) InvocationSetterCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InvocationSetterCalls) >= n
 }
 
 // AssertInvocationSetterCalledN calls t.Error if FakeIdentifier.InvocationSetter was called less than n times
 func (f *FakeIdentifier) AssertInvocationSetterCalledN(t IdentifierTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InvocationSetterCalls) < n {
 		t.Errorf("FakeIdentifier.InvocationSetter called %d times, expected >= %d", len(f.InvocationSetterCalls), n)
 	}
 }
 
-// InvocationSetterCalledWith returns true if FakeIdentifier.InvocationSetter was called with the given values
-func (f_sym14 *FakeIdentifier) InvocationSetterCalledWith(val int64) bool {
-	for _, call_sym14 := range f_sym14.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.Val, val) {
+// WaitForInvocationSetterCalled blocks until FakeIdentifier.InvocationSetter has been called, returning the error of ctx if it is done first
+func (f *FakeIdentifier) WaitForInvocationSetterCalled(ctx context.Context) error {
+	return f.WaitForInvocationSetterCalledN(ctx, 1)
+}
+
+// WaitForInvocationSetterCalledN blocks until FakeIdentifier.InvocationSetter has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeIdentifier) WaitForInvocationSetterCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.InvocationSetterCalls) >= n
+	})
+}
+
+// AssertInvocationSetterEventuallyCalled calls t.Error if FakeIdentifier.InvocationSetter is not called within the timeout
+func (f *FakeIdentifier) AssertInvocationSetterEventuallyCalled(t IdentifierTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForInvocationSetterCalled(ctx) != nil {
+		t.Errorf("FakeIdentifier.InvocationSetter not called within %v", timeout)
+	}
+}
+
+// describeInvocationSetterCalls describes the calls of FakeIdentifier.InvocationSetter against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeIdentifier) describeInvocationSetterCalls(val IdentifierMatcher[int64]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tVal: %s", fake.Describe(val))
+
+	if len(f.InvocationSetterCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call2 := range f.InvocationSetterCalls {
+		matched := 0
+		if fake.Matches(val, call2.Parameters.Val) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call2)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call2)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call2 := f.InvocationSetterCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call2)
+	if !fake.Matches(val, call2.Parameters.Val) {
+		fmt.Fprintf(&b, "\n\tVal: got %s, want %s", fake.Format(call2.Parameters.Val), fake.Describe(val))
+	}
+
+	return b.String()
+}
+
+// InvocationSetterCalledWith returns true if FakeIdentifier.InvocationSetter was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) InvocationSetterCalledWith(val IdentifierMatcher[int64]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call2 := range f.InvocationSetterCalls {
+		if fake.Matches(val, call2.Parameters.Val) {
 			return true
 		}
 	}
@@ -401,57 +1126,88 @@ func (f_sym14 *FakeIdentifier) InvocationSetterCalledWith(val int64) bool {
 	return false
 }
 
-// AssertInvocationSetterCalledWith calls t.Error if FakeIdentifier.InvocationSetter was not called with the given values
-func (f_sym15 *FakeIdentifier) AssertInvocationSetterCalledWith(t IdentifierTestingT, val int64) {
+// AssertInvocationSetterCalledWith calls t.Error if FakeIdentifier.InvocationSetter was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) AssertInvocationSetterCalledWith(t IdentifierTestingT, val IdentifierMatcher[int64]) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.Val, val) {
-			found_sym15 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call2 := range f.InvocationSetterCalls {
+		if fake.Matches(val, call2.Parameters.Val) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym15 {
-		t.Error("FakeIdentifier.InvocationSetter not called with expected parameters")
+	if !found {
+		t.Errorf("FakeIdentifier.InvocationSetter not called with expected parameters\n%s", f.describeInvocationSetterCalls(val))
 	}
 }
 
-// InvocationSetterCalledOnceWith returns true if FakeIdentifier.InvocationSetter was called exactly once with the given values
-func (f_sym16 *FakeIdentifier) InvocationSetterCalledOnceWith(val int64) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.Val, val) {
-			count_sym16++
+// InvocationSetterCalledOnceWith returns true if FakeIdentifier.InvocationSetter was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) InvocationSetterCalledOnceWith(val IdentifierMatcher[int64]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call2 := range f.InvocationSetterCalls {
+		if fake.Matches(val, call2.Parameters.Val) {
+			count++
 		}
 	}
 
-	return count_sym16 == 1
+	return count == 1
 }
 
-// AssertInvocationSetterCalledOnceWith calls t.Error if FakeIdentifier.InvocationSetter was not called exactly once with the given values
-func (f_sym17 *FakeIdentifier) AssertInvocationSetterCalledOnceWith(t IdentifierTestingT, val int64) {
+// AssertInvocationSetterCalledOnceWith calls t.Error if FakeIdentifier.InvocationSetter was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) AssertInvocationSetterCalledOnceWith(t IdentifierTestingT, val IdentifierMatcher[int64]) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.Val, val) {
-			count_sym17++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call2 := range f.InvocationSetterCalls {
+		if fake.Matches(val, call2.Parameters.Val) {
+			count++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeIdentifier.InvocationSetter called %d times with expected parameters, expected one", count_sym17)
+	if count != 1 {
+		t.Errorf("FakeIdentifier.InvocationSetter called %d times with expected parameters, expected one\n%s", count, f.describeInvocationSetterCalls(val))
+	}
+}
+
+// AssertInvocationSetterEventuallyCalledWith calls t.Error if FakeIdentifier.InvocationSetter is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeIdentifier) AssertInvocationSetterEventuallyCalledWith(t IdentifierTestingT, timeout time.Duration, val IdentifierMatcher[int64]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.InvocationSetterCalls)
+		for _, call2 := range f.InvocationSetterCalls {
+			if fake.Matches(val, call2.Parameters.Val) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeIdentifier.InvocationSetter not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeInvocationSetterCalls(val))
 	}
 }
 
-// InvocationSetterResultsForCall returns the result values for the first call to FakeIdentifier.InvocationSetter with the given values
-func (f_sym18 *FakeIdentifier) InvocationSetterResultsForCall(val int64) (call string, calls string, fallback string, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.InvocationSetterCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.Val, val) {
-			call = call_sym18.Results.Call
-			calls = call_sym18.Results.Calls
-			fallback = call_sym18.Results.Fallback
-			found_sym18 = true
+// InvocationSetterResultsForCall returns the result values for the first call to FakeIdentifier.InvocationSetter with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeIdentifier) InvocationSetterResultsForCall(val IdentifierMatcher[int64]) (call string, calls string, fallback string, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call2 := range f.InvocationSetterCalls {
+		if fake.Matches(val, call2.Parameters.Val) {
+			call = call2.Results.Call
+			calls = call2.Results.Calls
+			fallback = call2.Results.Fallback
+			found = true
 			break
 		}
 	}
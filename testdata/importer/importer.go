@@ -2,22 +2,43 @@
 
 package main
 
-import "reflect"
-import . "fmt"
-import z "strings"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	z "strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // ImporterScanInvocation represents a single call of FakeImporter.Scan
 type ImporterScanInvocation struct {
 	Parameters struct {
-		Ident1 *Scanner
+		Ident1 *fmt.Scanner
 	}
-	Results struct {
-		Ident2 z.Reader
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetScanInvocation
+	Matchers struct {
+		Ident1 ImporterMatcher[*fmt.Scanner]
 	}
+	Results ImporterScanResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *ImporterScanInvocation) String() string {
+	return fmt.Sprintf("FakeImporter.Scan%+v", i.Parameters)
+}
+
+// ImporterScanResults holds the results of a single call of FakeImporter.Scan
+type ImporterScanResults struct {
+	Ident2 z.Reader
 }
 
 // NewImporterScanInvocation creates a new instance of ImporterScanInvocation
-func NewImporterScanInvocation(ident1 *Scanner, ident2 z.Reader) *ImporterScanInvocation {
+func NewImporterScanInvocation(ident1 *fmt.Scanner, ident2 z.Reader) *ImporterScanInvocation {
 	invocation := new(ImporterScanInvocation)
 
 	invocation.Parameters.Ident1 = ident1
@@ -27,14 +48,120 @@ func NewImporterScanInvocation(ident1 *Scanner, ident2 z.Reader) *ImporterScanIn
 	return invocation
 }
 
+// ImporterScanExpectation is a call of FakeImporter.Scan expected by a test, created by FakeImporter.ExpectScan
+type ImporterScanExpectation struct {
+	fake     *FakeImporter
+	t        ImporterTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 ImporterMatcher[*fmt.Scanner]
+	}
+	returns bool
+	results ImporterScanResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *ImporterScanExpectation) With(ident1 ImporterMatcher[*fmt.Scanner]) *ImporterScanExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *ImporterScanExpectation) matches(ident1 *fmt.Scanner) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *ImporterScanExpectation) Times(n int) *ImporterScanExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *ImporterScanExpectation) Return(ident2 z.Reader) *ImporterScanExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = ImporterScanResults{Ident2: ident2}
+	return e
+}
+
 // ImporterTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type ImporterTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type ImporterTestingT = fake.TestingT
+
+// ImporterMatcher matches a parameter of a call to FakeImporter
+type ImporterMatcher[T any] interface {
+	Match(T) bool
+}
+
+// ImporterMatcherFunc is a ImporterMatcher implemented by a predicate function
+type ImporterMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m ImporterMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// ImporterAny returns a ImporterMatcher that matches any value
+func ImporterAny[T any]() ImporterMatcher[T] {
+	return fake.Any[T]()
+}
+
+// ImporterEq returns a ImporterMatcher that matches values deeply equal to want
+func ImporterEq[T any](want T) ImporterMatcher[T] {
+	return fake.Eq(want)
+}
+
+// ImporterNot returns a ImporterMatcher that matches the values the given matcher does not
+func ImporterNot[T any](m ImporterMatcher[T]) ImporterMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// ImporterPred returns a ImporterMatcher that matches values for which the given predicate returns true
+func ImporterPred[T any](pred func(T) bool) ImporterMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// ImporterAnyOfType returns a ImporterMatcher that matches values whose dynamic type is, or implements, U
+func ImporterAnyOfType[T, U any]() ImporterMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// ImporterCallMatcher selects calls of a method of a fake for ImporterInOrder and ImporterUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeImporter
+type ImporterCallMatcher = fake.CallMatcher
+
+// ImporterInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func ImporterInOrder(t ImporterTestingT, calls ...ImporterCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
 }
 
+// ImporterUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func ImporterUnordered(t ImporterTestingT, calls ...ImporterCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// ImporterExhausted selects how a method of FakeImporter behaves once the results given to its SetXReturnsSequence method are used up
+type ImporterExhausted = fake.Exhausted
+
+const (
+	// ImporterRepeatLast returns the last results in the sequence from all later calls
+	ImporterRepeatLast = fake.RepeatLast
+	// ImporterPanicAfterSequence panics on all later calls
+	ImporterPanicAfterSequence = fake.PanicAfterSequence
+	// ImporterHookAfterSequence calls the method's hook on all later calls
+	ImporterHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeImporter is a mock implementation of Importer for testing.
 Use it in your tests as in this example:
@@ -43,7 +170,7 @@ Use it in your tests as in this example:
 
 	func TestWithImporter(t *testing.T) {
 		f := &main.FakeImporter{
-			ScanHook: func(ident1 *Scanner) (ident2 z.Reader) {
+			ScanHook: func(ident1 *fmt.Scanner) (ident2 z.Reader) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -51,101 +178,304 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeScan ...
+		// assert state of FakeImporter ...
 		f.AssertScanCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeScan.
+unexpected calls are made to FakeImporter.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeImporter struct {
-	ScanHook func(*Scanner) z.Reader
+	ScanHook func(*fmt.Scanner) z.Reader
 
 	ScanCalls []*ImporterScanInvocation
+
+	returnsScan      fake.Returns[ImporterScanResults]
+	expectationsScan []*ImporterScanExpectation
+	mutex            sync.Mutex
+	recorded         chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters   bool
 }
 
 // NewFakeImporterDefaultPanic returns an instance of FakeImporter with all hooks configured to panic
 func NewFakeImporterDefaultPanic() *FakeImporter {
 	return &FakeImporter{
-		ScanHook: func(*Scanner) (ident2 z.Reader) {
+		ScanHook: func(*fmt.Scanner) (ident2 z.Reader) {
 			panic("Unexpected call to Importer.Scan")
 		},
 	}
 }
 
 // NewFakeImporterDefaultFatal returns an instance of FakeImporter with all hooks configured to call t.Fatal
-func NewFakeImporterDefaultFatal(t_sym1 ImporterTestingT) *FakeImporter {
+func NewFakeImporterDefaultFatal(t ImporterTestingT) *FakeImporter {
 	return &FakeImporter{
-		ScanHook: func(*Scanner) (ident2 z.Reader) {
-			t_sym1.Fatal("Unexpected call to Importer.Scan")
+		ScanHook: func(*fmt.Scanner) (ident2 z.Reader) {
+			t.Fatal("Unexpected call to Importer.Scan")
 			return
 		},
 	}
 }
 
 // NewFakeImporterDefaultError returns an instance of FakeImporter with all hooks configured to call t.Error
-func NewFakeImporterDefaultError(t_sym2 ImporterTestingT) *FakeImporter {
+func NewFakeImporterDefaultError(t ImporterTestingT) *FakeImporter {
+	return &FakeImporter{
+		ScanHook: func(*fmt.Scanner) (ident2 z.Reader) {
+			t.Error("Unexpected call to Importer.Scan")
+			return
+		},
+	}
+}
+
+// NewFakeImporterDefaultZero returns an instance of FakeImporter with all hooks configured to return zero values
+func NewFakeImporterDefaultZero() *FakeImporter {
+	return &FakeImporter{
+		ScanHook: func(*fmt.Scanner) (ident2 z.Reader) {
+			return
+		},
+	}
+}
+
+// NewFakeImporterDefaultFriendly returns an instance of FakeImporter with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeImporter are friendly fakes, one for each method and result, created on first use
+func NewFakeImporterDefaultFriendly() *FakeImporter {
+
 	return &FakeImporter{
-		ScanHook: func(*Scanner) (ident2 z.Reader) {
-			t_sym2.Error("Unexpected call to Importer.Scan")
+		ScanHook: func(*fmt.Scanner) (ident2 z.Reader) {
 			return
 		},
 	}
 }
 
+// NewFakeImporterSpy returns an instance of FakeImporter with all hooks configured to call the given implementation
+func NewFakeImporterSpy(real Importer) *FakeImporter {
+	return &FakeImporter{
+		ScanHook: real.Scan,
+	}
+}
+
+// Reset forgets all calls made to FakeImporter
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeImporter) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsScan.Rebase(len(f.ScanCalls))
 	f.ScanCalls = []*ImporterScanInvocation{}
 }
 
-func (f_sym3 *FakeImporter) Scan(ident1 *Scanner) (ident2 z.Reader) {
-	if f_sym3.ScanHook == nil {
+// SetCopyParameters configures FakeImporter to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeImporter) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeImporter) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeImporter) Scan(ident1 *fmt.Scanner) (ident2 z.Reader) {
+	f.mutex.Lock()
+	hook := f.ScanHook
+	expectation, t := f.expectedScan(ident1)
+	var results ImporterScanResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsScan.Lookup(len(f.ScanCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Importer.Scan() called after the results given to FakeImporter.SetScanReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Importer.Scan() called but FakeImporter.ScanHook is nil")
 	}
 
-	invocation_sym3 := new(ImporterScanInvocation)
-	f_sym3.ScanCalls = append(f_sym3.ScanCalls, invocation_sym3)
+	invocation := new(ImporterScanInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ScanCalls = append(f.ScanCalls, invocation)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	ident2 = f_sym3.ScanHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeImporter.Scan called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	invocation_sym3.Results.Ident2 = ident2
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedScan returns the first unsatisfied expectation of FakeImporter.Scan matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeImporter) expectedScan(ident1 *fmt.Scanner) (*ImporterScanExpectation, ImporterTestingT) {
+	if len(f.expectationsScan) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsScan {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsScan[0].t
+}
+
+// ExpectScan expects calls of FakeImporter.Scan, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeImporter) ExpectScan(t ImporterTestingT) *ImporterScanExpectation {
+	t.Helper()
+	expectation := &ImporterScanExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsScan = append(f.expectationsScan, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeImporter.Scan called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetScanHook configures Importer.Scan to call the given function
+func (f *FakeImporter) SetScanHook(hook func(*fmt.Scanner) z.Reader) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ScanHook = hook
+}
+
 // SetScanStub configures Importer.Scan to always return the given values
-func (f_sym4 *FakeImporter) SetScanStub(ident2 z.Reader) {
-	f_sym4.ScanHook = func(*Scanner) z.Reader {
+func (f *FakeImporter) SetScanStub(ident2 z.Reader) {
+	f.SetScanHook(func(*fmt.Scanner) z.Reader {
 		return ident2
-	}
+	})
+}
+
+// SetScanReturnsOnCall configures Importer.Scan to return the given values from the call with the given index in ScanCalls, rather than calling the hook
+func (f *FakeImporter) SetScanReturnsOnCall(call int, ident2 z.Reader) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsScan.Set(call, ImporterScanResults{Ident2: ident2})
+}
+
+// SetScanReturnsSequence configures the following calls of Importer.Scan to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeImporter) SetScanReturnsSequence(exhausted ImporterExhausted, results ...ImporterScanResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsScan.Sequence(len(f.ScanCalls), exhausted, results)
 }
 
 // SetScanInvocation configures Importer.Scan to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeImporter) SetScanInvocation(calls_sym5 []*ImporterScanInvocation, fallback_sym5 func() z.Reader) {
-	f_sym5.ScanHook = func(ident1 *Scanner) (ident2 z.Reader) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f *FakeImporter) SetScanInvocation(calls []*ImporterScanInvocation, fallback func() z.Reader) {
+	f.SetScanHook(func(ident1 *fmt.Scanner) (ident2 z.Reader) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// ScanCallsSnapshot returns a copy of the calls made to FakeImporter.Scan
+func (f *FakeImporter) ScanCallsSnapshot() []*ImporterScanInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*ImporterScanInvocation, len(f.ScanCalls))
+	for i, call := range f.ScanCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ScanCall returns a ImporterCallMatcher selecting the calls of FakeImporter.Scan with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeImporter) ScanCall(ident1 ImporterMatcher[*fmt.Scanner]) ImporterCallMatcher {
+	return fake.NewCallMatcher("FakeImporter.Scan(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*ImporterScanInvocation(nil), f.ScanCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ScanCalled returns true if FakeImporter.Scan was called
 func (f *FakeImporter) ScanCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ScanCalls) != 0
 }
 
 // AssertScanCalled calls t.Error if FakeImporter.Scan was not called
 func (f *FakeImporter) AssertScanCalled(t ImporterTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ScanCalls) == 0 {
 		t.Error("FakeImporter.Scan not called, expected at least one")
 	}
@@ -153,12 +483,16 @@ func (f *FakeImporter) AssertScanCalled(t ImporterTestingT) {
 
 // ScanNotCalled returns true if FakeImporter.Scan was not called
 func (f *FakeImporter) ScanNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ScanCalls) == 0
 }
 
 // AssertScanNotCalled calls t.Error if FakeImporter.Scan was called
 func (f *FakeImporter) AssertScanNotCalled(t ImporterTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ScanCalls) != 0 {
 		t.Error("FakeImporter.Scan called, expected none")
 	}
@@ -166,12 +500,16 @@ func (f *FakeImporter) AssertScanNotCalled(t ImporterTestingT) {
 
 // ScanCalledOnce returns true if FakeImporter.Scan was called exactly once
 func (f *FakeImporter) ScanCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ScanCalls) == 1
 }
 
 // AssertScanCalledOnce calls t.Error if FakeImporter.Scan was not called exactly once
 func (f *FakeImporter) AssertScanCalledOnce(t ImporterTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ScanCalls) != 1 {
 		t.Errorf("FakeImporter.Scan called %d times, expected 1", len(f.ScanCalls))
 	}
@@ -179,21 +517,92 @@ func (f *FakeImporter) AssertScanCalledOnce(t ImporterTestingT) {
 
 // ScanCalledN returns true if FakeImporter.Scan was called at least n times
 func (f *FakeImporter) ScanCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ScanCalls) >= n
 }
 
 // AssertScanCalledN calls t.Error if FakeImporter.Scan was called less than n times
 func (f *FakeImporter) AssertScanCalledN(t ImporterTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ScanCalls) < n {
 		t.Errorf("FakeImporter.Scan called %d times, expected >= %d", len(f.ScanCalls), n)
 	}
 }
 
-// ScanCalledWith returns true if FakeImporter.Scan was called with the given values
-func (f_sym6 *FakeImporter) ScanCalledWith(ident1 *Scanner) bool {
-	for _, call_sym6 := range f_sym6.ScanCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+// WaitForScanCalled blocks until FakeImporter.Scan has been called, returning the error of ctx if it is done first
+func (f *FakeImporter) WaitForScanCalled(ctx context.Context) error {
+	return f.WaitForScanCalledN(ctx, 1)
+}
+
+// WaitForScanCalledN blocks until FakeImporter.Scan has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeImporter) WaitForScanCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ScanCalls) >= n
+	})
+}
+
+// AssertScanEventuallyCalled calls t.Error if FakeImporter.Scan is not called within the timeout
+func (f *FakeImporter) AssertScanEventuallyCalled(t ImporterTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForScanCalled(ctx) != nil {
+		t.Errorf("FakeImporter.Scan not called within %v", timeout)
+	}
+}
+
+// describeScanCalls describes the calls of FakeImporter.Scan against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeImporter) describeScanCalls(ident1 ImporterMatcher[*fmt.Scanner]) string {
+	var b z.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.ScanCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ScanCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ScanCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// ScanCalledWith returns true if FakeImporter.Scan was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeImporter) ScanCalledWith(ident1 ImporterMatcher[*fmt.Scanner]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ScanCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -201,55 +610,86 @@ func (f_sym6 *FakeImporter) ScanCalledWith(ident1 *Scanner) bool {
 	return false
 }
 
-// AssertScanCalledWith calls t.Error if FakeImporter.Scan was not called with the given values
-func (f_sym7 *FakeImporter) AssertScanCalledWith(t ImporterTestingT, ident1 *Scanner) {
+// AssertScanCalledWith calls t.Error if FakeImporter.Scan was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeImporter) AssertScanCalledWith(t ImporterTestingT, ident1 ImporterMatcher[*fmt.Scanner]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.ScanCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ScanCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeImporter.Scan not called with expected parameters")
+	if !found {
+		t.Errorf("FakeImporter.Scan not called with expected parameters\n%s", f.describeScanCalls(ident1))
 	}
 }
 
-// ScanCalledOnceWith returns true if FakeImporter.Scan was called exactly once with the given values
-func (f_sym8 *FakeImporter) ScanCalledOnceWith(ident1 *Scanner) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.ScanCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+// ScanCalledOnceWith returns true if FakeImporter.Scan was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeImporter) ScanCalledOnceWith(ident1 ImporterMatcher[*fmt.Scanner]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ScanCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertScanCalledOnceWith calls t.Error if FakeImporter.Scan was not called exactly once with the given values
-func (f_sym9 *FakeImporter) AssertScanCalledOnceWith(t ImporterTestingT, ident1 *Scanner) {
+// AssertScanCalledOnceWith calls t.Error if FakeImporter.Scan was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeImporter) AssertScanCalledOnceWith(t ImporterTestingT, ident1 ImporterMatcher[*fmt.Scanner]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.ScanCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ScanCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeImporter.Scan called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakeImporter.Scan called %d times with expected parameters, expected one\n%s", count, f.describeScanCalls(ident1))
+	}
+}
+
+// AssertScanEventuallyCalledWith calls t.Error if FakeImporter.Scan is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeImporter) AssertScanEventuallyCalledWith(t ImporterTestingT, timeout time.Duration, ident1 ImporterMatcher[*fmt.Scanner]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ScanCalls)
+		for _, call := range f.ScanCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeImporter.Scan not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeScanCalls(ident1))
 	}
 }
 
-// ScanResultsForCall returns the result values for the first call to FakeImporter.Scan with the given values
-func (f_sym10 *FakeImporter) ScanResultsForCall(ident1 *Scanner) (ident2 z.Reader, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.ScanCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+// ScanResultsForCall returns the result values for the first call to FakeImporter.Scan with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeImporter) ScanResultsForCall(ident1 ImporterMatcher[*fmt.Scanner]) (ident2 z.Reader, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ScanCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
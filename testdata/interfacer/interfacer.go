@@ -2,16 +2,39 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // InterfacerInterfaceInvocation represents a single call of FakeInterfacer.Interface
 type InterfacerInterfaceInvocation struct {
 	Parameters struct {
 		Ident1 interface{}
 	}
-	Results struct {
-		Ident2 interface{}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetInterfaceInvocation
+	Matchers struct {
+		Ident1 InterfacerMatcher[interface{}]
 	}
+	Results InterfacerInterfaceResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *InterfacerInterfaceInvocation) String() string {
+	return fmt.Sprintf("FakeInterfacer.Interface%+v", i.Parameters)
+}
+
+// InterfacerInterfaceResults holds the results of a single call of FakeInterfacer.Interface
+type InterfacerInterfaceResults struct {
+	Ident2 interface{}
 }
 
 // NewInterfacerInterfaceInvocation creates a new instance of InterfacerInterfaceInvocation
@@ -25,14 +48,71 @@ func NewInterfacerInterfaceInvocation(ident1 interface{}, ident2 interface{}) *I
 	return invocation
 }
 
+// InterfacerInterfaceExpectation is a call of FakeInterfacer.Interface expected by a test, created by FakeInterfacer.ExpectInterface
+type InterfacerInterfaceExpectation struct {
+	fake     *FakeInterfacer
+	t        InterfacerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 InterfacerMatcher[interface{}]
+	}
+	returns bool
+	results InterfacerInterfaceResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *InterfacerInterfaceExpectation) With(ident1 InterfacerMatcher[interface{}]) *InterfacerInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *InterfacerInterfaceExpectation) matches(ident1 interface{}) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *InterfacerInterfaceExpectation) Times(n int) *InterfacerInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *InterfacerInterfaceExpectation) Return(ident2 interface{}) *InterfacerInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = InterfacerInterfaceResults{Ident2: ident2}
+	return e
+}
+
 // InterfacerNamedInterfaceInvocation represents a single call of FakeInterfacer.NamedInterface
 type InterfacerNamedInterfaceInvocation struct {
 	Parameters struct {
 		A interface{}
 	}
-	Results struct {
-		Z interface{}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetNamedInterfaceInvocation
+	Matchers struct {
+		A InterfacerMatcher[interface{}]
 	}
+	Results InterfacerNamedInterfaceResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *InterfacerNamedInterfaceInvocation) String() string {
+	return fmt.Sprintf("FakeInterfacer.NamedInterface%+v", i.Parameters)
+}
+
+// InterfacerNamedInterfaceResults holds the results of a single call of FakeInterfacer.NamedInterface
+type InterfacerNamedInterfaceResults struct {
+	Z interface{}
 }
 
 // NewInterfacerNamedInterfaceInvocation creates a new instance of InterfacerNamedInterfaceInvocation
@@ -46,14 +126,120 @@ func NewInterfacerNamedInterfaceInvocation(a interface{}, z interface{}) *Interf
 	return invocation
 }
 
+// InterfacerNamedInterfaceExpectation is a call of FakeInterfacer.NamedInterface expected by a test, created by FakeInterfacer.ExpectNamedInterface
+type InterfacerNamedInterfaceExpectation struct {
+	fake     *FakeInterfacer
+	t        InterfacerTestingT
+	times    int
+	count    int
+	matchers struct {
+		A InterfacerMatcher[interface{}]
+	}
+	returns bool
+	results InterfacerNamedInterfaceResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *InterfacerNamedInterfaceExpectation) With(a InterfacerMatcher[interface{}]) *InterfacerNamedInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+
+	return e
+}
+
+func (e *InterfacerNamedInterfaceExpectation) matches(a interface{}) bool {
+	return fake.Matches(e.matchers.A, a)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *InterfacerNamedInterfaceExpectation) Times(n int) *InterfacerNamedInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *InterfacerNamedInterfaceExpectation) Return(z interface{}) *InterfacerNamedInterfaceExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = InterfacerNamedInterfaceResults{Z: z}
+	return e
+}
+
 // InterfacerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type InterfacerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type InterfacerTestingT = fake.TestingT
+
+// InterfacerMatcher matches a parameter of a call to FakeInterfacer
+type InterfacerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// InterfacerMatcherFunc is a InterfacerMatcher implemented by a predicate function
+type InterfacerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m InterfacerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// InterfacerAny returns a InterfacerMatcher that matches any value
+func InterfacerAny[T any]() InterfacerMatcher[T] {
+	return fake.Any[T]()
+}
+
+// InterfacerEq returns a InterfacerMatcher that matches values deeply equal to want
+func InterfacerEq[T any](want T) InterfacerMatcher[T] {
+	return fake.Eq(want)
+}
+
+// InterfacerNot returns a InterfacerMatcher that matches the values the given matcher does not
+func InterfacerNot[T any](m InterfacerMatcher[T]) InterfacerMatcher[T] {
+	return fake.Not[T](m)
 }
 
+// InterfacerPred returns a InterfacerMatcher that matches values for which the given predicate returns true
+func InterfacerPred[T any](pred func(T) bool) InterfacerMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// InterfacerAnyOfType returns a InterfacerMatcher that matches values whose dynamic type is, or implements, U
+func InterfacerAnyOfType[T, U any]() InterfacerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// InterfacerCallMatcher selects calls of a method of a fake for InterfacerInOrder and InterfacerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeInterfacer
+type InterfacerCallMatcher = fake.CallMatcher
+
+// InterfacerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func InterfacerInOrder(t InterfacerTestingT, calls ...InterfacerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// InterfacerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func InterfacerUnordered(t InterfacerTestingT, calls ...InterfacerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// InterfacerExhausted selects how a method of FakeInterfacer behaves once the results given to its SetXReturnsSequence method are used up
+type InterfacerExhausted = fake.Exhausted
+
+const (
+	// InterfacerRepeatLast returns the last results in the sequence from all later calls
+	InterfacerRepeatLast = fake.RepeatLast
+	// InterfacerPanicAfterSequence panics on all later calls
+	InterfacerPanicAfterSequence = fake.PanicAfterSequence
+	// InterfacerHookAfterSequence calls the method's hook on all later calls
+	InterfacerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeInterfacer is a mock implementation of Interfacer for testing.
 Use it in your tests as in this example:
@@ -70,13 +256,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeInterface ...
+		// assert state of FakeInterfacer ...
 		f.AssertInterfaceCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeInterface.
+unexpected calls are made to FakeInterfacer.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeInterfacer struct {
 	InterfaceHook      func(interface{}) interface{}
@@ -84,6 +274,14 @@ type FakeInterfacer struct {
 
 	InterfaceCalls      []*InterfacerInterfaceInvocation
 	NamedInterfaceCalls []*InterfacerNamedInterfaceInvocation
+
+	returnsInterface           fake.Returns[InterfacerInterfaceResults]
+	returnsNamedInterface      fake.Returns[InterfacerNamedInterfaceResults]
+	expectationsInterface      []*InterfacerInterfaceExpectation
+	expectationsNamedInterface []*InterfacerNamedInterfaceExpectation
+	mutex                      sync.Mutex
+	recorded                   chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters             bool
 }
 
 // NewFakeInterfacerDefaultPanic returns an instance of FakeInterfacer with all hooks configured to panic
@@ -99,86 +297,287 @@ func NewFakeInterfacerDefaultPanic() *FakeInterfacer {
 }
 
 // NewFakeInterfacerDefaultFatal returns an instance of FakeInterfacer with all hooks configured to call t.Fatal
-func NewFakeInterfacerDefaultFatal(t_sym1 InterfacerTestingT) *FakeInterfacer {
+func NewFakeInterfacerDefaultFatal(t InterfacerTestingT) *FakeInterfacer {
 	return &FakeInterfacer{
 		InterfaceHook: func(interface{}) (ident2 interface{}) {
-			t_sym1.Fatal("Unexpected call to Interfacer.Interface")
+			t.Fatal("Unexpected call to Interfacer.Interface")
 			return
 		},
 		NamedInterfaceHook: func(interface{}) (z interface{}) {
-			t_sym1.Fatal("Unexpected call to Interfacer.NamedInterface")
+			t.Fatal("Unexpected call to Interfacer.NamedInterface")
 			return
 		},
 	}
 }
 
 // NewFakeInterfacerDefaultError returns an instance of FakeInterfacer with all hooks configured to call t.Error
-func NewFakeInterfacerDefaultError(t_sym2 InterfacerTestingT) *FakeInterfacer {
+func NewFakeInterfacerDefaultError(t InterfacerTestingT) *FakeInterfacer {
+	return &FakeInterfacer{
+		InterfaceHook: func(interface{}) (ident2 interface{}) {
+			t.Error("Unexpected call to Interfacer.Interface")
+			return
+		},
+		NamedInterfaceHook: func(interface{}) (z interface{}) {
+			t.Error("Unexpected call to Interfacer.NamedInterface")
+			return
+		},
+	}
+}
+
+// NewFakeInterfacerDefaultZero returns an instance of FakeInterfacer with all hooks configured to return zero values
+func NewFakeInterfacerDefaultZero() *FakeInterfacer {
+	return &FakeInterfacer{
+		InterfaceHook: func(interface{}) (ident2 interface{}) {
+			return
+		},
+		NamedInterfaceHook: func(interface{}) (z interface{}) {
+			return
+		},
+	}
+}
+
+// NewFakeInterfacerDefaultFriendly returns an instance of FakeInterfacer with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeInterfacer are friendly fakes, one for each method and result, created on first use
+func NewFakeInterfacerDefaultFriendly() *FakeInterfacer {
+
 	return &FakeInterfacer{
 		InterfaceHook: func(interface{}) (ident2 interface{}) {
-			t_sym2.Error("Unexpected call to Interfacer.Interface")
 			return
 		},
 		NamedInterfaceHook: func(interface{}) (z interface{}) {
-			t_sym2.Error("Unexpected call to Interfacer.NamedInterface")
 			return
 		},
 	}
 }
 
+// NewFakeInterfacerSpy returns an instance of FakeInterfacer with all hooks configured to call the given implementation
+func NewFakeInterfacerSpy(real Interfacer) *FakeInterfacer {
+	return &FakeInterfacer{
+		InterfaceHook:      real.Interface,
+		NamedInterfaceHook: real.NamedInterface,
+	}
+}
+
+// Reset forgets all calls made to FakeInterfacer
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeInterfacer) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsInterface.Rebase(len(f.InterfaceCalls))
 	f.InterfaceCalls = []*InterfacerInterfaceInvocation{}
+	f.returnsNamedInterface.Rebase(len(f.NamedInterfaceCalls))
 	f.NamedInterfaceCalls = []*InterfacerNamedInterfaceInvocation{}
 }
 
-func (f_sym3 *FakeInterfacer) Interface(ident1 interface{}) (ident2 interface{}) {
-	if f_sym3.InterfaceHook == nil {
+// SetCopyParameters configures FakeInterfacer to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeInterfacer) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeInterfacer) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeInterfacer) Interface(ident1 interface{}) (ident2 interface{}) {
+	f.mutex.Lock()
+	hook := f.InterfaceHook
+	expectation, t := f.expectedInterface(ident1)
+	var results InterfacerInterfaceResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsInterface.Lookup(len(f.InterfaceCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Interfacer.Interface() called after the results given to FakeInterfacer.SetInterfaceReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Interfacer.Interface() called but FakeInterfacer.InterfaceHook is nil")
 	}
 
-	invocation_sym3 := new(InterfacerInterfaceInvocation)
-	f_sym3.InterfaceCalls = append(f_sym3.InterfaceCalls, invocation_sym3)
+	invocation := new(InterfacerInterfaceInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.InterfaceCalls = append(f.InterfaceCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeInterfacer.Interface called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ident2 = f_sym3.InterfaceHook(ident1)
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym3.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedInterface returns the first unsatisfied expectation of FakeInterfacer.Interface matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeInterfacer) expectedInterface(ident1 interface{}) (*InterfacerInterfaceExpectation, InterfacerTestingT) {
+	if len(f.expectationsInterface) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsInterface {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsInterface[0].t
+}
+
+// ExpectInterface expects calls of FakeInterfacer.Interface, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeInterfacer) ExpectInterface(t InterfacerTestingT) *InterfacerInterfaceExpectation {
+	t.Helper()
+	expectation := &InterfacerInterfaceExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsInterface = append(f.expectationsInterface, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeInterfacer.Interface called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetInterfaceHook configures Interfacer.Interface to call the given function
+func (f *FakeInterfacer) SetInterfaceHook(hook func(interface{}) interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.InterfaceHook = hook
+}
+
 // SetInterfaceStub configures Interfacer.Interface to always return the given values
-func (f_sym4 *FakeInterfacer) SetInterfaceStub(ident2 interface{}) {
-	f_sym4.InterfaceHook = func(interface{}) interface{} {
+func (f *FakeInterfacer) SetInterfaceStub(ident2 interface{}) {
+	f.SetInterfaceHook(func(interface{}) interface{} {
 		return ident2
-	}
+	})
+}
+
+// SetInterfaceReturnsOnCall configures Interfacer.Interface to return the given values from the call with the given index in InterfaceCalls, rather than calling the hook
+func (f *FakeInterfacer) SetInterfaceReturnsOnCall(call int, ident2 interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsInterface.Set(call, InterfacerInterfaceResults{Ident2: ident2})
+}
+
+// SetInterfaceReturnsSequence configures the following calls of Interfacer.Interface to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeInterfacer) SetInterfaceReturnsSequence(exhausted InterfacerExhausted, results ...InterfacerInterfaceResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsInterface.Sequence(len(f.InterfaceCalls), exhausted, results)
 }
 
 // SetInterfaceInvocation configures Interfacer.Interface to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeInterfacer) SetInterfaceInvocation(calls_sym5 []*InterfacerInterfaceInvocation, fallback_sym5 func() interface{}) {
-	f_sym5.InterfaceHook = func(ident1 interface{}) (ident2 interface{}) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f *FakeInterfacer) SetInterfaceInvocation(calls []*InterfacerInterfaceInvocation, fallback func() interface{}) {
+	f.SetInterfaceHook(func(ident1 interface{}) (ident2 interface{}) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// InterfaceCallsSnapshot returns a copy of the calls made to FakeInterfacer.Interface
+func (f *FakeInterfacer) InterfaceCallsSnapshot() []*InterfacerInterfaceInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*InterfacerInterfaceInvocation, len(f.InterfaceCalls))
+	for i, call := range f.InterfaceCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// InterfaceCall returns a InterfacerCallMatcher selecting the calls of FakeInterfacer.Interface with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) InterfaceCall(ident1 InterfacerMatcher[interface{}]) InterfacerCallMatcher {
+	return fake.NewCallMatcher("FakeInterfacer.Interface(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*InterfacerInterfaceInvocation(nil), f.InterfaceCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // InterfaceCalled returns true if FakeInterfacer.Interface was called
 func (f *FakeInterfacer) InterfaceCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InterfaceCalls) != 0
 }
 
 // AssertInterfaceCalled calls t.Error if FakeInterfacer.Interface was not called
 func (f *FakeInterfacer) AssertInterfaceCalled(t InterfacerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InterfaceCalls) == 0 {
 		t.Error("FakeInterfacer.Interface not called, expected at least one")
 	}
@@ -186,12 +585,16 @@ func (f *FakeInterfacer) AssertInterfaceCalled(t InterfacerTestingT) {
 
 // InterfaceNotCalled returns true if FakeInterfacer.Interface was not called
 func (f *FakeInterfacer) InterfaceNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InterfaceCalls) == 0
 }
 
 // AssertInterfaceNotCalled calls t.Error if FakeInterfacer.Interface was called
 func (f *FakeInterfacer) AssertInterfaceNotCalled(t InterfacerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InterfaceCalls) != 0 {
 		t.Error("FakeInterfacer.Interface called, expected none")
 	}
@@ -199,12 +602,16 @@ func (f *FakeInterfacer) AssertInterfaceNotCalled(t InterfacerTestingT) {
 
 // InterfaceCalledOnce returns true if FakeInterfacer.Interface was called exactly once
 func (f *FakeInterfacer) InterfaceCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InterfaceCalls) == 1
 }
 
 // AssertInterfaceCalledOnce calls t.Error if FakeInterfacer.Interface was not called exactly once
 func (f *FakeInterfacer) AssertInterfaceCalledOnce(t InterfacerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InterfaceCalls) != 1 {
 		t.Errorf("FakeInterfacer.Interface called %d times, expected 1", len(f.InterfaceCalls))
 	}
@@ -212,21 +619,92 @@ func (f *FakeInterfacer) AssertInterfaceCalledOnce(t InterfacerTestingT) {
 
 // InterfaceCalledN returns true if FakeInterfacer.Interface was called at least n times
 func (f *FakeInterfacer) InterfaceCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.InterfaceCalls) >= n
 }
 
 // AssertInterfaceCalledN calls t.Error if FakeInterfacer.Interface was called less than n times
 func (f *FakeInterfacer) AssertInterfaceCalledN(t InterfacerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.InterfaceCalls) < n {
 		t.Errorf("FakeInterfacer.Interface called %d times, expected >= %d", len(f.InterfaceCalls), n)
 	}
 }
 
-// InterfaceCalledWith returns true if FakeInterfacer.Interface was called with the given values
-func (f_sym6 *FakeInterfacer) InterfaceCalledWith(ident1 interface{}) bool {
-	for _, call_sym6 := range f_sym6.InterfaceCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+// WaitForInterfaceCalled blocks until FakeInterfacer.Interface has been called, returning the error of ctx if it is done first
+func (f *FakeInterfacer) WaitForInterfaceCalled(ctx context.Context) error {
+	return f.WaitForInterfaceCalledN(ctx, 1)
+}
+
+// WaitForInterfaceCalledN blocks until FakeInterfacer.Interface has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeInterfacer) WaitForInterfaceCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.InterfaceCalls) >= n
+	})
+}
+
+// AssertInterfaceEventuallyCalled calls t.Error if FakeInterfacer.Interface is not called within the timeout
+func (f *FakeInterfacer) AssertInterfaceEventuallyCalled(t InterfacerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForInterfaceCalled(ctx) != nil {
+		t.Errorf("FakeInterfacer.Interface not called within %v", timeout)
+	}
+}
+
+// describeInterfaceCalls describes the calls of FakeInterfacer.Interface against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeInterfacer) describeInterfaceCalls(ident1 InterfacerMatcher[interface{}]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.InterfaceCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.InterfaceCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.InterfaceCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// InterfaceCalledWith returns true if FakeInterfacer.Interface was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) InterfaceCalledWith(ident1 InterfacerMatcher[interface{}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.InterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -234,55 +712,86 @@ func (f_sym6 *FakeInterfacer) InterfaceCalledWith(ident1 interface{}) bool {
 	return false
 }
 
-// AssertInterfaceCalledWith calls t.Error if FakeInterfacer.Interface was not called with the given values
-func (f_sym7 *FakeInterfacer) AssertInterfaceCalledWith(t InterfacerTestingT, ident1 interface{}) {
+// AssertInterfaceCalledWith calls t.Error if FakeInterfacer.Interface was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) AssertInterfaceCalledWith(t InterfacerTestingT, ident1 InterfacerMatcher[interface{}]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.InterfaceCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.InterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeInterfacer.Interface not called with expected parameters")
+	if !found {
+		t.Errorf("FakeInterfacer.Interface not called with expected parameters\n%s", f.describeInterfaceCalls(ident1))
 	}
 }
 
-// InterfaceCalledOnceWith returns true if FakeInterfacer.Interface was called exactly once with the given values
-func (f_sym8 *FakeInterfacer) InterfaceCalledOnceWith(ident1 interface{}) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.InterfaceCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+// InterfaceCalledOnceWith returns true if FakeInterfacer.Interface was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) InterfaceCalledOnceWith(ident1 InterfacerMatcher[interface{}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.InterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertInterfaceCalledOnceWith calls t.Error if FakeInterfacer.Interface was not called exactly once with the given values
-func (f_sym9 *FakeInterfacer) AssertInterfaceCalledOnceWith(t InterfacerTestingT, ident1 interface{}) {
+// AssertInterfaceCalledOnceWith calls t.Error if FakeInterfacer.Interface was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) AssertInterfaceCalledOnceWith(t InterfacerTestingT, ident1 InterfacerMatcher[interface{}]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.InterfaceCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.InterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeInterfacer.Interface called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakeInterfacer.Interface called %d times with expected parameters, expected one\n%s", count, f.describeInterfaceCalls(ident1))
+	}
+}
+
+// AssertInterfaceEventuallyCalledWith calls t.Error if FakeInterfacer.Interface is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeInterfacer) AssertInterfaceEventuallyCalledWith(t InterfacerTestingT, timeout time.Duration, ident1 InterfacerMatcher[interface{}]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.InterfaceCalls)
+		for _, call := range f.InterfaceCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeInterfacer.Interface not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeInterfaceCalls(ident1))
 	}
 }
 
-// InterfaceResultsForCall returns the result values for the first call to FakeInterfacer.Interface with the given values
-func (f_sym10 *FakeInterfacer) InterfaceResultsForCall(ident1 interface{}) (ident2 interface{}, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.InterfaceCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+// InterfaceResultsForCall returns the result values for the first call to FakeInterfacer.Interface with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) InterfaceResultsForCall(ident1 InterfacerMatcher[interface{}]) (ident2 interface{}, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.InterfaceCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -290,54 +799,185 @@ func (f_sym10 *FakeInterfacer) InterfaceResultsForCall(ident1 interface{}) (iden
 	return
 }
 
-func (f_sym11 *FakeInterfacer) NamedInterface(a interface{}) (z interface{}) {
-	if f_sym11.NamedInterfaceHook == nil {
+func (f *FakeInterfacer) NamedInterface(a interface{}) (z interface{}) {
+	f.mutex.Lock()
+	hook := f.NamedInterfaceHook
+	expectation, t := f.expectedNamedInterface(a)
+	var results InterfacerNamedInterfaceResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsNamedInterface.Lookup(len(f.NamedInterfaceCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Interfacer.NamedInterface() called after the results given to FakeInterfacer.SetNamedInterfaceReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Interfacer.NamedInterface() called but FakeInterfacer.NamedInterfaceHook is nil")
 	}
 
-	invocation_sym11 := new(InterfacerNamedInterfaceInvocation)
-	f_sym11.NamedInterfaceCalls = append(f_sym11.NamedInterfaceCalls, invocation_sym11)
+	invocation := new(InterfacerNamedInterfaceInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.NamedInterfaceCalls = append(f.NamedInterfaceCalls, invocation)
+
+	invocation.Parameters.A = a
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym11.Parameters.A = a
+	if t != nil && expectation == nil {
+		t.Errorf("FakeInterfacer.NamedInterface called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	z = f_sym11.NamedInterfaceHook(a)
+	if found {
+		z = results.Z
+	} else if hook != nil {
+		z = hook(a)
+	}
 
-	invocation_sym11.Results.Z = z
+	f.mutex.Lock()
+	invocation.Results.Z = z
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedNamedInterface returns the first unsatisfied expectation of FakeInterfacer.NamedInterface matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeInterfacer) expectedNamedInterface(a interface{}) (*InterfacerNamedInterfaceExpectation, InterfacerTestingT) {
+	if len(f.expectationsNamedInterface) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsNamedInterface {
+		if expectation.count < expectation.times && expectation.matches(a) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsNamedInterface[0].t
+}
+
+// ExpectNamedInterface expects calls of FakeInterfacer.NamedInterface, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeInterfacer) ExpectNamedInterface(t InterfacerTestingT) *InterfacerNamedInterfaceExpectation {
+	t.Helper()
+	expectation := &InterfacerNamedInterfaceExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsNamedInterface = append(f.expectationsNamedInterface, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeInterfacer.NamedInterface called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetNamedInterfaceHook configures Interfacer.NamedInterface to call the given function
+func (f *FakeInterfacer) SetNamedInterfaceHook(hook func(interface{}) interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.NamedInterfaceHook = hook
+}
+
 // SetNamedInterfaceStub configures Interfacer.NamedInterface to always return the given values
-func (f_sym12 *FakeInterfacer) SetNamedInterfaceStub(z interface{}) {
-	f_sym12.NamedInterfaceHook = func(interface{}) interface{} {
+func (f *FakeInterfacer) SetNamedInterfaceStub(z interface{}) {
+	f.SetNamedInterfaceHook(func(interface{}) interface{} {
 		return z
-	}
+	})
+}
+
+// SetNamedInterfaceReturnsOnCall configures Interfacer.NamedInterface to return the given values from the call with the given index in NamedInterfaceCalls, rather than calling the hook
+func (f *FakeInterfacer) SetNamedInterfaceReturnsOnCall(call int, z interface{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedInterface.Set(call, InterfacerNamedInterfaceResults{Z: z})
+}
+
+// SetNamedInterfaceReturnsSequence configures the following calls of Interfacer.NamedInterface to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeInterfacer) SetNamedInterfaceReturnsSequence(exhausted InterfacerExhausted, results ...InterfacerNamedInterfaceResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedInterface.Sequence(len(f.NamedInterfaceCalls), exhausted, results)
 }
 
 // SetNamedInterfaceInvocation configures Interfacer.NamedInterface to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeInterfacer) SetNamedInterfaceInvocation(calls_sym13 []*InterfacerNamedInterfaceInvocation, fallback_sym13 func() interface{}) {
-	f_sym13.NamedInterfaceHook = func(a interface{}) (z interface{}) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) {
-				z = call_sym13.Results.Z
+func (f *FakeInterfacer) SetNamedInterfaceInvocation(calls []*InterfacerNamedInterfaceInvocation, fallback func() interface{}) {
+	f.SetNamedInterfaceHook(func(a interface{}) (z interface{}) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.A, call.Parameters.A, a) {
+				z = call.Results.Z
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback()
+	})
+}
+
+// NamedInterfaceCallsSnapshot returns a copy of the calls made to FakeInterfacer.NamedInterface
+func (f *FakeInterfacer) NamedInterfaceCallsSnapshot() []*InterfacerNamedInterfaceInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*InterfacerNamedInterfaceInvocation, len(f.NamedInterfaceCalls))
+	for i, call := range f.NamedInterfaceCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// NamedInterfaceCall returns a InterfacerCallMatcher selecting the calls of FakeInterfacer.NamedInterface with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) NamedInterfaceCall(a InterfacerMatcher[interface{}]) InterfacerCallMatcher {
+	return fake.NewCallMatcher("FakeInterfacer.NamedInterface(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*InterfacerNamedInterfaceInvocation(nil), f.NamedInterfaceCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // NamedInterfaceCalled returns true if FakeInterfacer.NamedInterface was called
 func (f *FakeInterfacer) NamedInterfaceCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedInterfaceCalls) != 0
 }
 
 // AssertNamedInterfaceCalled calls t.Error if FakeInterfacer.NamedInterface was not called
 func (f *FakeInterfacer) AssertNamedInterfaceCalled(t InterfacerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedInterfaceCalls) == 0 {
 		t.Error("FakeInterfacer.NamedInterface not called, expected at least one")
 	}
@@ -345,12 +985,16 @@ func (f *FakeInterfacer) AssertNamedInterfaceCalled(t InterfacerTestingT) {
 
 // NamedInterfaceNotCalled returns true if FakeInterfacer.NamedInterface was not called
 func (f *FakeInterfacer) NamedInterfaceNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedInterfaceCalls) == 0
 }
 
 // AssertNamedInterfaceNotCalled calls t.Error if FakeInterfacer.NamedInterface was called
 func (f *FakeInterfacer) AssertNamedInterfaceNotCalled(t InterfacerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedInterfaceCalls) != 0 {
 		t.Error("FakeInterfacer.NamedInterface called, expected none")
 	}
@@ -358,12 +1002,16 @@ func (f *FakeInterfacer) AssertNamedInterfaceNotCalled(t InterfacerTestingT) {
 
 // NamedInterfaceCalledOnce returns true if FakeInterfacer.NamedInterface was called exactly once
 func (f *FakeInterfacer) NamedInterfaceCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedInterfaceCalls) == 1
 }
 
 // AssertNamedInterfaceCalledOnce calls t.Error if FakeInterfacer.NamedInterface was not called exactly once
 func (f *FakeInterfacer) AssertNamedInterfaceCalledOnce(t InterfacerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedInterfaceCalls) != 1 {
 		t.Errorf("FakeInterfacer.NamedInterface called %d times, expected 1", len(f.NamedInterfaceCalls))
 	}
@@ -371,21 +1019,92 @@ func (f *FakeInterfacer) AssertNamedInterfaceCalledOnce(t InterfacerTestingT) {
 
 // NamedInterfaceCalledN returns true if FakeInterfacer.NamedInterface was called at least n times
 func (f *FakeInterfacer) NamedInterfaceCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedInterfaceCalls) >= n
 }
 
 // AssertNamedInterfaceCalledN calls t.Error if FakeInterfacer.NamedInterface was called less than n times
 func (f *FakeInterfacer) AssertNamedInterfaceCalledN(t InterfacerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedInterfaceCalls) < n {
 		t.Errorf("FakeInterfacer.NamedInterface called %d times, expected >= %d", len(f.NamedInterfaceCalls), n)
 	}
 }
 
-// NamedInterfaceCalledWith returns true if FakeInterfacer.NamedInterface was called with the given values
-func (f_sym14 *FakeInterfacer) NamedInterfaceCalledWith(a interface{}) bool {
-	for _, call_sym14 := range f_sym14.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) {
+// WaitForNamedInterfaceCalled blocks until FakeInterfacer.NamedInterface has been called, returning the error of ctx if it is done first
+func (f *FakeInterfacer) WaitForNamedInterfaceCalled(ctx context.Context) error {
+	return f.WaitForNamedInterfaceCalledN(ctx, 1)
+}
+
+// WaitForNamedInterfaceCalledN blocks until FakeInterfacer.NamedInterface has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeInterfacer) WaitForNamedInterfaceCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.NamedInterfaceCalls) >= n
+	})
+}
+
+// AssertNamedInterfaceEventuallyCalled calls t.Error if FakeInterfacer.NamedInterface is not called within the timeout
+func (f *FakeInterfacer) AssertNamedInterfaceEventuallyCalled(t InterfacerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForNamedInterfaceCalled(ctx) != nil {
+		t.Errorf("FakeInterfacer.NamedInterface not called within %v", timeout)
+	}
+}
+
+// describeNamedInterfaceCalls describes the calls of FakeInterfacer.NamedInterface against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeInterfacer) describeNamedInterfaceCalls(a InterfacerMatcher[interface{}]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tA: %s", fake.Describe(a))
+
+	if len(f.NamedInterfaceCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.NamedInterfaceCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.NamedInterfaceCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+
+	return b.String()
+}
+
+// NamedInterfaceCalledWith returns true if FakeInterfacer.NamedInterface was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) NamedInterfaceCalledWith(a InterfacerMatcher[interface{}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedInterfaceCalls {
+		if fake.Matches(a, call.Parameters.A) {
 			return true
 		}
 	}
@@ -393,55 +1112,86 @@ func (f_sym14 *FakeInterfacer) NamedInterfaceCalledWith(a interface{}) bool {
 	return false
 }
 
-// AssertNamedInterfaceCalledWith calls t.Error if FakeInterfacer.NamedInterface was not called with the given values
-func (f_sym15 *FakeInterfacer) AssertNamedInterfaceCalledWith(t InterfacerTestingT, a interface{}) {
+// AssertNamedInterfaceCalledWith calls t.Error if FakeInterfacer.NamedInterface was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) AssertNamedInterfaceCalledWith(t InterfacerTestingT, a InterfacerMatcher[interface{}]) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) {
-			found_sym15 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.NamedInterfaceCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym15 {
-		t.Error("FakeInterfacer.NamedInterface not called with expected parameters")
+	if !found {
+		t.Errorf("FakeInterfacer.NamedInterface not called with expected parameters\n%s", f.describeNamedInterfaceCalls(a))
 	}
 }
 
-// NamedInterfaceCalledOnceWith returns true if FakeInterfacer.NamedInterface was called exactly once with the given values
-func (f_sym16 *FakeInterfacer) NamedInterfaceCalledOnceWith(a interface{}) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) {
-			count_sym16++
+// NamedInterfaceCalledOnceWith returns true if FakeInterfacer.NamedInterface was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) NamedInterfaceCalledOnceWith(a InterfacerMatcher[interface{}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedInterfaceCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			count++
 		}
 	}
 
-	return count_sym16 == 1
+	return count == 1
 }
 
-// AssertNamedInterfaceCalledOnceWith calls t.Error if FakeInterfacer.NamedInterface was not called exactly once with the given values
-func (f_sym17 *FakeInterfacer) AssertNamedInterfaceCalledOnceWith(t InterfacerTestingT, a interface{}) {
+// AssertNamedInterfaceCalledOnceWith calls t.Error if FakeInterfacer.NamedInterface was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) AssertNamedInterfaceCalledOnceWith(t InterfacerTestingT, a InterfacerMatcher[interface{}]) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) {
-			count_sym17++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedInterfaceCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			count++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeInterfacer.NamedInterface called %d times with expected parameters, expected one", count_sym17)
+	if count != 1 {
+		t.Errorf("FakeInterfacer.NamedInterface called %d times with expected parameters, expected one\n%s", count, f.describeNamedInterfaceCalls(a))
+	}
+}
+
+// AssertNamedInterfaceEventuallyCalledWith calls t.Error if FakeInterfacer.NamedInterface is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeInterfacer) AssertNamedInterfaceEventuallyCalledWith(t InterfacerTestingT, timeout time.Duration, a InterfacerMatcher[interface{}]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.NamedInterfaceCalls)
+		for _, call := range f.NamedInterfaceCalls {
+			if fake.Matches(a, call.Parameters.A) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeInterfacer.NamedInterface not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeNamedInterfaceCalls(a))
 	}
 }
 
-// NamedInterfaceResultsForCall returns the result values for the first call to FakeInterfacer.NamedInterface with the given values
-func (f_sym18 *FakeInterfacer) NamedInterfaceResultsForCall(a interface{}) (z interface{}, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedInterfaceCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) {
-			z = call_sym18.Results.Z
-			found_sym18 = true
+// NamedInterfaceResultsForCall returns the result values for the first call to FakeInterfacer.NamedInterface with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeInterfacer) NamedInterfaceResultsForCall(a InterfacerMatcher[interface{}]) (z interface{}, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedInterfaceCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			z = call.Results.Z
+			found = true
 			break
 		}
 	}
@@ -2,30 +2,183 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // MapperMapParameterInvocation represents a single call of FakeMapper.MapParameter
 type MapperMapParameterInvocation struct {
 	Parameters struct {
 		Ident1 map[string]string
 	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetMapParameterInvocation
+	Matchers struct {
+		Ident1 MapperMatcher[map[string]string]
+	}
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *MapperMapParameterInvocation) String() string {
+	return fmt.Sprintf("FakeMapper.MapParameter%+v", i.Parameters)
+}
+
+// MapperMapParameterExpectation is a call of FakeMapper.MapParameter expected by a test, created by FakeMapper.ExpectMapParameter
+type MapperMapParameterExpectation struct {
+	fake     *FakeMapper
+	t        MapperTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 MapperMatcher[map[string]string]
+	}
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *MapperMapParameterExpectation) With(ident1 MapperMatcher[map[string]string]) *MapperMapParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *MapperMapParameterExpectation) matches(ident1 map[string]string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *MapperMapParameterExpectation) Times(n int) *MapperMapParameterExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // MapperMapReturnInvocation represents a single call of FakeMapper.MapReturn
 type MapperMapReturnInvocation struct {
-	Results struct {
-		Ident1 map[string]string
-	}
+	Results MapperMapReturnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *MapperMapReturnInvocation) String() string {
+	return "FakeMapper.MapReturn()"
+}
+
+// MapperMapReturnResults holds the results of a single call of FakeMapper.MapReturn
+type MapperMapReturnResults struct {
+	Ident1 map[string]string
+}
+
+// MapperMapReturnExpectation is a call of FakeMapper.MapReturn expected by a test, created by FakeMapper.ExpectMapReturn
+type MapperMapReturnExpectation struct {
+	fake    *FakeMapper
+	t       MapperTestingT
+	times   int
+	count   int
+	returns bool
+	results MapperMapReturnResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *MapperMapReturnExpectation) Times(n int) *MapperMapReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *MapperMapReturnExpectation) Return(ident1 map[string]string) *MapperMapReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = MapperMapReturnResults{Ident1: ident1}
+	return e
 }
 
 // MapperTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type MapperTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type MapperTestingT = fake.TestingT
+
+// MapperMatcher matches a parameter of a call to FakeMapper
+type MapperMatcher[T any] interface {
+	Match(T) bool
+}
+
+// MapperMatcherFunc is a MapperMatcher implemented by a predicate function
+type MapperMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m MapperMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// MapperAny returns a MapperMatcher that matches any value
+func MapperAny[T any]() MapperMatcher[T] {
+	return fake.Any[T]()
+}
+
+// MapperEq returns a MapperMatcher that matches values deeply equal to want
+func MapperEq[T any](want T) MapperMatcher[T] {
+	return fake.Eq(want)
 }
 
+// MapperNot returns a MapperMatcher that matches the values the given matcher does not
+func MapperNot[T any](m MapperMatcher[T]) MapperMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// MapperPred returns a MapperMatcher that matches values for which the given predicate returns true
+func MapperPred[T any](pred func(T) bool) MapperMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// MapperAnyOfType returns a MapperMatcher that matches values whose dynamic type is, or implements, U
+func MapperAnyOfType[T, U any]() MapperMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// MapperCallMatcher selects calls of a method of a fake for MapperInOrder and MapperUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeMapper
+type MapperCallMatcher = fake.CallMatcher
+
+// MapperInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func MapperInOrder(t MapperTestingT, calls ...MapperCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// MapperUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func MapperUnordered(t MapperTestingT, calls ...MapperCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// MapperExhausted selects how a method of FakeMapper behaves once the results given to its SetXReturnsSequence method are used up
+type MapperExhausted = fake.Exhausted
+
+const (
+	// MapperRepeatLast returns the last results in the sequence from all later calls
+	MapperRepeatLast = fake.RepeatLast
+	// MapperPanicAfterSequence panics on all later calls
+	MapperPanicAfterSequence = fake.PanicAfterSequence
+	// MapperHookAfterSequence calls the method's hook on all later calls
+	MapperHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeMapper is a mock implementation of Mapper for testing.
 Use it in your tests as in this example:
@@ -42,13 +195,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeMapParameter ...
+		// assert state of FakeMapper ...
 		f.AssertMapParameterCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeMapParameter.
+unexpected calls are made to FakeMapper.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeMapper struct {
 	MapParameterHook func(map[string]string)
@@ -56,6 +213,13 @@ type FakeMapper struct {
 
 	MapParameterCalls []*MapperMapParameterInvocation
 	MapReturnCalls    []*MapperMapReturnInvocation
+
+	returnsMapReturn         fake.Returns[MapperMapReturnResults]
+	expectationsMapParameter []*MapperMapParameterExpectation
+	expectationsMapReturn    []*MapperMapReturnExpectation
+	mutex                    sync.Mutex
+	recorded                 chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters           bool
 }
 
 // NewFakeMapperDefaultPanic returns an instance of FakeMapper with all hooks configured to panic
@@ -71,61 +235,231 @@ func NewFakeMapperDefaultPanic() *FakeMapper {
 }
 
 // NewFakeMapperDefaultFatal returns an instance of FakeMapper with all hooks configured to call t.Fatal
-func NewFakeMapperDefaultFatal(t_sym1 MapperTestingT) *FakeMapper {
+func NewFakeMapperDefaultFatal(t MapperTestingT) *FakeMapper {
 	return &FakeMapper{
 		MapParameterHook: func(map[string]string) {
-			t_sym1.Fatal("Unexpected call to Mapper.MapParameter")
+			t.Fatal("Unexpected call to Mapper.MapParameter")
 			return
 		},
 		MapReturnHook: func() (ident1 map[string]string) {
-			t_sym1.Fatal("Unexpected call to Mapper.MapReturn")
+			t.Fatal("Unexpected call to Mapper.MapReturn")
 			return
 		},
 	}
 }
 
 // NewFakeMapperDefaultError returns an instance of FakeMapper with all hooks configured to call t.Error
-func NewFakeMapperDefaultError(t_sym2 MapperTestingT) *FakeMapper {
+func NewFakeMapperDefaultError(t MapperTestingT) *FakeMapper {
 	return &FakeMapper{
 		MapParameterHook: func(map[string]string) {
-			t_sym2.Error("Unexpected call to Mapper.MapParameter")
+			t.Error("Unexpected call to Mapper.MapParameter")
 			return
 		},
 		MapReturnHook: func() (ident1 map[string]string) {
-			t_sym2.Error("Unexpected call to Mapper.MapReturn")
+			t.Error("Unexpected call to Mapper.MapReturn")
 			return
 		},
 	}
 }
 
+// NewFakeMapperDefaultZero returns an instance of FakeMapper with all hooks configured to return zero values
+func NewFakeMapperDefaultZero() *FakeMapper {
+	return &FakeMapper{
+		MapParameterHook: func(map[string]string) {
+			return
+		},
+		MapReturnHook: func() (ident1 map[string]string) {
+			return
+		},
+	}
+}
+
+// NewFakeMapperDefaultFriendly returns an instance of FakeMapper with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeMapper are friendly fakes, one for each method and result, created on first use
+func NewFakeMapperDefaultFriendly() *FakeMapper {
+
+	return &FakeMapper{
+		MapParameterHook: func(map[string]string) {
+			return
+		},
+		MapReturnHook: func() (ident1 map[string]string) {
+			ident1 = make(map[string]string)
+			return
+		},
+	}
+}
+
+// NewFakeMapperSpy returns an instance of FakeMapper with all hooks configured to call the given implementation
+func NewFakeMapperSpy(real Mapper) *FakeMapper {
+	return &FakeMapper{
+		MapParameterHook: real.MapParameter,
+		MapReturnHook:    real.MapReturn,
+	}
+}
+
+// Reset forgets all calls made to FakeMapper
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeMapper) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.MapParameterCalls = []*MapperMapParameterInvocation{}
+	f.returnsMapReturn.Rebase(len(f.MapReturnCalls))
 	f.MapReturnCalls = []*MapperMapReturnInvocation{}
 }
 
-func (f_sym3 *FakeMapper) MapParameter(ident1 map[string]string) {
-	if f_sym3.MapParameterHook == nil {
+// SetCopyParameters configures FakeMapper to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeMapper) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeMapper) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeMapper) MapParameter(ident1 map[string]string) {
+	f.mutex.Lock()
+	hook := f.MapParameterHook
+	expectation, t := f.expectedMapParameter(ident1)
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Mapper.MapParameter() called but FakeMapper.MapParameterHook is nil")
 	}
 
-	invocation_sym3 := new(MapperMapParameterInvocation)
-	f_sym3.MapParameterCalls = append(f_sym3.MapParameterCalls, invocation_sym3)
+	invocation := new(MapperMapParameterInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.MapParameterCalls = append(f.MapParameterCalls, invocation)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	f_sym3.MapParameterHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeMapper.MapParameter called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if hook != nil {
+		hook(ident1)
+	}
 
 	return
 }
 
+// expectedMapParameter returns the first unsatisfied expectation of FakeMapper.MapParameter matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeMapper) expectedMapParameter(ident1 map[string]string) (*MapperMapParameterExpectation, MapperTestingT) {
+	if len(f.expectationsMapParameter) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsMapParameter {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsMapParameter[0].t
+}
+
+// ExpectMapParameter expects calls of FakeMapper.MapParameter, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeMapper) ExpectMapParameter(t MapperTestingT) *MapperMapParameterExpectation {
+	t.Helper()
+	expectation := &MapperMapParameterExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsMapParameter = append(f.expectationsMapParameter, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeMapper.MapParameter called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetMapParameterHook configures Mapper.MapParameter to call the given function
+func (f *FakeMapper) SetMapParameterHook(hook func(map[string]string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.MapParameterHook = hook
+}
+
+// MapParameterCallsSnapshot returns a copy of the calls made to FakeMapper.MapParameter
+func (f *FakeMapper) MapParameterCallsSnapshot() []*MapperMapParameterInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*MapperMapParameterInvocation, len(f.MapParameterCalls))
+	for i, call := range f.MapParameterCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// MapParameterCall returns a MapperCallMatcher selecting the calls of FakeMapper.MapParameter with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeMapper) MapParameterCall(ident1 MapperMatcher[map[string]string]) MapperCallMatcher {
+	return fake.NewCallMatcher("FakeMapper.MapParameter(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*MapperMapParameterInvocation(nil), f.MapParameterCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
 // MapParameterCalled returns true if FakeMapper.MapParameter was called
 func (f *FakeMapper) MapParameterCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapParameterCalls) != 0
 }
 
 // AssertMapParameterCalled calls t.Error if FakeMapper.MapParameter was not called
 func (f *FakeMapper) AssertMapParameterCalled(t MapperTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapParameterCalls) == 0 {
 		t.Error("FakeMapper.MapParameter not called, expected at least one")
 	}
@@ -133,12 +467,16 @@ func (f *FakeMapper) AssertMapParameterCalled(t MapperTestingT) {
 
 // MapParameterNotCalled returns true if FakeMapper.MapParameter was not called
 func (f *FakeMapper) MapParameterNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapParameterCalls) == 0
 }
 
 // AssertMapParameterNotCalled calls t.Error if FakeMapper.MapParameter was called
 func (f *FakeMapper) AssertMapParameterNotCalled(t MapperTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapParameterCalls) != 0 {
 		t.Error("FakeMapper.MapParameter called, expected none")
 	}
@@ -146,12 +484,16 @@ func (f *FakeMapper) AssertMapParameterNotCalled(t MapperTestingT) {
 
 // MapParameterCalledOnce returns true if FakeMapper.MapParameter was called exactly once
 func (f *FakeMapper) MapParameterCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapParameterCalls) == 1
 }
 
 // AssertMapParameterCalledOnce calls t.Error if FakeMapper.MapParameter was not called exactly once
 func (f *FakeMapper) AssertMapParameterCalledOnce(t MapperTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapParameterCalls) != 1 {
 		t.Errorf("FakeMapper.MapParameter called %d times, expected 1", len(f.MapParameterCalls))
 	}
@@ -159,21 +501,92 @@ func (f *FakeMapper) AssertMapParameterCalledOnce(t MapperTestingT) {
 
 // MapParameterCalledN returns true if FakeMapper.MapParameter was called at least n times
 func (f *FakeMapper) MapParameterCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapParameterCalls) >= n
 }
 
 // AssertMapParameterCalledN calls t.Error if FakeMapper.MapParameter was called less than n times
 func (f *FakeMapper) AssertMapParameterCalledN(t MapperTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapParameterCalls) < n {
 		t.Errorf("FakeMapper.MapParameter called %d times, expected >= %d", len(f.MapParameterCalls), n)
 	}
 }
 
-// MapParameterCalledWith returns true if FakeMapper.MapParameter was called with the given values
-func (f_sym4 *FakeMapper) MapParameterCalledWith(ident1 map[string]string) bool {
-	for _, call_sym4 := range f_sym4.MapParameterCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.Ident1, ident1) {
+// WaitForMapParameterCalled blocks until FakeMapper.MapParameter has been called, returning the error of ctx if it is done first
+func (f *FakeMapper) WaitForMapParameterCalled(ctx context.Context) error {
+	return f.WaitForMapParameterCalledN(ctx, 1)
+}
+
+// WaitForMapParameterCalledN blocks until FakeMapper.MapParameter has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeMapper) WaitForMapParameterCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.MapParameterCalls) >= n
+	})
+}
+
+// AssertMapParameterEventuallyCalled calls t.Error if FakeMapper.MapParameter is not called within the timeout
+func (f *FakeMapper) AssertMapParameterEventuallyCalled(t MapperTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForMapParameterCalled(ctx) != nil {
+		t.Errorf("FakeMapper.MapParameter not called within %v", timeout)
+	}
+}
+
+// describeMapParameterCalls describes the calls of FakeMapper.MapParameter against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeMapper) describeMapParameterCalls(ident1 MapperMatcher[map[string]string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.MapParameterCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.MapParameterCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.MapParameterCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// MapParameterCalledWith returns true if FakeMapper.MapParameter was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeMapper) MapParameterCalledWith(ident1 MapperMatcher[map[string]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.MapParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -181,79 +594,232 @@ func (f_sym4 *FakeMapper) MapParameterCalledWith(ident1 map[string]string) bool
 	return false
 }
 
-// AssertMapParameterCalledWith calls t.Error if FakeMapper.MapParameter was not called with the given values
-func (f_sym5 *FakeMapper) AssertMapParameterCalledWith(t MapperTestingT, ident1 map[string]string) {
+// AssertMapParameterCalledWith calls t.Error if FakeMapper.MapParameter was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeMapper) AssertMapParameterCalledWith(t MapperTestingT, ident1 MapperMatcher[map[string]string]) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.MapParameterCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-			found_sym5 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.MapParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym5 {
-		t.Error("FakeMapper.MapParameter not called with expected parameters")
+	if !found {
+		t.Errorf("FakeMapper.MapParameter not called with expected parameters\n%s", f.describeMapParameterCalls(ident1))
 	}
 }
 
-// MapParameterCalledOnceWith returns true if FakeMapper.MapParameter was called exactly once with the given values
-func (f_sym6 *FakeMapper) MapParameterCalledOnceWith(ident1 map[string]string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.MapParameterCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
-			count_sym6++
+// MapParameterCalledOnceWith returns true if FakeMapper.MapParameter was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeMapper) MapParameterCalledOnceWith(ident1 MapperMatcher[map[string]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.MapParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym6 == 1
+	return count == 1
 }
 
-// AssertMapParameterCalledOnceWith calls t.Error if FakeMapper.MapParameter was not called exactly once with the given values
-func (f_sym7 *FakeMapper) AssertMapParameterCalledOnceWith(t MapperTestingT, ident1 map[string]string) {
+// AssertMapParameterCalledOnceWith calls t.Error if FakeMapper.MapParameter was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeMapper) AssertMapParameterCalledOnceWith(t MapperTestingT, ident1 MapperMatcher[map[string]string]) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.MapParameterCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			count_sym7++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.MapParameterCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeMapper.MapParameter called %d times with expected parameters, expected one", count_sym7)
+	if count != 1 {
+		t.Errorf("FakeMapper.MapParameter called %d times with expected parameters, expected one\n%s", count, f.describeMapParameterCalls(ident1))
 	}
 }
 
-func (f_sym8 *FakeMapper) MapReturn() (ident1 map[string]string) {
-	if f_sym8.MapReturnHook == nil {
+// AssertMapParameterEventuallyCalledWith calls t.Error if FakeMapper.MapParameter is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeMapper) AssertMapParameterEventuallyCalledWith(t MapperTestingT, timeout time.Duration, ident1 MapperMatcher[map[string]string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.MapParameterCalls)
+		for _, call := range f.MapParameterCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeMapper.MapParameter not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeMapParameterCalls(ident1))
+	}
+}
+
+func (f *FakeMapper) MapReturn() (ident1 map[string]string) {
+	f.mutex.Lock()
+	hook := f.MapReturnHook
+	expectation, t := f.expectedMapReturn()
+	var results MapperMapReturnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsMapReturn.Lookup(len(f.MapReturnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Mapper.MapReturn() called after the results given to FakeMapper.SetMapReturnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Mapper.MapReturn() called but FakeMapper.MapReturnHook is nil")
 	}
 
-	invocation_sym8 := new(MapperMapReturnInvocation)
-	f_sym8.MapReturnCalls = append(f_sym8.MapReturnCalls, invocation_sym8)
+	invocation := new(MapperMapReturnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.MapReturnCalls = append(f.MapReturnCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	ident1 = f_sym8.MapReturnHook()
+	if t != nil && expectation == nil {
+		t.Error("FakeMapper.MapReturn called more times than expected")
+	}
 
-	invocation_sym8.Results.Ident1 = ident1
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedMapReturn returns the first unsatisfied expectation of FakeMapper.MapReturn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeMapper) expectedMapReturn() (*MapperMapReturnExpectation, MapperTestingT) {
+	if len(f.expectationsMapReturn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsMapReturn {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsMapReturn[0].t
+}
+
+// ExpectMapReturn expects calls of FakeMapper.MapReturn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeMapper) ExpectMapReturn(t MapperTestingT) *MapperMapReturnExpectation {
+	t.Helper()
+	expectation := &MapperMapReturnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsMapReturn = append(f.expectationsMapReturn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeMapper.MapReturn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetMapReturnHook configures Mapper.MapReturn to call the given function
+func (f *FakeMapper) SetMapReturnHook(hook func() map[string]string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.MapReturnHook = hook
+}
+
 // SetMapReturnStub configures Mapper.MapReturn to always return the given values
-func (f_sym9 *FakeMapper) SetMapReturnStub(ident1 map[string]string) {
-	f_sym9.MapReturnHook = func() map[string]string {
+func (f *FakeMapper) SetMapReturnStub(ident1 map[string]string) {
+	f.SetMapReturnHook(func() map[string]string {
 		return ident1
+	})
+}
+
+// SetMapReturnReturnsOnCall configures Mapper.MapReturn to return the given values from the call with the given index in MapReturnCalls, rather than calling the hook
+func (f *FakeMapper) SetMapReturnReturnsOnCall(call int, ident1 map[string]string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsMapReturn.Set(call, MapperMapReturnResults{Ident1: ident1})
+}
+
+// SetMapReturnReturnsSequence configures the following calls of Mapper.MapReturn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeMapper) SetMapReturnReturnsSequence(exhausted MapperExhausted, results ...MapperMapReturnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsMapReturn.Sequence(len(f.MapReturnCalls), exhausted, results)
+}
+
+// MapReturnCallsSnapshot returns a copy of the calls made to FakeMapper.MapReturn
+func (f *FakeMapper) MapReturnCallsSnapshot() []*MapperMapReturnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*MapperMapReturnInvocation, len(f.MapReturnCalls))
+	for i, call := range f.MapReturnCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// MapReturnCall returns a MapperCallMatcher selecting the calls of FakeMapper.MapReturn
+func (f *FakeMapper) MapReturnCall() MapperCallMatcher {
+	return fake.NewCallMatcher("FakeMapper.MapReturn()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*MapperMapReturnInvocation(nil), f.MapReturnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // MapReturnCalled returns true if FakeMapper.MapReturn was called
 func (f *FakeMapper) MapReturnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapReturnCalls) != 0
 }
 
 // AssertMapReturnCalled calls t.Error if FakeMapper.MapReturn was not called
 func (f *FakeMapper) AssertMapReturnCalled(t MapperTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapReturnCalls) == 0 {
 		t.Error("FakeMapper.MapReturn not called, expected at least one")
 	}
@@ -261,12 +827,16 @@ func (f *FakeMapper) AssertMapReturnCalled(t MapperTestingT) {
 
 // MapReturnNotCalled returns true if FakeMapper.MapReturn was not called
 func (f *FakeMapper) MapReturnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapReturnCalls) == 0
 }
 
 // AssertMapReturnNotCalled calls t.Error if FakeMapper.MapReturn was called
 func (f *FakeMapper) AssertMapReturnNotCalled(t MapperTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapReturnCalls) != 0 {
 		t.Error("FakeMapper.MapReturn called, expected none")
 	}
@@ -274,12 +844,16 @@ func (f *FakeMapper) AssertMapReturnNotCalled(t MapperTestingT) {
 
 // MapReturnCalledOnce returns true if FakeMapper.MapReturn was called exactly once
 func (f *FakeMapper) MapReturnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapReturnCalls) == 1
 }
 
 // AssertMapReturnCalledOnce calls t.Error if FakeMapper.MapReturn was not called exactly once
 func (f *FakeMapper) AssertMapReturnCalledOnce(t MapperTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapReturnCalls) != 1 {
 		t.Errorf("FakeMapper.MapReturn called %d times, expected 1", len(f.MapReturnCalls))
 	}
@@ -287,13 +861,39 @@ func (f *FakeMapper) AssertMapReturnCalledOnce(t MapperTestingT) {
 
 // MapReturnCalledN returns true if FakeMapper.MapReturn was called at least n times
 func (f *FakeMapper) MapReturnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MapReturnCalls) >= n
 }
 
 // AssertMapReturnCalledN calls t.Error if FakeMapper.MapReturn was called less than n times
 func (f *FakeMapper) AssertMapReturnCalledN(t MapperTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MapReturnCalls) < n {
 		t.Errorf("FakeMapper.MapReturn called %d times, expected >= %d", len(f.MapReturnCalls), n)
 	}
 }
+
+// WaitForMapReturnCalled blocks until FakeMapper.MapReturn has been called, returning the error of ctx if it is done first
+func (f *FakeMapper) WaitForMapReturnCalled(ctx context.Context) error {
+	return f.WaitForMapReturnCalledN(ctx, 1)
+}
+
+// WaitForMapReturnCalledN blocks until FakeMapper.MapReturn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeMapper) WaitForMapReturnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.MapReturnCalls) >= n
+	})
+}
+
+// AssertMapReturnEventuallyCalled calls t.Error if FakeMapper.MapReturn is not called within the timeout
+func (f *FakeMapper) AssertMapReturnEventuallyCalled(t MapperTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForMapReturnCalled(ctx) != nil {
+		t.Errorf("FakeMapper.MapReturn not called within %v", timeout)
+	}
+}
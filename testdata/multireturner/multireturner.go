@@ -2,32 +2,177 @@
 
 package main
 
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
+
 // MultireturnerMultiReturnInvocation represents a single call of FakeMultireturner.MultiReturn
 type MultireturnerMultiReturnInvocation struct {
-	Results struct {
-		Ident1 string
-		Ident2 int
-	}
+	Results MultireturnerMultiReturnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *MultireturnerMultiReturnInvocation) String() string {
+	return "FakeMultireturner.MultiReturn()"
+}
+
+// MultireturnerMultiReturnResults holds the results of a single call of FakeMultireturner.MultiReturn
+type MultireturnerMultiReturnResults struct {
+	Ident1 string
+	Ident2 int
+}
+
+// MultireturnerMultiReturnExpectation is a call of FakeMultireturner.MultiReturn expected by a test, created by FakeMultireturner.ExpectMultiReturn
+type MultireturnerMultiReturnExpectation struct {
+	fake    *FakeMultireturner
+	t       MultireturnerTestingT
+	times   int
+	count   int
+	returns bool
+	results MultireturnerMultiReturnResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *MultireturnerMultiReturnExpectation) Times(n int) *MultireturnerMultiReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *MultireturnerMultiReturnExpectation) Return(ident1 string, ident2 int) *MultireturnerMultiReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = MultireturnerMultiReturnResults{Ident1: ident1, Ident2: ident2}
+	return e
 }
 
 // MultireturnerNamedReturnInvocation represents a single call of FakeMultireturner.NamedReturn
 type MultireturnerNamedReturnInvocation struct {
-	Results struct {
-		A int
-		B int
-		C int
-		D int
-	}
+	Results MultireturnerNamedReturnResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *MultireturnerNamedReturnInvocation) String() string {
+	return "FakeMultireturner.NamedReturn()"
+}
+
+// MultireturnerNamedReturnResults holds the results of a single call of FakeMultireturner.NamedReturn
+type MultireturnerNamedReturnResults struct {
+	A int
+	B int
+	C int
+	D int
+}
+
+// MultireturnerNamedReturnExpectation is a call of FakeMultireturner.NamedReturn expected by a test, created by FakeMultireturner.ExpectNamedReturn
+type MultireturnerNamedReturnExpectation struct {
+	fake    *FakeMultireturner
+	t       MultireturnerTestingT
+	times   int
+	count   int
+	returns bool
+	results MultireturnerNamedReturnResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *MultireturnerNamedReturnExpectation) Times(n int) *MultireturnerNamedReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *MultireturnerNamedReturnExpectation) Return(a int, b int, c int, d int) *MultireturnerNamedReturnExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = MultireturnerNamedReturnResults{A: a, B: b, C: c, D: d}
+	return e
 }
 
 // MultireturnerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type MultireturnerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type MultireturnerTestingT = fake.TestingT
+
+// MultireturnerMatcher matches a parameter of a call to FakeMultireturner
+type MultireturnerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// MultireturnerMatcherFunc is a MultireturnerMatcher implemented by a predicate function
+type MultireturnerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m MultireturnerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// MultireturnerAny returns a MultireturnerMatcher that matches any value
+func MultireturnerAny[T any]() MultireturnerMatcher[T] {
+	return fake.Any[T]()
+}
+
+// MultireturnerEq returns a MultireturnerMatcher that matches values deeply equal to want
+func MultireturnerEq[T any](want T) MultireturnerMatcher[T] {
+	return fake.Eq(want)
+}
+
+// MultireturnerNot returns a MultireturnerMatcher that matches the values the given matcher does not
+func MultireturnerNot[T any](m MultireturnerMatcher[T]) MultireturnerMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// MultireturnerPred returns a MultireturnerMatcher that matches values for which the given predicate returns true
+func MultireturnerPred[T any](pred func(T) bool) MultireturnerMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// MultireturnerAnyOfType returns a MultireturnerMatcher that matches values whose dynamic type is, or implements, U
+func MultireturnerAnyOfType[T, U any]() MultireturnerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// MultireturnerCallMatcher selects calls of a method of a fake for MultireturnerInOrder and MultireturnerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeMultireturner
+type MultireturnerCallMatcher = fake.CallMatcher
+
+// MultireturnerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func MultireturnerInOrder(t MultireturnerTestingT, calls ...MultireturnerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// MultireturnerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func MultireturnerUnordered(t MultireturnerTestingT, calls ...MultireturnerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
 }
 
+// MultireturnerExhausted selects how a method of FakeMultireturner behaves once the results given to its SetXReturnsSequence method are used up
+type MultireturnerExhausted = fake.Exhausted
+
+const (
+	// MultireturnerRepeatLast returns the last results in the sequence from all later calls
+	MultireturnerRepeatLast = fake.RepeatLast
+	// MultireturnerPanicAfterSequence panics on all later calls
+	MultireturnerPanicAfterSequence = fake.PanicAfterSequence
+	// MultireturnerHookAfterSequence calls the method's hook on all later calls
+	MultireturnerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeMultireturner is a mock implementation of Multireturner for testing.
 Use it in your tests as in this example:
@@ -44,13 +189,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeMultiReturn ...
+		// assert state of FakeMultireturner ...
 		f.AssertMultiReturnCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeMultiReturn.
+unexpected calls are made to FakeMultireturner.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeMultireturner struct {
 	MultiReturnHook func() (string, int)
@@ -58,6 +207,13 @@ type FakeMultireturner struct {
 
 	MultiReturnCalls []*MultireturnerMultiReturnInvocation
 	NamedReturnCalls []*MultireturnerNamedReturnInvocation
+
+	returnsMultiReturn      fake.Returns[MultireturnerMultiReturnResults]
+	returnsNamedReturn      fake.Returns[MultireturnerNamedReturnResults]
+	expectationsMultiReturn []*MultireturnerMultiReturnExpectation
+	expectationsNamedReturn []*MultireturnerNamedReturnExpectation
+	mutex                   sync.Mutex
+	recorded                chan struct{} // closed when the next call is recorded, if waited for
 }
 
 // NewFakeMultireturnerDefaultPanic returns an instance of FakeMultireturner with all hooks configured to panic
@@ -73,69 +229,256 @@ func NewFakeMultireturnerDefaultPanic() *FakeMultireturner {
 }
 
 // NewFakeMultireturnerDefaultFatal returns an instance of FakeMultireturner with all hooks configured to call t.Fatal
-func NewFakeMultireturnerDefaultFatal(t_sym1 MultireturnerTestingT) *FakeMultireturner {
+func NewFakeMultireturnerDefaultFatal(t MultireturnerTestingT) *FakeMultireturner {
 	return &FakeMultireturner{
 		MultiReturnHook: func() (ident1 string, ident2 int) {
-			t_sym1.Fatal("Unexpected call to Multireturner.MultiReturn")
+			t.Fatal("Unexpected call to Multireturner.MultiReturn")
 			return
 		},
 		NamedReturnHook: func() (a int, b int, c int, d int) {
-			t_sym1.Fatal("Unexpected call to Multireturner.NamedReturn")
+			t.Fatal("Unexpected call to Multireturner.NamedReturn")
 			return
 		},
 	}
 }
 
 // NewFakeMultireturnerDefaultError returns an instance of FakeMultireturner with all hooks configured to call t.Error
-func NewFakeMultireturnerDefaultError(t_sym2 MultireturnerTestingT) *FakeMultireturner {
+func NewFakeMultireturnerDefaultError(t MultireturnerTestingT) *FakeMultireturner {
+	return &FakeMultireturner{
+		MultiReturnHook: func() (ident1 string, ident2 int) {
+			t.Error("Unexpected call to Multireturner.MultiReturn")
+			return
+		},
+		NamedReturnHook: func() (a int, b int, c int, d int) {
+			t.Error("Unexpected call to Multireturner.NamedReturn")
+			return
+		},
+	}
+}
+
+// NewFakeMultireturnerDefaultZero returns an instance of FakeMultireturner with all hooks configured to return zero values
+func NewFakeMultireturnerDefaultZero() *FakeMultireturner {
+	return &FakeMultireturner{
+		MultiReturnHook: func() (ident1 string, ident2 int) {
+			return
+		},
+		NamedReturnHook: func() (a int, b int, c int, d int) {
+			return
+		},
+	}
+}
+
+// NewFakeMultireturnerDefaultFriendly returns an instance of FakeMultireturner with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeMultireturner are friendly fakes, one for each method and result, created on first use
+func NewFakeMultireturnerDefaultFriendly() *FakeMultireturner {
+
 	return &FakeMultireturner{
 		MultiReturnHook: func() (ident1 string, ident2 int) {
-			t_sym2.Error("Unexpected call to Multireturner.MultiReturn")
 			return
 		},
 		NamedReturnHook: func() (a int, b int, c int, d int) {
-			t_sym2.Error("Unexpected call to Multireturner.NamedReturn")
 			return
 		},
 	}
 }
 
+// NewFakeMultireturnerSpy returns an instance of FakeMultireturner with all hooks configured to call the given implementation
+func NewFakeMultireturnerSpy(real Multireturner) *FakeMultireturner {
+	return &FakeMultireturner{
+		MultiReturnHook: real.MultiReturn,
+		NamedReturnHook: real.NamedReturn,
+	}
+}
+
+// Reset forgets all calls made to FakeMultireturner
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeMultireturner) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsMultiReturn.Rebase(len(f.MultiReturnCalls))
 	f.MultiReturnCalls = []*MultireturnerMultiReturnInvocation{}
+	f.returnsNamedReturn.Rebase(len(f.NamedReturnCalls))
 	f.NamedReturnCalls = []*MultireturnerNamedReturnInvocation{}
 }
 
-func (f_sym3 *FakeMultireturner) MultiReturn() (ident1 string, ident2 int) {
-	if f_sym3.MultiReturnHook == nil {
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeMultireturner) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeMultireturner) MultiReturn() (ident1 string, ident2 int) {
+	f.mutex.Lock()
+	hook := f.MultiReturnHook
+	expectation, t := f.expectedMultiReturn()
+	var results MultireturnerMultiReturnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsMultiReturn.Lookup(len(f.MultiReturnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Multireturner.MultiReturn() called after the results given to FakeMultireturner.SetMultiReturnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Multireturner.MultiReturn() called but FakeMultireturner.MultiReturnHook is nil")
 	}
 
-	invocation_sym3 := new(MultireturnerMultiReturnInvocation)
-	f_sym3.MultiReturnCalls = append(f_sym3.MultiReturnCalls, invocation_sym3)
+	invocation := new(MultireturnerMultiReturnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.MultiReturnCalls = append(f.MultiReturnCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeMultireturner.MultiReturn called more times than expected")
+	}
 
-	ident1, ident2 = f_sym3.MultiReturnHook()
+	if found {
+		ident1 = results.Ident1
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident1, ident2 = hook()
+	}
 
-	invocation_sym3.Results.Ident1 = ident1
-	invocation_sym3.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedMultiReturn returns the first unsatisfied expectation of FakeMultireturner.MultiReturn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeMultireturner) expectedMultiReturn() (*MultireturnerMultiReturnExpectation, MultireturnerTestingT) {
+	if len(f.expectationsMultiReturn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsMultiReturn {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsMultiReturn[0].t
+}
+
+// ExpectMultiReturn expects calls of FakeMultireturner.MultiReturn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeMultireturner) ExpectMultiReturn(t MultireturnerTestingT) *MultireturnerMultiReturnExpectation {
+	t.Helper()
+	expectation := &MultireturnerMultiReturnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsMultiReturn = append(f.expectationsMultiReturn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeMultireturner.MultiReturn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetMultiReturnHook configures Multireturner.MultiReturn to call the given function
+func (f *FakeMultireturner) SetMultiReturnHook(hook func() (string, int)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.MultiReturnHook = hook
+}
+
 // SetMultiReturnStub configures Multireturner.MultiReturn to always return the given values
-func (f_sym4 *FakeMultireturner) SetMultiReturnStub(ident1 string, ident2 int) {
-	f_sym4.MultiReturnHook = func() (string, int) {
+func (f *FakeMultireturner) SetMultiReturnStub(ident1 string, ident2 int) {
+	f.SetMultiReturnHook(func() (string, int) {
 		return ident1, ident2
+	})
+}
+
+// SetMultiReturnReturnsOnCall configures Multireturner.MultiReturn to return the given values from the call with the given index in MultiReturnCalls, rather than calling the hook
+func (f *FakeMultireturner) SetMultiReturnReturnsOnCall(call int, ident1 string, ident2 int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsMultiReturn.Set(call, MultireturnerMultiReturnResults{Ident1: ident1, Ident2: ident2})
+}
+
+// SetMultiReturnReturnsSequence configures the following calls of Multireturner.MultiReturn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeMultireturner) SetMultiReturnReturnsSequence(exhausted MultireturnerExhausted, results ...MultireturnerMultiReturnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsMultiReturn.Sequence(len(f.MultiReturnCalls), exhausted, results)
+}
+
+// MultiReturnCallsSnapshot returns a copy of the calls made to FakeMultireturner.MultiReturn
+func (f *FakeMultireturner) MultiReturnCallsSnapshot() []*MultireturnerMultiReturnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*MultireturnerMultiReturnInvocation, len(f.MultiReturnCalls))
+	for i, call := range f.MultiReturnCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// MultiReturnCall returns a MultireturnerCallMatcher selecting the calls of FakeMultireturner.MultiReturn
+func (f *FakeMultireturner) MultiReturnCall() MultireturnerCallMatcher {
+	return fake.NewCallMatcher("FakeMultireturner.MultiReturn()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*MultireturnerMultiReturnInvocation(nil), f.MultiReturnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // MultiReturnCalled returns true if FakeMultireturner.MultiReturn was called
 func (f *FakeMultireturner) MultiReturnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MultiReturnCalls) != 0
 }
 
 // AssertMultiReturnCalled calls t.Error if FakeMultireturner.MultiReturn was not called
 func (f *FakeMultireturner) AssertMultiReturnCalled(t MultireturnerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MultiReturnCalls) == 0 {
 		t.Error("FakeMultireturner.MultiReturn not called, expected at least one")
 	}
@@ -143,12 +486,16 @@ func (f *FakeMultireturner) AssertMultiReturnCalled(t MultireturnerTestingT) {
 
 // MultiReturnNotCalled returns true if FakeMultireturner.MultiReturn was not called
 func (f *FakeMultireturner) MultiReturnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MultiReturnCalls) == 0
 }
 
 // AssertMultiReturnNotCalled calls t.Error if FakeMultireturner.MultiReturn was called
 func (f *FakeMultireturner) AssertMultiReturnNotCalled(t MultireturnerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MultiReturnCalls) != 0 {
 		t.Error("FakeMultireturner.MultiReturn called, expected none")
 	}
@@ -156,12 +503,16 @@ func (f *FakeMultireturner) AssertMultiReturnNotCalled(t MultireturnerTestingT)
 
 // MultiReturnCalledOnce returns true if FakeMultireturner.MultiReturn was called exactly once
 func (f *FakeMultireturner) MultiReturnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MultiReturnCalls) == 1
 }
 
 // AssertMultiReturnCalledOnce calls t.Error if FakeMultireturner.MultiReturn was not called exactly once
 func (f *FakeMultireturner) AssertMultiReturnCalledOnce(t MultireturnerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MultiReturnCalls) != 1 {
 		t.Errorf("FakeMultireturner.MultiReturn called %d times, expected 1", len(f.MultiReturnCalls))
 	}
@@ -169,50 +520,203 @@ func (f *FakeMultireturner) AssertMultiReturnCalledOnce(t MultireturnerTestingT)
 
 // MultiReturnCalledN returns true if FakeMultireturner.MultiReturn was called at least n times
 func (f *FakeMultireturner) MultiReturnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MultiReturnCalls) >= n
 }
 
 // AssertMultiReturnCalledN calls t.Error if FakeMultireturner.MultiReturn was called less than n times
 func (f *FakeMultireturner) AssertMultiReturnCalledN(t MultireturnerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MultiReturnCalls) < n {
 		t.Errorf("FakeMultireturner.MultiReturn called %d times, expected >= %d", len(f.MultiReturnCalls), n)
 	}
 }
 
-func (f_sym5 *FakeMultireturner) NamedReturn() (a int, b int, c int, d int) {
-	if f_sym5.NamedReturnHook == nil {
+// WaitForMultiReturnCalled blocks until FakeMultireturner.MultiReturn has been called, returning the error of ctx if it is done first
+func (f *FakeMultireturner) WaitForMultiReturnCalled(ctx context.Context) error {
+	return f.WaitForMultiReturnCalledN(ctx, 1)
+}
+
+// WaitForMultiReturnCalledN blocks until FakeMultireturner.MultiReturn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeMultireturner) WaitForMultiReturnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.MultiReturnCalls) >= n
+	})
+}
+
+// AssertMultiReturnEventuallyCalled calls t.Error if FakeMultireturner.MultiReturn is not called within the timeout
+func (f *FakeMultireturner) AssertMultiReturnEventuallyCalled(t MultireturnerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForMultiReturnCalled(ctx) != nil {
+		t.Errorf("FakeMultireturner.MultiReturn not called within %v", timeout)
+	}
+}
+
+func (f *FakeMultireturner) NamedReturn() (a int, b int, c int, d int) {
+	f.mutex.Lock()
+	hook := f.NamedReturnHook
+	expectation, t := f.expectedNamedReturn()
+	var results MultireturnerNamedReturnResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsNamedReturn.Lookup(len(f.NamedReturnCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Multireturner.NamedReturn() called after the results given to FakeMultireturner.SetNamedReturnReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Multireturner.NamedReturn() called but FakeMultireturner.NamedReturnHook is nil")
 	}
 
-	invocation_sym5 := new(MultireturnerNamedReturnInvocation)
-	f_sym5.NamedReturnCalls = append(f_sym5.NamedReturnCalls, invocation_sym5)
+	invocation := new(MultireturnerNamedReturnInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.NamedReturnCalls = append(f.NamedReturnCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeMultireturner.NamedReturn called more times than expected")
+	}
 
-	a, b, c, d = f_sym5.NamedReturnHook()
+	if found {
+		a = results.A
+		b = results.B
+		c = results.C
+		d = results.D
+	} else if hook != nil {
+		a, b, c, d = hook()
+	}
 
-	invocation_sym5.Results.A = a
-	invocation_sym5.Results.B = b
-	invocation_sym5.Results.C = c
-	invocation_sym5.Results.D = d
+	f.mutex.Lock()
+	invocation.Results.A = a
+	invocation.Results.B = b
+	invocation.Results.C = c
+	invocation.Results.D = d
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedNamedReturn returns the first unsatisfied expectation of FakeMultireturner.NamedReturn matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeMultireturner) expectedNamedReturn() (*MultireturnerNamedReturnExpectation, MultireturnerTestingT) {
+	if len(f.expectationsNamedReturn) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsNamedReturn {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsNamedReturn[0].t
+}
+
+// ExpectNamedReturn expects calls of FakeMultireturner.NamedReturn, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeMultireturner) ExpectNamedReturn(t MultireturnerTestingT) *MultireturnerNamedReturnExpectation {
+	t.Helper()
+	expectation := &MultireturnerNamedReturnExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsNamedReturn = append(f.expectationsNamedReturn, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeMultireturner.NamedReturn called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetNamedReturnHook configures Multireturner.NamedReturn to call the given function
+func (f *FakeMultireturner) SetNamedReturnHook(hook func() (int, int, int, int)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.NamedReturnHook = hook
+}
+
 // SetNamedReturnStub configures Multireturner.NamedReturn to always return the given values
-func (f_sym6 *FakeMultireturner) SetNamedReturnStub(a int, b int, c int, d int) {
-	f_sym6.NamedReturnHook = func() (int, int, int, int) {
+func (f *FakeMultireturner) SetNamedReturnStub(a int, b int, c int, d int) {
+	f.SetNamedReturnHook(func() (int, int, int, int) {
 		return a, b, c, d
+	})
+}
+
+// SetNamedReturnReturnsOnCall configures Multireturner.NamedReturn to return the given values from the call with the given index in NamedReturnCalls, rather than calling the hook
+func (f *FakeMultireturner) SetNamedReturnReturnsOnCall(call int, a int, b int, c int, d int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedReturn.Set(call, MultireturnerNamedReturnResults{A: a, B: b, C: c, D: d})
+}
+
+// SetNamedReturnReturnsSequence configures the following calls of Multireturner.NamedReturn to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeMultireturner) SetNamedReturnReturnsSequence(exhausted MultireturnerExhausted, results ...MultireturnerNamedReturnResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedReturn.Sequence(len(f.NamedReturnCalls), exhausted, results)
+}
+
+// NamedReturnCallsSnapshot returns a copy of the calls made to FakeMultireturner.NamedReturn
+func (f *FakeMultireturner) NamedReturnCallsSnapshot() []*MultireturnerNamedReturnInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*MultireturnerNamedReturnInvocation, len(f.NamedReturnCalls))
+	for i, call := range f.NamedReturnCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// NamedReturnCall returns a MultireturnerCallMatcher selecting the calls of FakeMultireturner.NamedReturn
+func (f *FakeMultireturner) NamedReturnCall() MultireturnerCallMatcher {
+	return fake.NewCallMatcher("FakeMultireturner.NamedReturn()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*MultireturnerNamedReturnInvocation(nil), f.NamedReturnCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
 }
 
 // NamedReturnCalled returns true if FakeMultireturner.NamedReturn was called
 func (f *FakeMultireturner) NamedReturnCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedReturnCalls) != 0
 }
 
 // AssertNamedReturnCalled calls t.Error if FakeMultireturner.NamedReturn was not called
 func (f *FakeMultireturner) AssertNamedReturnCalled(t MultireturnerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedReturnCalls) == 0 {
 		t.Error("FakeMultireturner.NamedReturn not called, expected at least one")
 	}
@@ -220,12 +724,16 @@ func (f *FakeMultireturner) AssertNamedReturnCalled(t MultireturnerTestingT) {
 
 // NamedReturnNotCalled returns true if FakeMultireturner.NamedReturn was not called
 func (f *FakeMultireturner) NamedReturnNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedReturnCalls) == 0
 }
 
 // AssertNamedReturnNotCalled calls t.Error if FakeMultireturner.NamedReturn was called
 func (f *FakeMultireturner) AssertNamedReturnNotCalled(t MultireturnerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedReturnCalls) != 0 {
 		t.Error("FakeMultireturner.NamedReturn called, expected none")
 	}
@@ -233,12 +741,16 @@ func (f *FakeMultireturner) AssertNamedReturnNotCalled(t MultireturnerTestingT)
 
 // NamedReturnCalledOnce returns true if FakeMultireturner.NamedReturn was called exactly once
 func (f *FakeMultireturner) NamedReturnCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedReturnCalls) == 1
 }
 
 // AssertNamedReturnCalledOnce calls t.Error if FakeMultireturner.NamedReturn was not called exactly once
 func (f *FakeMultireturner) AssertNamedReturnCalledOnce(t MultireturnerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedReturnCalls) != 1 {
 		t.Errorf("FakeMultireturner.NamedReturn called %d times, expected 1", len(f.NamedReturnCalls))
 	}
@@ -246,13 +758,39 @@ func (f *FakeMultireturner) AssertNamedReturnCalledOnce(t MultireturnerTestingT)
 
 // NamedReturnCalledN returns true if FakeMultireturner.NamedReturn was called at least n times
 func (f *FakeMultireturner) NamedReturnCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedReturnCalls) >= n
 }
 
 // AssertNamedReturnCalledN calls t.Error if FakeMultireturner.NamedReturn was called less than n times
 func (f *FakeMultireturner) AssertNamedReturnCalledN(t MultireturnerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedReturnCalls) < n {
 		t.Errorf("FakeMultireturner.NamedReturn called %d times, expected >= %d", len(f.NamedReturnCalls), n)
 	}
 }
+
+// WaitForNamedReturnCalled blocks until FakeMultireturner.NamedReturn has been called, returning the error of ctx if it is done first
+func (f *FakeMultireturner) WaitForNamedReturnCalled(ctx context.Context) error {
+	return f.WaitForNamedReturnCalledN(ctx, 1)
+}
+
+// WaitForNamedReturnCalledN blocks until FakeMultireturner.NamedReturn has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeMultireturner) WaitForNamedReturnCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.NamedReturnCalls) >= n
+	})
+}
+
+// AssertNamedReturnEventuallyCalled calls t.Error if FakeMultireturner.NamedReturn is not called within the timeout
+func (f *FakeMultireturner) AssertNamedReturnEventuallyCalled(t MultireturnerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForNamedReturnCalled(ctx) != nil {
+		t.Errorf("FakeMultireturner.NamedReturn not called within %v", timeout)
+	}
+}
@@ -2,7 +2,16 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // NamedvaluerManyNamedInvocation represents a single call of FakeNamedvaluer.ManyNamed
 type NamedvaluerManyNamedInvocation struct {
@@ -12,18 +21,35 @@ type NamedvaluerManyNamedInvocation struct {
 		F int
 		G int
 	}
-	Results struct {
-		Ret bool
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetManyNamedInvocation
+	Matchers struct {
+		A NamedvaluerMatcher[string]
+		B NamedvaluerMatcher[string]
+		F NamedvaluerMatcher[int]
+		G NamedvaluerMatcher[int]
 	}
+	Results NamedvaluerManyNamedResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *NamedvaluerManyNamedInvocation) String() string {
+	return fmt.Sprintf("FakeNamedvaluer.ManyNamed%+v", i.Parameters)
+}
+
+// NamedvaluerManyNamedResults holds the results of a single call of FakeNamedvaluer.ManyNamed
+type NamedvaluerManyNamedResults struct {
+	Ret bool
 }
 
 // NewNamedvaluerManyNamedInvocation creates a new instance of NamedvaluerManyNamedInvocation
-func NewNamedvaluerManyNamedInvocation(a string, b string, f int, g int, ret bool) *NamedvaluerManyNamedInvocation {
+func NewNamedvaluerManyNamedInvocation(a string, b string, f2 int, g int, ret bool) *NamedvaluerManyNamedInvocation {
 	invocation := new(NamedvaluerManyNamedInvocation)
 
 	invocation.Parameters.A = a
 	invocation.Parameters.B = b
-	invocation.Parameters.F = f
+	invocation.Parameters.F = f2
 	invocation.Parameters.G = g
 
 	invocation.Results.Ret = ret
@@ -31,15 +57,79 @@ func NewNamedvaluerManyNamedInvocation(a string, b string, f int, g int, ret boo
 	return invocation
 }
 
+// NamedvaluerManyNamedExpectation is a call of FakeNamedvaluer.ManyNamed expected by a test, created by FakeNamedvaluer.ExpectManyNamed
+type NamedvaluerManyNamedExpectation struct {
+	fake     *FakeNamedvaluer
+	t        NamedvaluerTestingT
+	times    int
+	count    int
+	matchers struct {
+		A NamedvaluerMatcher[string]
+		B NamedvaluerMatcher[string]
+		F NamedvaluerMatcher[int]
+		G NamedvaluerMatcher[int]
+	}
+	returns bool
+	results NamedvaluerManyNamedResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *NamedvaluerManyNamedExpectation) With(a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) *NamedvaluerManyNamedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+	e.matchers.B = b
+	e.matchers.F = f2
+	e.matchers.G = g
+
+	return e
+}
+
+func (e *NamedvaluerManyNamedExpectation) matches(a string, b string, f2 int, g int) bool {
+	return fake.Matches(e.matchers.A, a) && fake.Matches(e.matchers.B, b) && fake.Matches(e.matchers.F, f2) && fake.Matches(e.matchers.G, g)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *NamedvaluerManyNamedExpectation) Times(n int) *NamedvaluerManyNamedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *NamedvaluerManyNamedExpectation) Return(ret bool) *NamedvaluerManyNamedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = NamedvaluerManyNamedResults{Ret: ret}
+	return e
+}
+
 // NamedvaluerNamedInvocation represents a single call of FakeNamedvaluer.Named
 type NamedvaluerNamedInvocation struct {
 	Parameters struct {
 		A int
 		B string
 	}
-	Results struct {
-		Ret bool
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetNamedInvocation
+	Matchers struct {
+		A NamedvaluerMatcher[int]
+		B NamedvaluerMatcher[string]
 	}
+	Results NamedvaluerNamedResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *NamedvaluerNamedInvocation) String() string {
+	return fmt.Sprintf("FakeNamedvaluer.Named%+v", i.Parameters)
+}
+
+// NamedvaluerNamedResults holds the results of a single call of FakeNamedvaluer.Named
+type NamedvaluerNamedResults struct {
+	Ret bool
 }
 
 // NewNamedvaluerNamedInvocation creates a new instance of NamedvaluerNamedInvocation
@@ -54,14 +144,122 @@ func NewNamedvaluerNamedInvocation(a int, b string, ret bool) *NamedvaluerNamedI
 	return invocation
 }
 
+// NamedvaluerNamedExpectation is a call of FakeNamedvaluer.Named expected by a test, created by FakeNamedvaluer.ExpectNamed
+type NamedvaluerNamedExpectation struct {
+	fake     *FakeNamedvaluer
+	t        NamedvaluerTestingT
+	times    int
+	count    int
+	matchers struct {
+		A NamedvaluerMatcher[int]
+		B NamedvaluerMatcher[string]
+	}
+	returns bool
+	results NamedvaluerNamedResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *NamedvaluerNamedExpectation) With(a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) *NamedvaluerNamedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+	e.matchers.B = b
+
+	return e
+}
+
+func (e *NamedvaluerNamedExpectation) matches(a int, b string) bool {
+	return fake.Matches(e.matchers.A, a) && fake.Matches(e.matchers.B, b)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *NamedvaluerNamedExpectation) Times(n int) *NamedvaluerNamedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *NamedvaluerNamedExpectation) Return(ret bool) *NamedvaluerNamedExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = NamedvaluerNamedResults{Ret: ret}
+	return e
+}
+
 // NamedvaluerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type NamedvaluerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type NamedvaluerTestingT = fake.TestingT
+
+// NamedvaluerMatcher matches a parameter of a call to FakeNamedvaluer
+type NamedvaluerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// NamedvaluerMatcherFunc is a NamedvaluerMatcher implemented by a predicate function
+type NamedvaluerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m NamedvaluerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// NamedvaluerAny returns a NamedvaluerMatcher that matches any value
+func NamedvaluerAny[T any]() NamedvaluerMatcher[T] {
+	return fake.Any[T]()
+}
+
+// NamedvaluerEq returns a NamedvaluerMatcher that matches values deeply equal to want
+func NamedvaluerEq[T any](want T) NamedvaluerMatcher[T] {
+	return fake.Eq(want)
+}
+
+// NamedvaluerNot returns a NamedvaluerMatcher that matches the values the given matcher does not
+func NamedvaluerNot[T any](m NamedvaluerMatcher[T]) NamedvaluerMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// NamedvaluerPred returns a NamedvaluerMatcher that matches values for which the given predicate returns true
+func NamedvaluerPred[T any](pred func(T) bool) NamedvaluerMatcher[T] {
+	return fake.Pred(pred)
 }
 
+// NamedvaluerAnyOfType returns a NamedvaluerMatcher that matches values whose dynamic type is, or implements, U
+func NamedvaluerAnyOfType[T, U any]() NamedvaluerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// NamedvaluerCallMatcher selects calls of a method of a fake for NamedvaluerInOrder and NamedvaluerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeNamedvaluer
+type NamedvaluerCallMatcher = fake.CallMatcher
+
+// NamedvaluerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func NamedvaluerInOrder(t NamedvaluerTestingT, calls ...NamedvaluerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// NamedvaluerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func NamedvaluerUnordered(t NamedvaluerTestingT, calls ...NamedvaluerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// NamedvaluerExhausted selects how a method of FakeNamedvaluer behaves once the results given to its SetXReturnsSequence method are used up
+type NamedvaluerExhausted = fake.Exhausted
+
+const (
+	// NamedvaluerRepeatLast returns the last results in the sequence from all later calls
+	NamedvaluerRepeatLast = fake.RepeatLast
+	// NamedvaluerPanicAfterSequence panics on all later calls
+	NamedvaluerPanicAfterSequence = fake.PanicAfterSequence
+	// NamedvaluerHookAfterSequence calls the method's hook on all later calls
+	NamedvaluerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeNamedvaluer is a mock implementation of Namedvaluer for testing.
 Use it in your tests as in this example:
@@ -70,7 +268,7 @@ Use it in your tests as in this example:
 
 	func TestWithNamedvaluer(t *testing.T) {
 		f := &main.FakeNamedvaluer{
-			ManyNamedHook: func(a string, b string, f int, g int) (ret bool) {
+			ManyNamedHook: func(a string, b string, f2 int, g int) (ret bool) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -78,13 +276,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeManyNamed ...
+		// assert state of FakeNamedvaluer ...
 		f.AssertManyNamedCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeManyNamed.
+unexpected calls are made to FakeNamedvaluer.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeNamedvaluer struct {
 	ManyNamedHook func(string, string, int, int) bool
@@ -92,6 +294,14 @@ type FakeNamedvaluer struct {
 
 	ManyNamedCalls []*NamedvaluerManyNamedInvocation
 	NamedCalls     []*NamedvaluerNamedInvocation
+
+	returnsManyNamed      fake.Returns[NamedvaluerManyNamedResults]
+	returnsNamed          fake.Returns[NamedvaluerNamedResults]
+	expectationsManyNamed []*NamedvaluerManyNamedExpectation
+	expectationsNamed     []*NamedvaluerNamedExpectation
+	mutex                 sync.Mutex
+	recorded              chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters        bool
 }
 
 // NewFakeNamedvaluerDefaultPanic returns an instance of FakeNamedvaluer with all hooks configured to panic
@@ -107,89 +317,293 @@ func NewFakeNamedvaluerDefaultPanic() *FakeNamedvaluer {
 }
 
 // NewFakeNamedvaluerDefaultFatal returns an instance of FakeNamedvaluer with all hooks configured to call t.Fatal
-func NewFakeNamedvaluerDefaultFatal(t_sym1 NamedvaluerTestingT) *FakeNamedvaluer {
+func NewFakeNamedvaluerDefaultFatal(t NamedvaluerTestingT) *FakeNamedvaluer {
 	return &FakeNamedvaluer{
 		ManyNamedHook: func(string, string, int, int) (ret bool) {
-			t_sym1.Fatal("Unexpected call to Namedvaluer.ManyNamed")
+			t.Fatal("Unexpected call to Namedvaluer.ManyNamed")
 			return
 		},
 		NamedHook: func(int, string) (ret bool) {
-			t_sym1.Fatal("Unexpected call to Namedvaluer.Named")
+			t.Fatal("Unexpected call to Namedvaluer.Named")
 			return
 		},
 	}
 }
 
 // NewFakeNamedvaluerDefaultError returns an instance of FakeNamedvaluer with all hooks configured to call t.Error
-func NewFakeNamedvaluerDefaultError(t_sym2 NamedvaluerTestingT) *FakeNamedvaluer {
+func NewFakeNamedvaluerDefaultError(t NamedvaluerTestingT) *FakeNamedvaluer {
 	return &FakeNamedvaluer{
 		ManyNamedHook: func(string, string, int, int) (ret bool) {
-			t_sym2.Error("Unexpected call to Namedvaluer.ManyNamed")
+			t.Error("Unexpected call to Namedvaluer.ManyNamed")
 			return
 		},
 		NamedHook: func(int, string) (ret bool) {
-			t_sym2.Error("Unexpected call to Namedvaluer.Named")
+			t.Error("Unexpected call to Namedvaluer.Named")
 			return
 		},
 	}
 }
 
+// NewFakeNamedvaluerDefaultZero returns an instance of FakeNamedvaluer with all hooks configured to return zero values
+func NewFakeNamedvaluerDefaultZero() *FakeNamedvaluer {
+	return &FakeNamedvaluer{
+		ManyNamedHook: func(string, string, int, int) (ret bool) {
+			return
+		},
+		NamedHook: func(int, string) (ret bool) {
+			return
+		},
+	}
+}
+
+// NewFakeNamedvaluerDefaultFriendly returns an instance of FakeNamedvaluer with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeNamedvaluer are friendly fakes, one for each method and result, created on first use
+func NewFakeNamedvaluerDefaultFriendly() *FakeNamedvaluer {
+
+	return &FakeNamedvaluer{
+		ManyNamedHook: func(string, string, int, int) (ret bool) {
+			return
+		},
+		NamedHook: func(int, string) (ret bool) {
+			return
+		},
+	}
+}
+
+// NewFakeNamedvaluerSpy returns an instance of FakeNamedvaluer with all hooks configured to call the given implementation
+func NewFakeNamedvaluerSpy(real Namedvaluer) *FakeNamedvaluer {
+	return &FakeNamedvaluer{
+		ManyNamedHook: real.ManyNamed,
+		NamedHook:     real.Named,
+	}
+}
+
+// Reset forgets all calls made to FakeNamedvaluer
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeNamedvaluer) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsManyNamed.Rebase(len(f.ManyNamedCalls))
 	f.ManyNamedCalls = []*NamedvaluerManyNamedInvocation{}
+	f.returnsNamed.Rebase(len(f.NamedCalls))
 	f.NamedCalls = []*NamedvaluerNamedInvocation{}
 }
 
-func (f_sym3 *FakeNamedvaluer) ManyNamed(a string, b string, f int, g int) (ret bool) {
-	if f_sym3.ManyNamedHook == nil {
+// SetCopyParameters configures FakeNamedvaluer to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeNamedvaluer) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeNamedvaluer) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeNamedvaluer) ManyNamed(a string, b string, f2 int, g int) (ret bool) {
+	f.mutex.Lock()
+	hook := f.ManyNamedHook
+	expectation, t := f.expectedManyNamed(a, b, f2, g)
+	var results NamedvaluerManyNamedResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsManyNamed.Lookup(len(f.ManyNamedCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Namedvaluer.ManyNamed() called after the results given to FakeNamedvaluer.SetManyNamedReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Namedvaluer.ManyNamed() called but FakeNamedvaluer.ManyNamedHook is nil")
 	}
 
-	invocation_sym3 := new(NamedvaluerManyNamedInvocation)
-	f_sym3.ManyNamedCalls = append(f_sym3.ManyNamedCalls, invocation_sym3)
+	invocation := new(NamedvaluerManyNamedInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ManyNamedCalls = append(f.ManyNamedCalls, invocation)
 
-	invocation_sym3.Parameters.A = a
-	invocation_sym3.Parameters.B = b
-	invocation_sym3.Parameters.F = f
-	invocation_sym3.Parameters.G = g
+	invocation.Parameters.A = a
+	invocation.Parameters.B = b
+	invocation.Parameters.F = f2
+	invocation.Parameters.G = g
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+		invocation.Parameters.B = fake.CopyParameter(invocation.Parameters.B, seen)
+		invocation.Parameters.F = fake.CopyParameter(invocation.Parameters.F, seen)
+		invocation.Parameters.G = fake.CopyParameter(invocation.Parameters.G, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	ret = f_sym3.ManyNamedHook(a, b, f, g)
+	if t != nil && expectation == nil {
+		t.Errorf("FakeNamedvaluer.ManyNamed called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	invocation_sym3.Results.Ret = ret
+	if found {
+		ret = results.Ret
+	} else if hook != nil {
+		ret = hook(a, b, f2, g)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ret = ret
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedManyNamed returns the first unsatisfied expectation of FakeNamedvaluer.ManyNamed matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeNamedvaluer) expectedManyNamed(a string, b string, f2 int, g int) (*NamedvaluerManyNamedExpectation, NamedvaluerTestingT) {
+	if len(f.expectationsManyNamed) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsManyNamed {
+		if expectation.count < expectation.times && expectation.matches(a, b, f2, g) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsManyNamed[0].t
+}
+
+// ExpectManyNamed expects calls of FakeNamedvaluer.ManyNamed, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeNamedvaluer) ExpectManyNamed(t NamedvaluerTestingT) *NamedvaluerManyNamedExpectation {
+	t.Helper()
+	expectation := &NamedvaluerManyNamedExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsManyNamed = append(f.expectationsManyNamed, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeNamedvaluer.ManyNamed called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetManyNamedHook configures Namedvaluer.ManyNamed to call the given function
+func (f *FakeNamedvaluer) SetManyNamedHook(hook func(string, string, int, int) bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ManyNamedHook = hook
+}
+
 // SetManyNamedStub configures Namedvaluer.ManyNamed to always return the given values
-func (f_sym4 *FakeNamedvaluer) SetManyNamedStub(ret bool) {
-	f_sym4.ManyNamedHook = func(string, string, int, int) bool {
+func (f *FakeNamedvaluer) SetManyNamedStub(ret bool) {
+	f.SetManyNamedHook(func(string, string, int, int) bool {
 		return ret
-	}
+	})
+}
+
+// SetManyNamedReturnsOnCall configures Namedvaluer.ManyNamed to return the given values from the call with the given index in ManyNamedCalls, rather than calling the hook
+func (f *FakeNamedvaluer) SetManyNamedReturnsOnCall(call int, ret bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsManyNamed.Set(call, NamedvaluerManyNamedResults{Ret: ret})
+}
+
+// SetManyNamedReturnsSequence configures the following calls of Namedvaluer.ManyNamed to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeNamedvaluer) SetManyNamedReturnsSequence(exhausted NamedvaluerExhausted, results ...NamedvaluerManyNamedResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsManyNamed.Sequence(len(f.ManyNamedCalls), exhausted, results)
 }
 
 // SetManyNamedInvocation configures Namedvaluer.ManyNamed to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeNamedvaluer) SetManyNamedInvocation(calls_sym5 []*NamedvaluerManyNamedInvocation, fallback_sym5 func() bool) {
-	f_sym5.ManyNamedHook = func(a string, b string, f int, g int) (ret bool) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.A, a) && reflect.DeepEqual(call_sym5.Parameters.B, b) && reflect.DeepEqual(call_sym5.Parameters.F, f) && reflect.DeepEqual(call_sym5.Parameters.G, g) {
-				ret = call_sym5.Results.Ret
+func (f *FakeNamedvaluer) SetManyNamedInvocation(calls []*NamedvaluerManyNamedInvocation, fallback func() bool) {
+	f.SetManyNamedHook(func(a string, b string, f2 int, g int) (ret bool) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.A, call.Parameters.A, a) && fake.MatchParameter(call.Matchers.B, call.Parameters.B, b) && fake.MatchParameter(call.Matchers.F, call.Parameters.F, f2) && fake.MatchParameter(call.Matchers.G, call.Parameters.G, g) {
+				ret = call.Results.Ret
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// ManyNamedCallsSnapshot returns a copy of the calls made to FakeNamedvaluer.ManyNamed
+func (f *FakeNamedvaluer) ManyNamedCallsSnapshot() []*NamedvaluerManyNamedInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*NamedvaluerManyNamedInvocation, len(f.ManyNamedCalls))
+	for i, call := range f.ManyNamedCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// ManyNamedCall returns a NamedvaluerCallMatcher selecting the calls of FakeNamedvaluer.ManyNamed with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) ManyNamedCall(a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) NamedvaluerCallMatcher {
+	return fake.NewCallMatcher("FakeNamedvaluer.ManyNamed(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*NamedvaluerManyNamedInvocation(nil), f.ManyNamedCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // ManyNamedCalled returns true if FakeNamedvaluer.ManyNamed was called
 func (f *FakeNamedvaluer) ManyNamedCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ManyNamedCalls) != 0
 }
 
 // AssertManyNamedCalled calls t.Error if FakeNamedvaluer.ManyNamed was not called
 func (f *FakeNamedvaluer) AssertManyNamedCalled(t NamedvaluerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ManyNamedCalls) == 0 {
 		t.Error("FakeNamedvaluer.ManyNamed not called, expected at least one")
 	}
@@ -197,12 +611,16 @@ func (f *FakeNamedvaluer) AssertManyNamedCalled(t NamedvaluerTestingT) {
 
 // ManyNamedNotCalled returns true if FakeNamedvaluer.ManyNamed was not called
 func (f *FakeNamedvaluer) ManyNamedNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ManyNamedCalls) == 0
 }
 
 // AssertManyNamedNotCalled calls t.Error if FakeNamedvaluer.ManyNamed was called
 func (f *FakeNamedvaluer) AssertManyNamedNotCalled(t NamedvaluerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ManyNamedCalls) != 0 {
 		t.Error("FakeNamedvaluer.ManyNamed called, expected none")
 	}
@@ -210,12 +628,16 @@ func (f *FakeNamedvaluer) AssertManyNamedNotCalled(t NamedvaluerTestingT) {
 
 // ManyNamedCalledOnce returns true if FakeNamedvaluer.ManyNamed was called exactly once
 func (f *FakeNamedvaluer) ManyNamedCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ManyNamedCalls) == 1
 }
 
 // AssertManyNamedCalledOnce calls t.Error if FakeNamedvaluer.ManyNamed was not called exactly once
 func (f *FakeNamedvaluer) AssertManyNamedCalledOnce(t NamedvaluerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ManyNamedCalls) != 1 {
 		t.Errorf("FakeNamedvaluer.ManyNamed called %d times, expected 1", len(f.ManyNamedCalls))
 	}
@@ -223,21 +645,113 @@ func (f *FakeNamedvaluer) AssertManyNamedCalledOnce(t NamedvaluerTestingT) {
 
 // ManyNamedCalledN returns true if FakeNamedvaluer.ManyNamed was called at least n times
 func (f *FakeNamedvaluer) ManyNamedCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.ManyNamedCalls) >= n
 }
 
 // AssertManyNamedCalledN calls t.Error if FakeNamedvaluer.ManyNamed was called less than n times
 func (f *FakeNamedvaluer) AssertManyNamedCalledN(t NamedvaluerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.ManyNamedCalls) < n {
 		t.Errorf("FakeNamedvaluer.ManyNamed called %d times, expected >= %d", len(f.ManyNamedCalls), n)
 	}
 }
 
-// ManyNamedCalledWith returns true if FakeNamedvaluer.ManyNamed was called with the given values
-func (f_sym6 *FakeNamedvaluer) ManyNamedCalledWith(a string, b string, f int, g int) bool {
-	for _, call_sym6 := range f_sym6.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.A, a) && reflect.DeepEqual(call_sym6.Parameters.B, b) && reflect.DeepEqual(call_sym6.Parameters.F, f) && reflect.DeepEqual(call_sym6.Parameters.G, g) {
+// WaitForManyNamedCalled blocks until FakeNamedvaluer.ManyNamed has been called, returning the error of ctx if it is done first
+func (f *FakeNamedvaluer) WaitForManyNamedCalled(ctx context.Context) error {
+	return f.WaitForManyNamedCalledN(ctx, 1)
+}
+
+// WaitForManyNamedCalledN blocks until FakeNamedvaluer.ManyNamed has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeNamedvaluer) WaitForManyNamedCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ManyNamedCalls) >= n
+	})
+}
+
+// AssertManyNamedEventuallyCalled calls t.Error if FakeNamedvaluer.ManyNamed is not called within the timeout
+func (f *FakeNamedvaluer) AssertManyNamedEventuallyCalled(t NamedvaluerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForManyNamedCalled(ctx) != nil {
+		t.Errorf("FakeNamedvaluer.ManyNamed not called within %v", timeout)
+	}
+}
+
+// describeManyNamedCalls describes the calls of FakeNamedvaluer.ManyNamed against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeNamedvaluer) describeManyNamedCalls(a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) string {
+	var b2 strings.Builder
+	b2.WriteString("expected:")
+	fmt.Fprintf(&b2, "\n\tA: %s", fake.Describe(a))
+	fmt.Fprintf(&b2, "\n\tB: %s", fake.Describe(b))
+	fmt.Fprintf(&b2, "\n\tF: %s", fake.Describe(f2))
+	fmt.Fprintf(&b2, "\n\tG: %s", fake.Describe(g))
+
+	if len(f.ManyNamedCalls) == 0 {
+		b2.WriteString("\nrecorded calls: none")
+		return b2.String()
+	}
+
+	b2.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ManyNamedCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+		if fake.Matches(b, call.Parameters.B) {
+			matched++
+		}
+		if fake.Matches(f2, call.Parameters.F) {
+			matched++
+		}
+		if fake.Matches(g, call.Parameters.G) {
+			matched++
+		}
+
+		if matched == 4 {
+			found = true
+			fmt.Fprintf(&b2, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b2, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b2.String()
+	}
+
+	call := f.ManyNamedCalls[closest]
+	fmt.Fprintf(&b2, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b2, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+	if !fake.Matches(b, call.Parameters.B) {
+		fmt.Fprintf(&b2, "\n\tB: got %s, want %s", fake.Format(call.Parameters.B), fake.Describe(b))
+	}
+	if !fake.Matches(f2, call.Parameters.F) {
+		fmt.Fprintf(&b2, "\n\tF: got %s, want %s", fake.Format(call.Parameters.F), fake.Describe(f2))
+	}
+	if !fake.Matches(g, call.Parameters.G) {
+		fmt.Fprintf(&b2, "\n\tG: got %s, want %s", fake.Format(call.Parameters.G), fake.Describe(g))
+	}
+
+	return b2.String()
+}
+
+// ManyNamedCalledWith returns true if FakeNamedvaluer.ManyNamed was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) ManyNamedCalledWith(a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ManyNamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
 			return true
 		}
 	}
@@ -245,55 +759,86 @@ func (f_sym6 *FakeNamedvaluer) ManyNamedCalledWith(a string, b string, f int, g
 	return false
 }
 
-// AssertManyNamedCalledWith calls t.Error if FakeNamedvaluer.ManyNamed was not called with the given values
-func (f_sym7 *FakeNamedvaluer) AssertManyNamedCalledWith(t NamedvaluerTestingT, a string, b string, f int, g int) {
+// AssertManyNamedCalledWith calls t.Error if FakeNamedvaluer.ManyNamed was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) AssertManyNamedCalledWith(t NamedvaluerTestingT, a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.A, a) && reflect.DeepEqual(call_sym7.Parameters.B, b) && reflect.DeepEqual(call_sym7.Parameters.F, f) && reflect.DeepEqual(call_sym7.Parameters.G, g) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ManyNamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeNamedvaluer.ManyNamed not called with expected parameters")
+	if !found {
+		t.Errorf("FakeNamedvaluer.ManyNamed not called with expected parameters\n%s", f.describeManyNamedCalls(a, b, f2, g))
 	}
 }
 
-// ManyNamedCalledOnceWith returns true if FakeNamedvaluer.ManyNamed was called exactly once with the given values
-func (f_sym8 *FakeNamedvaluer) ManyNamedCalledOnceWith(a string, b string, f int, g int) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.A, a) && reflect.DeepEqual(call_sym8.Parameters.B, b) && reflect.DeepEqual(call_sym8.Parameters.F, f) && reflect.DeepEqual(call_sym8.Parameters.G, g) {
-			count_sym8++
+// ManyNamedCalledOnceWith returns true if FakeNamedvaluer.ManyNamed was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) ManyNamedCalledOnceWith(a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ManyNamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertManyNamedCalledOnceWith calls t.Error if FakeNamedvaluer.ManyNamed was not called exactly once with the given values
-func (f_sym9 *FakeNamedvaluer) AssertManyNamedCalledOnceWith(t NamedvaluerTestingT, a string, b string, f int, g int) {
+// AssertManyNamedCalledOnceWith calls t.Error if FakeNamedvaluer.ManyNamed was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) AssertManyNamedCalledOnceWith(t NamedvaluerTestingT, a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.A, a) && reflect.DeepEqual(call_sym9.Parameters.B, b) && reflect.DeepEqual(call_sym9.Parameters.F, f) && reflect.DeepEqual(call_sym9.Parameters.G, g) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ManyNamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeNamedvaluer.ManyNamed called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakeNamedvaluer.ManyNamed called %d times with expected parameters, expected one\n%s", count, f.describeManyNamedCalls(a, b, f2, g))
 	}
 }
 
-// ManyNamedResultsForCall returns the result values for the first call to FakeNamedvaluer.ManyNamed with the given values
-func (f_sym10 *FakeNamedvaluer) ManyNamedResultsForCall(a string, b string, f int, g int) (ret bool, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.ManyNamedCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.A, a) && reflect.DeepEqual(call_sym10.Parameters.B, b) && reflect.DeepEqual(call_sym10.Parameters.F, f) && reflect.DeepEqual(call_sym10.Parameters.G, g) {
-			ret = call_sym10.Results.Ret
-			found_sym10 = true
+// AssertManyNamedEventuallyCalledWith calls t.Error if FakeNamedvaluer.ManyNamed is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeNamedvaluer) AssertManyNamedEventuallyCalledWith(t NamedvaluerTestingT, timeout time.Duration, a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ManyNamedCalls)
+		for _, call := range f.ManyNamedCalls {
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeNamedvaluer.ManyNamed not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeManyNamedCalls(a, b, f2, g))
+	}
+}
+
+// ManyNamedResultsForCall returns the result values for the first call to FakeNamedvaluer.ManyNamed with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) ManyNamedResultsForCall(a NamedvaluerMatcher[string], b NamedvaluerMatcher[string], f2 NamedvaluerMatcher[int], g NamedvaluerMatcher[int]) (ret bool, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ManyNamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(f2, call.Parameters.F) && fake.Matches(g, call.Parameters.G) {
+			ret = call.Results.Ret
+			found = true
 			break
 		}
 	}
@@ -301,55 +846,187 @@ func (f_sym10 *FakeNamedvaluer) ManyNamedResultsForCall(a string, b string, f in
 	return
 }
 
-func (f_sym11 *FakeNamedvaluer) Named(a int, b string) (ret bool) {
-	if f_sym11.NamedHook == nil {
+func (f *FakeNamedvaluer) Named(a int, b string) (ret bool) {
+	f.mutex.Lock()
+	hook := f.NamedHook
+	expectation, t := f.expectedNamed(a, b)
+	var results NamedvaluerNamedResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsNamed.Lookup(len(f.NamedCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Namedvaluer.Named() called after the results given to FakeNamedvaluer.SetNamedReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Namedvaluer.Named() called but FakeNamedvaluer.NamedHook is nil")
 	}
 
-	invocation_sym11 := new(NamedvaluerNamedInvocation)
-	f_sym11.NamedCalls = append(f_sym11.NamedCalls, invocation_sym11)
+	invocation := new(NamedvaluerNamedInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.NamedCalls = append(f.NamedCalls, invocation)
+
+	invocation.Parameters.A = a
+	invocation.Parameters.B = b
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+		invocation.Parameters.B = fake.CopyParameter(invocation.Parameters.B, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym11.Parameters.A = a
-	invocation_sym11.Parameters.B = b
+	if t != nil && expectation == nil {
+		t.Errorf("FakeNamedvaluer.Named called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ret = f_sym11.NamedHook(a, b)
+	if found {
+		ret = results.Ret
+	} else if hook != nil {
+		ret = hook(a, b)
+	}
 
-	invocation_sym11.Results.Ret = ret
+	f.mutex.Lock()
+	invocation.Results.Ret = ret
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedNamed returns the first unsatisfied expectation of FakeNamedvaluer.Named matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeNamedvaluer) expectedNamed(a int, b string) (*NamedvaluerNamedExpectation, NamedvaluerTestingT) {
+	if len(f.expectationsNamed) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsNamed {
+		if expectation.count < expectation.times && expectation.matches(a, b) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsNamed[0].t
+}
+
+// ExpectNamed expects calls of FakeNamedvaluer.Named, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeNamedvaluer) ExpectNamed(t NamedvaluerTestingT) *NamedvaluerNamedExpectation {
+	t.Helper()
+	expectation := &NamedvaluerNamedExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsNamed = append(f.expectationsNamed, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeNamedvaluer.Named called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetNamedHook configures Namedvaluer.Named to call the given function
+func (f *FakeNamedvaluer) SetNamedHook(hook func(int, string) bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.NamedHook = hook
+}
+
 // SetNamedStub configures Namedvaluer.Named to always return the given values
-func (f_sym12 *FakeNamedvaluer) SetNamedStub(ret bool) {
-	f_sym12.NamedHook = func(int, string) bool {
+func (f *FakeNamedvaluer) SetNamedStub(ret bool) {
+	f.SetNamedHook(func(int, string) bool {
 		return ret
-	}
+	})
+}
+
+// SetNamedReturnsOnCall configures Namedvaluer.Named to return the given values from the call with the given index in NamedCalls, rather than calling the hook
+func (f *FakeNamedvaluer) SetNamedReturnsOnCall(call int, ret bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamed.Set(call, NamedvaluerNamedResults{Ret: ret})
+}
+
+// SetNamedReturnsSequence configures the following calls of Namedvaluer.Named to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeNamedvaluer) SetNamedReturnsSequence(exhausted NamedvaluerExhausted, results ...NamedvaluerNamedResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamed.Sequence(len(f.NamedCalls), exhausted, results)
 }
 
 // SetNamedInvocation configures Namedvaluer.Named to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeNamedvaluer) SetNamedInvocation(calls_sym13 []*NamedvaluerNamedInvocation, fallback_sym13 func() bool) {
-	f_sym13.NamedHook = func(a int, b string) (ret bool) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) && reflect.DeepEqual(call_sym13.Parameters.B, b) {
-				ret = call_sym13.Results.Ret
+func (f *FakeNamedvaluer) SetNamedInvocation(calls []*NamedvaluerNamedInvocation, fallback func() bool) {
+	f.SetNamedHook(func(a int, b string) (ret bool) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.A, call.Parameters.A, a) && fake.MatchParameter(call.Matchers.B, call.Parameters.B, b) {
+				ret = call.Results.Ret
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback()
+	})
+}
+
+// NamedCallsSnapshot returns a copy of the calls made to FakeNamedvaluer.Named
+func (f *FakeNamedvaluer) NamedCallsSnapshot() []*NamedvaluerNamedInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*NamedvaluerNamedInvocation, len(f.NamedCalls))
+	for i, call := range f.NamedCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// NamedCall returns a NamedvaluerCallMatcher selecting the calls of FakeNamedvaluer.Named with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) NamedCall(a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) NamedvaluerCallMatcher {
+	return fake.NewCallMatcher("FakeNamedvaluer.Named(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*NamedvaluerNamedInvocation(nil), f.NamedCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // NamedCalled returns true if FakeNamedvaluer.Named was called
 func (f *FakeNamedvaluer) NamedCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedCalls) != 0
 }
 
 // AssertNamedCalled calls t.Error if FakeNamedvaluer.Named was not called
 func (f *FakeNamedvaluer) AssertNamedCalled(t NamedvaluerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedCalls) == 0 {
 		t.Error("FakeNamedvaluer.Named not called, expected at least one")
 	}
@@ -357,12 +1034,16 @@ func (f *FakeNamedvaluer) AssertNamedCalled(t NamedvaluerTestingT) {
 
 // NamedNotCalled returns true if FakeNamedvaluer.Named was not called
 func (f *FakeNamedvaluer) NamedNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedCalls) == 0
 }
 
 // AssertNamedNotCalled calls t.Error if FakeNamedvaluer.Named was called
 func (f *FakeNamedvaluer) AssertNamedNotCalled(t NamedvaluerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedCalls) != 0 {
 		t.Error("FakeNamedvaluer.Named called, expected none")
 	}
@@ -370,12 +1051,16 @@ func (f *FakeNamedvaluer) AssertNamedNotCalled(t NamedvaluerTestingT) {
 
 // NamedCalledOnce returns true if FakeNamedvaluer.Named was called exactly once
 func (f *FakeNamedvaluer) NamedCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedCalls) == 1
 }
 
 // AssertNamedCalledOnce calls t.Error if FakeNamedvaluer.Named was not called exactly once
 func (f *FakeNamedvaluer) AssertNamedCalledOnce(t NamedvaluerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedCalls) != 1 {
 		t.Errorf("FakeNamedvaluer.Named called %d times, expected 1", len(f.NamedCalls))
 	}
@@ -383,21 +1068,99 @@ func (f *FakeNamedvaluer) AssertNamedCalledOnce(t NamedvaluerTestingT) {
 
 // NamedCalledN returns true if FakeNamedvaluer.Named was called at least n times
 func (f *FakeNamedvaluer) NamedCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedCalls) >= n
 }
 
 // AssertNamedCalledN calls t.Error if FakeNamedvaluer.Named was called less than n times
 func (f *FakeNamedvaluer) AssertNamedCalledN(t NamedvaluerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedCalls) < n {
 		t.Errorf("FakeNamedvaluer.Named called %d times, expected >= %d", len(f.NamedCalls), n)
 	}
 }
 
-// NamedCalledWith returns true if FakeNamedvaluer.Named was called with the given values
-func (f_sym14 *FakeNamedvaluer) NamedCalledWith(a int, b string) bool {
-	for _, call_sym14 := range f_sym14.NamedCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) && reflect.DeepEqual(call_sym14.Parameters.B, b) {
+// WaitForNamedCalled blocks until FakeNamedvaluer.Named has been called, returning the error of ctx if it is done first
+func (f *FakeNamedvaluer) WaitForNamedCalled(ctx context.Context) error {
+	return f.WaitForNamedCalledN(ctx, 1)
+}
+
+// WaitForNamedCalledN blocks until FakeNamedvaluer.Named has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeNamedvaluer) WaitForNamedCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.NamedCalls) >= n
+	})
+}
+
+// AssertNamedEventuallyCalled calls t.Error if FakeNamedvaluer.Named is not called within the timeout
+func (f *FakeNamedvaluer) AssertNamedEventuallyCalled(t NamedvaluerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForNamedCalled(ctx) != nil {
+		t.Errorf("FakeNamedvaluer.Named not called within %v", timeout)
+	}
+}
+
+// describeNamedCalls describes the calls of FakeNamedvaluer.Named against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeNamedvaluer) describeNamedCalls(a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) string {
+	var b2 strings.Builder
+	b2.WriteString("expected:")
+	fmt.Fprintf(&b2, "\n\tA: %s", fake.Describe(a))
+	fmt.Fprintf(&b2, "\n\tB: %s", fake.Describe(b))
+
+	if len(f.NamedCalls) == 0 {
+		b2.WriteString("\nrecorded calls: none")
+		return b2.String()
+	}
+
+	b2.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.NamedCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+		if fake.Matches(b, call.Parameters.B) {
+			matched++
+		}
+
+		if matched == 2 {
+			found = true
+			fmt.Fprintf(&b2, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b2, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b2.String()
+	}
+
+	call := f.NamedCalls[closest]
+	fmt.Fprintf(&b2, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b2, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+	if !fake.Matches(b, call.Parameters.B) {
+		fmt.Fprintf(&b2, "\n\tB: got %s, want %s", fake.Format(call.Parameters.B), fake.Describe(b))
+	}
+
+	return b2.String()
+}
+
+// NamedCalledWith returns true if FakeNamedvaluer.Named was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) NamedCalledWith(a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
 			return true
 		}
 	}
@@ -405,55 +1168,86 @@ func (f_sym14 *FakeNamedvaluer) NamedCalledWith(a int, b string) bool {
 	return false
 }
 
-// AssertNamedCalledWith calls t.Error if FakeNamedvaluer.Named was not called with the given values
-func (f_sym15 *FakeNamedvaluer) AssertNamedCalledWith(t NamedvaluerTestingT, a int, b string) {
+// AssertNamedCalledWith calls t.Error if FakeNamedvaluer.Named was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) AssertNamedCalledWith(t NamedvaluerTestingT, a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) && reflect.DeepEqual(call_sym15.Parameters.B, b) {
-			found_sym15 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.NamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym15 {
-		t.Error("FakeNamedvaluer.Named not called with expected parameters")
+	if !found {
+		t.Errorf("FakeNamedvaluer.Named not called with expected parameters\n%s", f.describeNamedCalls(a, b))
 	}
 }
 
-// NamedCalledOnceWith returns true if FakeNamedvaluer.Named was called exactly once with the given values
-func (f_sym16 *FakeNamedvaluer) NamedCalledOnceWith(a int, b string) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) && reflect.DeepEqual(call_sym16.Parameters.B, b) {
-			count_sym16++
+// NamedCalledOnceWith returns true if FakeNamedvaluer.Named was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) NamedCalledOnceWith(a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
+			count++
 		}
 	}
 
-	return count_sym16 == 1
+	return count == 1
 }
 
-// AssertNamedCalledOnceWith calls t.Error if FakeNamedvaluer.Named was not called exactly once with the given values
-func (f_sym17 *FakeNamedvaluer) AssertNamedCalledOnceWith(t NamedvaluerTestingT, a int, b string) {
+// AssertNamedCalledOnceWith calls t.Error if FakeNamedvaluer.Named was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) AssertNamedCalledOnceWith(t NamedvaluerTestingT, a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) && reflect.DeepEqual(call_sym17.Parameters.B, b) {
-			count_sym17++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
+			count++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeNamedvaluer.Named called %d times with expected parameters, expected one", count_sym17)
+	if count != 1 {
+		t.Errorf("FakeNamedvaluer.Named called %d times with expected parameters, expected one\n%s", count, f.describeNamedCalls(a, b))
+	}
+}
+
+// AssertNamedEventuallyCalledWith calls t.Error if FakeNamedvaluer.Named is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeNamedvaluer) AssertNamedEventuallyCalledWith(t NamedvaluerTestingT, timeout time.Duration, a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.NamedCalls)
+		for _, call := range f.NamedCalls {
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeNamedvaluer.Named not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeNamedCalls(a, b))
 	}
 }
 
-// NamedResultsForCall returns the result values for the first call to FakeNamedvaluer.Named with the given values
-func (f_sym18 *FakeNamedvaluer) NamedResultsForCall(a int, b string) (ret bool, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) && reflect.DeepEqual(call_sym18.Parameters.B, b) {
-			ret = call_sym18.Results.Ret
-			found_sym18 = true
+// NamedResultsForCall returns the result values for the first call to FakeNamedvaluer.Named with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeNamedvaluer) NamedResultsForCall(a NamedvaluerMatcher[int], b NamedvaluerMatcher[string]) (ret bool, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) {
+			ret = call.Results.Ret
+			found = true
 			break
 		}
 	}
@@ -0,0 +1,6 @@
+package main
+
+type Notifier interface {
+	Notify(topic string, n int) error
+	Close()
+}
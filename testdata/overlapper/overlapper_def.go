@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"io"
+	iofs "io/fs"
+)
+
+type Closer interface {
+	Close() error
+}
+
+type ReadCloser interface {
+	io.Reader
+	Closer
+}
+
+type Overlapper interface {
+	ReadCloser
+	io.ReadCloser
+	iofs.FS
+	error
+	Context() context.Context
+	Close() error
+}
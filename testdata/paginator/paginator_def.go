@@ -0,0 +1,24 @@
+package main
+
+import (
+	"iter"
+	"sync/atomic"
+)
+
+type User struct {
+	Name string
+}
+
+type Page[T any] struct {
+	Items []T
+	Next  string
+}
+
+type Cache[K comparable, V any] map[K]V
+
+type Paginator interface {
+	List(token string) (Page[User], error)
+	Cache() *Cache[string, int]
+	Current() *atomic.Pointer[Page[User]]
+	All(pages []Page[*User]) iter.Seq[User]
+}
@@ -2,16 +2,39 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // PointerPointInvocation represents a single call of FakePointer.Point
 type PointerPointInvocation struct {
 	Parameters struct {
 		Ident1 *string
 	}
-	Results struct {
-		Ident2 int
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetPointInvocation
+	Matchers struct {
+		Ident1 PointerMatcher[*string]
 	}
+	Results PointerPointResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *PointerPointInvocation) String() string {
+	return fmt.Sprintf("FakePointer.Point%+v", i.Parameters)
+}
+
+// PointerPointResults holds the results of a single call of FakePointer.Point
+type PointerPointResults struct {
+	Ident2 int
 }
 
 // NewPointerPointInvocation creates a new instance of PointerPointInvocation
@@ -25,14 +48,120 @@ func NewPointerPointInvocation(ident1 *string, ident2 int) *PointerPointInvocati
 	return invocation
 }
 
+// PointerPointExpectation is a call of FakePointer.Point expected by a test, created by FakePointer.ExpectPoint
+type PointerPointExpectation struct {
+	fake     *FakePointer
+	t        PointerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 PointerMatcher[*string]
+	}
+	returns bool
+	results PointerPointResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *PointerPointExpectation) With(ident1 PointerMatcher[*string]) *PointerPointExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *PointerPointExpectation) matches(ident1 *string) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *PointerPointExpectation) Times(n int) *PointerPointExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *PointerPointExpectation) Return(ident2 int) *PointerPointExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = PointerPointResults{Ident2: ident2}
+	return e
+}
+
 // PointerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type PointerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type PointerTestingT = fake.TestingT
+
+// PointerMatcher matches a parameter of a call to FakePointer
+type PointerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// PointerMatcherFunc is a PointerMatcher implemented by a predicate function
+type PointerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m PointerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// PointerAny returns a PointerMatcher that matches any value
+func PointerAny[T any]() PointerMatcher[T] {
+	return fake.Any[T]()
+}
+
+// PointerEq returns a PointerMatcher that matches values deeply equal to want
+func PointerEq[T any](want T) PointerMatcher[T] {
+	return fake.Eq(want)
+}
+
+// PointerNot returns a PointerMatcher that matches the values the given matcher does not
+func PointerNot[T any](m PointerMatcher[T]) PointerMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// PointerPred returns a PointerMatcher that matches values for which the given predicate returns true
+func PointerPred[T any](pred func(T) bool) PointerMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// PointerAnyOfType returns a PointerMatcher that matches values whose dynamic type is, or implements, U
+func PointerAnyOfType[T, U any]() PointerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// PointerCallMatcher selects calls of a method of a fake for PointerInOrder and PointerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakePointer
+type PointerCallMatcher = fake.CallMatcher
+
+// PointerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func PointerInOrder(t PointerTestingT, calls ...PointerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
 }
 
+// PointerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func PointerUnordered(t PointerTestingT, calls ...PointerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// PointerExhausted selects how a method of FakePointer behaves once the results given to its SetXReturnsSequence method are used up
+type PointerExhausted = fake.Exhausted
+
+const (
+	// PointerRepeatLast returns the last results in the sequence from all later calls
+	PointerRepeatLast = fake.RepeatLast
+	// PointerPanicAfterSequence panics on all later calls
+	PointerPanicAfterSequence = fake.PanicAfterSequence
+	// PointerHookAfterSequence calls the method's hook on all later calls
+	PointerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakePointer is a mock implementation of Pointer for testing.
 Use it in your tests as in this example:
@@ -49,18 +178,28 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakePoint ...
+		// assert state of FakePointer ...
 		f.AssertPointCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakePoint.
+unexpected calls are made to FakePointer.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakePointer struct {
 	PointHook func(*string) int
 
 	PointCalls []*PointerPointInvocation
+
+	returnsPoint      fake.Returns[PointerPointResults]
+	expectationsPoint []*PointerPointExpectation
+	mutex             sync.Mutex
+	recorded          chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters    bool
 }
 
 // NewFakePointerDefaultPanic returns an instance of FakePointer with all hooks configured to panic
@@ -73,77 +212,270 @@ func NewFakePointerDefaultPanic() *FakePointer {
 }
 
 // NewFakePointerDefaultFatal returns an instance of FakePointer with all hooks configured to call t.Fatal
-func NewFakePointerDefaultFatal(t_sym1 PointerTestingT) *FakePointer {
+func NewFakePointerDefaultFatal(t PointerTestingT) *FakePointer {
 	return &FakePointer{
 		PointHook: func(*string) (ident2 int) {
-			t_sym1.Fatal("Unexpected call to Pointer.Point")
+			t.Fatal("Unexpected call to Pointer.Point")
 			return
 		},
 	}
 }
 
 // NewFakePointerDefaultError returns an instance of FakePointer with all hooks configured to call t.Error
-func NewFakePointerDefaultError(t_sym2 PointerTestingT) *FakePointer {
+func NewFakePointerDefaultError(t PointerTestingT) *FakePointer {
+	return &FakePointer{
+		PointHook: func(*string) (ident2 int) {
+			t.Error("Unexpected call to Pointer.Point")
+			return
+		},
+	}
+}
+
+// NewFakePointerDefaultZero returns an instance of FakePointer with all hooks configured to return zero values
+func NewFakePointerDefaultZero() *FakePointer {
+	return &FakePointer{
+		PointHook: func(*string) (ident2 int) {
+			return
+		},
+	}
+}
+
+// NewFakePointerDefaultFriendly returns an instance of FakePointer with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakePointer are friendly fakes, one for each method and result, created on first use
+func NewFakePointerDefaultFriendly() *FakePointer {
+
 	return &FakePointer{
 		PointHook: func(*string) (ident2 int) {
-			t_sym2.Error("Unexpected call to Pointer.Point")
 			return
 		},
 	}
 }
 
+// NewFakePointerSpy returns an instance of FakePointer with all hooks configured to call the given implementation
+func NewFakePointerSpy(real Pointer) *FakePointer {
+	return &FakePointer{
+		PointHook: real.Point,
+	}
+}
+
+// Reset forgets all calls made to FakePointer
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakePointer) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsPoint.Rebase(len(f.PointCalls))
 	f.PointCalls = []*PointerPointInvocation{}
 }
 
-func (f_sym3 *FakePointer) Point(ident1 *string) (ident2 int) {
-	if f_sym3.PointHook == nil {
+// SetCopyParameters configures FakePointer to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakePointer) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakePointer) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakePointer) Point(ident1 *string) (ident2 int) {
+	f.mutex.Lock()
+	hook := f.PointHook
+	expectation, t := f.expectedPoint(ident1)
+	var results PointerPointResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsPoint.Lookup(len(f.PointCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Pointer.Point() called after the results given to FakePointer.SetPointReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Pointer.Point() called but FakePointer.PointHook is nil")
 	}
 
-	invocation_sym3 := new(PointerPointInvocation)
-	f_sym3.PointCalls = append(f_sym3.PointCalls, invocation_sym3)
+	invocation := new(PointerPointInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.PointCalls = append(f.PointCalls, invocation)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	ident2 = f_sym3.PointHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Results.Ident2 = ident2
+	if t != nil && expectation == nil {
+		t.Errorf("FakePointer.Point called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedPoint returns the first unsatisfied expectation of FakePointer.Point matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakePointer) expectedPoint(ident1 *string) (*PointerPointExpectation, PointerTestingT) {
+	if len(f.expectationsPoint) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsPoint {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsPoint[0].t
+}
+
+// ExpectPoint expects calls of FakePointer.Point, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakePointer) ExpectPoint(t PointerTestingT) *PointerPointExpectation {
+	t.Helper()
+	expectation := &PointerPointExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsPoint = append(f.expectationsPoint, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakePointer.Point called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetPointHook configures Pointer.Point to call the given function
+func (f *FakePointer) SetPointHook(hook func(*string) int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.PointHook = hook
+}
+
 // SetPointStub configures Pointer.Point to always return the given values
-func (f_sym4 *FakePointer) SetPointStub(ident2 int) {
-	f_sym4.PointHook = func(*string) int {
+func (f *FakePointer) SetPointStub(ident2 int) {
+	f.SetPointHook(func(*string) int {
 		return ident2
-	}
+	})
+}
+
+// SetPointReturnsOnCall configures Pointer.Point to return the given values from the call with the given index in PointCalls, rather than calling the hook
+func (f *FakePointer) SetPointReturnsOnCall(call int, ident2 int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsPoint.Set(call, PointerPointResults{Ident2: ident2})
+}
+
+// SetPointReturnsSequence configures the following calls of Pointer.Point to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakePointer) SetPointReturnsSequence(exhausted PointerExhausted, results ...PointerPointResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsPoint.Sequence(len(f.PointCalls), exhausted, results)
 }
 
 // SetPointInvocation configures Pointer.Point to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakePointer) SetPointInvocation(calls_sym5 []*PointerPointInvocation, fallback_sym5 func() int) {
-	f_sym5.PointHook = func(ident1 *string) (ident2 int) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f *FakePointer) SetPointInvocation(calls []*PointerPointInvocation, fallback func() int) {
+	f.SetPointHook(func(ident1 *string) (ident2 int) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// PointCallsSnapshot returns a copy of the calls made to FakePointer.Point
+func (f *FakePointer) PointCallsSnapshot() []*PointerPointInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*PointerPointInvocation, len(f.PointCalls))
+	for i, call := range f.PointCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// PointCall returns a PointerCallMatcher selecting the calls of FakePointer.Point with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakePointer) PointCall(ident1 PointerMatcher[*string]) PointerCallMatcher {
+	return fake.NewCallMatcher("FakePointer.Point(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*PointerPointInvocation(nil), f.PointCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // PointCalled returns true if FakePointer.Point was called
 func (f *FakePointer) PointCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.PointCalls) != 0
 }
 
 // AssertPointCalled calls t.Error if FakePointer.Point was not called
 func (f *FakePointer) AssertPointCalled(t PointerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.PointCalls) == 0 {
 		t.Error("FakePointer.Point not called, expected at least one")
 	}
@@ -151,12 +483,16 @@ func (f *FakePointer) AssertPointCalled(t PointerTestingT) {
 
 // PointNotCalled returns true if FakePointer.Point was not called
 func (f *FakePointer) PointNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.PointCalls) == 0
 }
 
 // AssertPointNotCalled calls t.Error if FakePointer.Point was called
 func (f *FakePointer) AssertPointNotCalled(t PointerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.PointCalls) != 0 {
 		t.Error("FakePointer.Point called, expected none")
 	}
@@ -164,12 +500,16 @@ func (f *FakePointer) AssertPointNotCalled(t PointerTestingT) {
 
 // PointCalledOnce returns true if FakePointer.Point was called exactly once
 func (f *FakePointer) PointCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.PointCalls) == 1
 }
 
 // AssertPointCalledOnce calls t.Error if FakePointer.Point was not called exactly once
 func (f *FakePointer) AssertPointCalledOnce(t PointerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.PointCalls) != 1 {
 		t.Errorf("FakePointer.Point called %d times, expected 1", len(f.PointCalls))
 	}
@@ -177,21 +517,92 @@ func (f *FakePointer) AssertPointCalledOnce(t PointerTestingT) {
 
 // PointCalledN returns true if FakePointer.Point was called at least n times
 func (f *FakePointer) PointCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.PointCalls) >= n
 }
 
 // AssertPointCalledN calls t.Error if FakePointer.Point was called less than n times
 func (f *FakePointer) AssertPointCalledN(t PointerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.PointCalls) < n {
 		t.Errorf("FakePointer.Point called %d times, expected >= %d", len(f.PointCalls), n)
 	}
 }
 
-// PointCalledWith returns true if FakePointer.Point was called with the given values
-func (f_sym6 *FakePointer) PointCalledWith(ident1 *string) bool {
-	for _, call_sym6 := range f_sym6.PointCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+// WaitForPointCalled blocks until FakePointer.Point has been called, returning the error of ctx if it is done first
+func (f *FakePointer) WaitForPointCalled(ctx context.Context) error {
+	return f.WaitForPointCalledN(ctx, 1)
+}
+
+// WaitForPointCalledN blocks until FakePointer.Point has been called at least n times, returning the error of ctx if it is done first
+func (f *FakePointer) WaitForPointCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.PointCalls) >= n
+	})
+}
+
+// AssertPointEventuallyCalled calls t.Error if FakePointer.Point is not called within the timeout
+func (f *FakePointer) AssertPointEventuallyCalled(t PointerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForPointCalled(ctx) != nil {
+		t.Errorf("FakePointer.Point not called within %v", timeout)
+	}
+}
+
+// describePointCalls describes the calls of FakePointer.Point against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakePointer) describePointCalls(ident1 PointerMatcher[*string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.PointCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.PointCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.PointCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// PointCalledWith returns true if FakePointer.Point was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakePointer) PointCalledWith(ident1 PointerMatcher[*string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.PointCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -199,55 +610,86 @@ func (f_sym6 *FakePointer) PointCalledWith(ident1 *string) bool {
 	return false
 }
 
-// AssertPointCalledWith calls t.Error if FakePointer.Point was not called with the given values
-func (f_sym7 *FakePointer) AssertPointCalledWith(t PointerTestingT, ident1 *string) {
+// AssertPointCalledWith calls t.Error if FakePointer.Point was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakePointer) AssertPointCalledWith(t PointerTestingT, ident1 PointerMatcher[*string]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.PointCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.PointCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakePointer.Point not called with expected parameters")
+	if !found {
+		t.Errorf("FakePointer.Point not called with expected parameters\n%s", f.describePointCalls(ident1))
 	}
 }
 
-// PointCalledOnceWith returns true if FakePointer.Point was called exactly once with the given values
-func (f_sym8 *FakePointer) PointCalledOnceWith(ident1 *string) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.PointCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+// PointCalledOnceWith returns true if FakePointer.Point was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakePointer) PointCalledOnceWith(ident1 PointerMatcher[*string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.PointCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertPointCalledOnceWith calls t.Error if FakePointer.Point was not called exactly once with the given values
-func (f_sym9 *FakePointer) AssertPointCalledOnceWith(t PointerTestingT, ident1 *string) {
+// AssertPointCalledOnceWith calls t.Error if FakePointer.Point was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakePointer) AssertPointCalledOnceWith(t PointerTestingT, ident1 PointerMatcher[*string]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.PointCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.PointCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakePointer.Point called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakePointer.Point called %d times with expected parameters, expected one\n%s", count, f.describePointCalls(ident1))
+	}
+}
+
+// AssertPointEventuallyCalledWith calls t.Error if FakePointer.Point is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakePointer) AssertPointEventuallyCalledWith(t PointerTestingT, timeout time.Duration, ident1 PointerMatcher[*string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.PointCalls)
+		for _, call := range f.PointCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakePointer.Point not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describePointCalls(ident1))
 	}
 }
 
-// PointResultsForCall returns the result values for the first call to FakePointer.Point with the given values
-func (f_sym10 *FakePointer) PointResultsForCall(ident1 *string) (ident2 int, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.PointCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+// PointResultsForCall returns the result values for the first call to FakePointer.Point with values matching the given matchers, any of which may be nil to match any value
+func (f *FakePointer) PointResultsForCall(ident1 PointerMatcher[*string]) (ident2 int, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.PointCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -2,17 +2,39 @@
 
 package main
 
-import "reflect"
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // QualifierQualifyInvocation represents a single call of FakeQualifier.Qualify
 type QualifierQualifyInvocation struct {
 	Parameters struct {
 		Ident1 fmt.Scanner
 	}
-	Results struct {
-		Ident2 fmt.Scanner
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetQualifyInvocation
+	Matchers struct {
+		Ident1 QualifierMatcher[fmt.Scanner]
 	}
+	Results QualifierQualifyResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *QualifierQualifyInvocation) String() string {
+	return fmt.Sprintf("FakeQualifier.Qualify%+v", i.Parameters)
+}
+
+// QualifierQualifyResults holds the results of a single call of FakeQualifier.Qualify
+type QualifierQualifyResults struct {
+	Ident2 fmt.Scanner
 }
 
 // NewQualifierQualifyInvocation creates a new instance of QualifierQualifyInvocation
@@ -26,6 +48,49 @@ func NewQualifierQualifyInvocation(ident1 fmt.Scanner, ident2 fmt.Scanner) *Qual
 	return invocation
 }
 
+// QualifierQualifyExpectation is a call of FakeQualifier.Qualify expected by a test, created by FakeQualifier.ExpectQualify
+type QualifierQualifyExpectation struct {
+	fake     *FakeQualifier
+	t        QualifierTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 QualifierMatcher[fmt.Scanner]
+	}
+	returns bool
+	results QualifierQualifyResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *QualifierQualifyExpectation) With(ident1 QualifierMatcher[fmt.Scanner]) *QualifierQualifyExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *QualifierQualifyExpectation) matches(ident1 fmt.Scanner) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *QualifierQualifyExpectation) Times(n int) *QualifierQualifyExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *QualifierQualifyExpectation) Return(ident2 fmt.Scanner) *QualifierQualifyExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = QualifierQualifyResults{Ident2: ident2}
+	return e
+}
+
 // QualifierNamedQualifyInvocation represents a single call of FakeQualifier.NamedQualify
 type QualifierNamedQualifyInvocation struct {
 	Parameters struct {
@@ -33,9 +98,25 @@ type QualifierNamedQualifyInvocation struct {
 		B fmt.Scanner
 		C fmt.Scanner
 	}
-	Results struct {
-		D fmt.Scanner
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetNamedQualifyInvocation
+	Matchers struct {
+		A QualifierMatcher[fmt.Scanner]
+		B QualifierMatcher[fmt.Scanner]
+		C QualifierMatcher[fmt.Scanner]
 	}
+	Results QualifierNamedQualifyResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *QualifierNamedQualifyInvocation) String() string {
+	return fmt.Sprintf("FakeQualifier.NamedQualify%+v", i.Parameters)
+}
+
+// QualifierNamedQualifyResults holds the results of a single call of FakeQualifier.NamedQualify
+type QualifierNamedQualifyResults struct {
+	D fmt.Scanner
 }
 
 // NewQualifierNamedQualifyInvocation creates a new instance of QualifierNamedQualifyInvocation
@@ -51,14 +132,124 @@ func NewQualifierNamedQualifyInvocation(a fmt.Scanner, b fmt.Scanner, c fmt.Scan
 	return invocation
 }
 
+// QualifierNamedQualifyExpectation is a call of FakeQualifier.NamedQualify expected by a test, created by FakeQualifier.ExpectNamedQualify
+type QualifierNamedQualifyExpectation struct {
+	fake     *FakeQualifier
+	t        QualifierTestingT
+	times    int
+	count    int
+	matchers struct {
+		A QualifierMatcher[fmt.Scanner]
+		B QualifierMatcher[fmt.Scanner]
+		C QualifierMatcher[fmt.Scanner]
+	}
+	returns bool
+	results QualifierNamedQualifyResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *QualifierNamedQualifyExpectation) With(a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) *QualifierNamedQualifyExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+	e.matchers.B = b
+	e.matchers.C = c
+
+	return e
+}
+
+func (e *QualifierNamedQualifyExpectation) matches(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
+	return fake.Matches(e.matchers.A, a) && fake.Matches(e.matchers.B, b) && fake.Matches(e.matchers.C, c)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *QualifierNamedQualifyExpectation) Times(n int) *QualifierNamedQualifyExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *QualifierNamedQualifyExpectation) Return(d fmt.Scanner) *QualifierNamedQualifyExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = QualifierNamedQualifyResults{D: d}
+	return e
+}
+
 // QualifierTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type QualifierTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type QualifierTestingT = fake.TestingT
+
+// QualifierMatcher matches a parameter of a call to FakeQualifier
+type QualifierMatcher[T any] interface {
+	Match(T) bool
+}
+
+// QualifierMatcherFunc is a QualifierMatcher implemented by a predicate function
+type QualifierMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m QualifierMatcherFunc[T]) Match(v T) bool {
+	return m(v)
 }
 
+// QualifierAny returns a QualifierMatcher that matches any value
+func QualifierAny[T any]() QualifierMatcher[T] {
+	return fake.Any[T]()
+}
+
+// QualifierEq returns a QualifierMatcher that matches values deeply equal to want
+func QualifierEq[T any](want T) QualifierMatcher[T] {
+	return fake.Eq(want)
+}
+
+// QualifierNot returns a QualifierMatcher that matches the values the given matcher does not
+func QualifierNot[T any](m QualifierMatcher[T]) QualifierMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// QualifierPred returns a QualifierMatcher that matches values for which the given predicate returns true
+func QualifierPred[T any](pred func(T) bool) QualifierMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// QualifierAnyOfType returns a QualifierMatcher that matches values whose dynamic type is, or implements, U
+func QualifierAnyOfType[T, U any]() QualifierMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// QualifierCallMatcher selects calls of a method of a fake for QualifierInOrder and QualifierUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeQualifier
+type QualifierCallMatcher = fake.CallMatcher
+
+// QualifierInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func QualifierInOrder(t QualifierTestingT, calls ...QualifierCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// QualifierUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func QualifierUnordered(t QualifierTestingT, calls ...QualifierCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// QualifierExhausted selects how a method of FakeQualifier behaves once the results given to its SetXReturnsSequence method are used up
+type QualifierExhausted = fake.Exhausted
+
+const (
+	// QualifierRepeatLast returns the last results in the sequence from all later calls
+	QualifierRepeatLast = fake.RepeatLast
+	// QualifierPanicAfterSequence panics on all later calls
+	QualifierPanicAfterSequence = fake.PanicAfterSequence
+	// QualifierHookAfterSequence calls the method's hook on all later calls
+	QualifierHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeQualifier is a mock implementation of Qualifier for testing.
 Use it in your tests as in this example:
@@ -75,13 +266,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeQualify ...
+		// assert state of FakeQualifier ...
 		f.AssertQualifyCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeQualify.
+unexpected calls are made to FakeQualifier.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeQualifier struct {
 	QualifyHook      func(fmt.Scanner) fmt.Scanner
@@ -89,6 +284,14 @@ type FakeQualifier struct {
 
 	QualifyCalls      []*QualifierQualifyInvocation
 	NamedQualifyCalls []*QualifierNamedQualifyInvocation
+
+	returnsQualify           fake.Returns[QualifierQualifyResults]
+	returnsNamedQualify      fake.Returns[QualifierNamedQualifyResults]
+	expectationsQualify      []*QualifierQualifyExpectation
+	expectationsNamedQualify []*QualifierNamedQualifyExpectation
+	mutex                    sync.Mutex
+	recorded                 chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters           bool
 }
 
 // NewFakeQualifierDefaultPanic returns an instance of FakeQualifier with all hooks configured to panic
@@ -104,86 +307,287 @@ func NewFakeQualifierDefaultPanic() *FakeQualifier {
 }
 
 // NewFakeQualifierDefaultFatal returns an instance of FakeQualifier with all hooks configured to call t.Fatal
-func NewFakeQualifierDefaultFatal(t_sym1 QualifierTestingT) *FakeQualifier {
+func NewFakeQualifierDefaultFatal(t QualifierTestingT) *FakeQualifier {
 	return &FakeQualifier{
 		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
-			t_sym1.Fatal("Unexpected call to Qualifier.Qualify")
+			t.Fatal("Unexpected call to Qualifier.Qualify")
 			return
 		},
 		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
-			t_sym1.Fatal("Unexpected call to Qualifier.NamedQualify")
+			t.Fatal("Unexpected call to Qualifier.NamedQualify")
 			return
 		},
 	}
 }
 
 // NewFakeQualifierDefaultError returns an instance of FakeQualifier with all hooks configured to call t.Error
-func NewFakeQualifierDefaultError(t_sym2 QualifierTestingT) *FakeQualifier {
+func NewFakeQualifierDefaultError(t QualifierTestingT) *FakeQualifier {
 	return &FakeQualifier{
 		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
-			t_sym2.Error("Unexpected call to Qualifier.Qualify")
+			t.Error("Unexpected call to Qualifier.Qualify")
 			return
 		},
 		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
-			t_sym2.Error("Unexpected call to Qualifier.NamedQualify")
+			t.Error("Unexpected call to Qualifier.NamedQualify")
 			return
 		},
 	}
 }
 
+// NewFakeQualifierDefaultZero returns an instance of FakeQualifier with all hooks configured to return zero values
+func NewFakeQualifierDefaultZero() *FakeQualifier {
+	return &FakeQualifier{
+		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
+			return
+		},
+		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
+			return
+		},
+	}
+}
+
+// NewFakeQualifierDefaultFriendly returns an instance of FakeQualifier with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeQualifier are friendly fakes, one for each method and result, created on first use
+func NewFakeQualifierDefaultFriendly() *FakeQualifier {
+
+	return &FakeQualifier{
+		QualifyHook: func(fmt.Scanner) (ident2 fmt.Scanner) {
+			return
+		},
+		NamedQualifyHook: func(fmt.Scanner, fmt.Scanner, fmt.Scanner) (d fmt.Scanner) {
+			return
+		},
+	}
+}
+
+// NewFakeQualifierSpy returns an instance of FakeQualifier with all hooks configured to call the given implementation
+func NewFakeQualifierSpy(real Qualifier) *FakeQualifier {
+	return &FakeQualifier{
+		QualifyHook:      real.Qualify,
+		NamedQualifyHook: real.NamedQualify,
+	}
+}
+
+// Reset forgets all calls made to FakeQualifier
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeQualifier) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsQualify.Rebase(len(f.QualifyCalls))
 	f.QualifyCalls = []*QualifierQualifyInvocation{}
+	f.returnsNamedQualify.Rebase(len(f.NamedQualifyCalls))
 	f.NamedQualifyCalls = []*QualifierNamedQualifyInvocation{}
 }
 
-func (f_sym3 *FakeQualifier) Qualify(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
-	if f_sym3.QualifyHook == nil {
+// SetCopyParameters configures FakeQualifier to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeQualifier) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeQualifier) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeQualifier) Qualify(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
+	f.mutex.Lock()
+	hook := f.QualifyHook
+	expectation, t := f.expectedQualify(ident1)
+	var results QualifierQualifyResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsQualify.Lookup(len(f.QualifyCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Qualifier.Qualify() called after the results given to FakeQualifier.SetQualifyReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Qualifier.Qualify() called but FakeQualifier.QualifyHook is nil")
 	}
 
-	invocation_sym3 := new(QualifierQualifyInvocation)
-	f_sym3.QualifyCalls = append(f_sym3.QualifyCalls, invocation_sym3)
+	invocation := new(QualifierQualifyInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.QualifyCalls = append(f.QualifyCalls, invocation)
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
 
-	ident2 = f_sym3.QualifyHook(ident1)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Results.Ident2 = ident2
+	if t != nil && expectation == nil {
+		t.Errorf("FakeQualifier.Qualify called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedQualify returns the first unsatisfied expectation of FakeQualifier.Qualify matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeQualifier) expectedQualify(ident1 fmt.Scanner) (*QualifierQualifyExpectation, QualifierTestingT) {
+	if len(f.expectationsQualify) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsQualify {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsQualify[0].t
+}
+
+// ExpectQualify expects calls of FakeQualifier.Qualify, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeQualifier) ExpectQualify(t QualifierTestingT) *QualifierQualifyExpectation {
+	t.Helper()
+	expectation := &QualifierQualifyExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsQualify = append(f.expectationsQualify, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeQualifier.Qualify called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetQualifyHook configures Qualifier.Qualify to call the given function
+func (f *FakeQualifier) SetQualifyHook(hook func(fmt.Scanner) fmt.Scanner) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.QualifyHook = hook
+}
+
 // SetQualifyStub configures Qualifier.Qualify to always return the given values
-func (f_sym4 *FakeQualifier) SetQualifyStub(ident2 fmt.Scanner) {
-	f_sym4.QualifyHook = func(fmt.Scanner) fmt.Scanner {
+func (f *FakeQualifier) SetQualifyStub(ident2 fmt.Scanner) {
+	f.SetQualifyHook(func(fmt.Scanner) fmt.Scanner {
 		return ident2
-	}
+	})
+}
+
+// SetQualifyReturnsOnCall configures Qualifier.Qualify to return the given values from the call with the given index in QualifyCalls, rather than calling the hook
+func (f *FakeQualifier) SetQualifyReturnsOnCall(call int, ident2 fmt.Scanner) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsQualify.Set(call, QualifierQualifyResults{Ident2: ident2})
+}
+
+// SetQualifyReturnsSequence configures the following calls of Qualifier.Qualify to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeQualifier) SetQualifyReturnsSequence(exhausted QualifierExhausted, results ...QualifierQualifyResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsQualify.Sequence(len(f.QualifyCalls), exhausted, results)
 }
 
 // SetQualifyInvocation configures Qualifier.Qualify to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeQualifier) SetQualifyInvocation(calls_sym5 []*QualifierQualifyInvocation, fallback_sym5 func() fmt.Scanner) {
-	f_sym5.QualifyHook = func(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+func (f *FakeQualifier) SetQualifyInvocation(calls []*QualifierQualifyInvocation, fallback func() fmt.Scanner) {
+	f.SetQualifyHook(func(ident1 fmt.Scanner) (ident2 fmt.Scanner) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
 
-		return fallback_sym5()
+		return fallback()
+	})
+}
+
+// QualifyCallsSnapshot returns a copy of the calls made to FakeQualifier.Qualify
+func (f *FakeQualifier) QualifyCallsSnapshot() []*QualifierQualifyInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*QualifierQualifyInvocation, len(f.QualifyCalls))
+	for i, call := range f.QualifyCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// QualifyCall returns a QualifierCallMatcher selecting the calls of FakeQualifier.Qualify with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) QualifyCall(ident1 QualifierMatcher[fmt.Scanner]) QualifierCallMatcher {
+	return fake.NewCallMatcher("FakeQualifier.Qualify(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*QualifierQualifyInvocation(nil), f.QualifyCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // QualifyCalled returns true if FakeQualifier.Qualify was called
 func (f *FakeQualifier) QualifyCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.QualifyCalls) != 0
 }
 
 // AssertQualifyCalled calls t.Error if FakeQualifier.Qualify was not called
 func (f *FakeQualifier) AssertQualifyCalled(t QualifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.QualifyCalls) == 0 {
 		t.Error("FakeQualifier.Qualify not called, expected at least one")
 	}
@@ -191,12 +595,16 @@ func (f *FakeQualifier) AssertQualifyCalled(t QualifierTestingT) {
 
 // QualifyNotCalled returns true if FakeQualifier.Qualify was not called
 func (f *FakeQualifier) QualifyNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.QualifyCalls) == 0
 }
 
 // AssertQualifyNotCalled calls t.Error if FakeQualifier.Qualify was called
 func (f *FakeQualifier) AssertQualifyNotCalled(t QualifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.QualifyCalls) != 0 {
 		t.Error("FakeQualifier.Qualify called, expected none")
 	}
@@ -204,12 +612,16 @@ func (f *FakeQualifier) AssertQualifyNotCalled(t QualifierTestingT) {
 
 // QualifyCalledOnce returns true if FakeQualifier.Qualify was called exactly once
 func (f *FakeQualifier) QualifyCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.QualifyCalls) == 1
 }
 
 // AssertQualifyCalledOnce calls t.Error if FakeQualifier.Qualify was not called exactly once
 func (f *FakeQualifier) AssertQualifyCalledOnce(t QualifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.QualifyCalls) != 1 {
 		t.Errorf("FakeQualifier.Qualify called %d times, expected 1", len(f.QualifyCalls))
 	}
@@ -217,21 +629,92 @@ func (f *FakeQualifier) AssertQualifyCalledOnce(t QualifierTestingT) {
 
 // QualifyCalledN returns true if FakeQualifier.Qualify was called at least n times
 func (f *FakeQualifier) QualifyCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.QualifyCalls) >= n
 }
 
 // AssertQualifyCalledN calls t.Error if FakeQualifier.Qualify was called less than n times
 func (f *FakeQualifier) AssertQualifyCalledN(t QualifierTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.QualifyCalls) < n {
 		t.Errorf("FakeQualifier.Qualify called %d times, expected >= %d", len(f.QualifyCalls), n)
 	}
 }
 
-// QualifyCalledWith returns true if FakeQualifier.Qualify was called with the given values
-func (f_sym6 *FakeQualifier) QualifyCalledWith(ident1 fmt.Scanner) bool {
-	for _, call_sym6 := range f_sym6.QualifyCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+// WaitForQualifyCalled blocks until FakeQualifier.Qualify has been called, returning the error of ctx if it is done first
+func (f *FakeQualifier) WaitForQualifyCalled(ctx context.Context) error {
+	return f.WaitForQualifyCalledN(ctx, 1)
+}
+
+// WaitForQualifyCalledN blocks until FakeQualifier.Qualify has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeQualifier) WaitForQualifyCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.QualifyCalls) >= n
+	})
+}
+
+// AssertQualifyEventuallyCalled calls t.Error if FakeQualifier.Qualify is not called within the timeout
+func (f *FakeQualifier) AssertQualifyEventuallyCalled(t QualifierTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForQualifyCalled(ctx) != nil {
+		t.Errorf("FakeQualifier.Qualify not called within %v", timeout)
+	}
+}
+
+// describeQualifyCalls describes the calls of FakeQualifier.Qualify against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeQualifier) describeQualifyCalls(ident1 QualifierMatcher[fmt.Scanner]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.QualifyCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.QualifyCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.QualifyCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b.String()
+}
+
+// QualifyCalledWith returns true if FakeQualifier.Qualify was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) QualifyCalledWith(ident1 QualifierMatcher[fmt.Scanner]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.QualifyCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -239,55 +722,86 @@ func (f_sym6 *FakeQualifier) QualifyCalledWith(ident1 fmt.Scanner) bool {
 	return false
 }
 
-// AssertQualifyCalledWith calls t.Error if FakeQualifier.Qualify was not called with the given values
-func (f_sym7 *FakeQualifier) AssertQualifyCalledWith(t QualifierTestingT, ident1 fmt.Scanner) {
+// AssertQualifyCalledWith calls t.Error if FakeQualifier.Qualify was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) AssertQualifyCalledWith(t QualifierTestingT, ident1 QualifierMatcher[fmt.Scanner]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.QualifyCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.QualifyCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeQualifier.Qualify not called with expected parameters")
+	if !found {
+		t.Errorf("FakeQualifier.Qualify not called with expected parameters\n%s", f.describeQualifyCalls(ident1))
 	}
 }
 
-// QualifyCalledOnceWith returns true if FakeQualifier.Qualify was called exactly once with the given values
-func (f_sym8 *FakeQualifier) QualifyCalledOnceWith(ident1 fmt.Scanner) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.QualifyCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+// QualifyCalledOnceWith returns true if FakeQualifier.Qualify was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) QualifyCalledOnceWith(ident1 QualifierMatcher[fmt.Scanner]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.QualifyCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertQualifyCalledOnceWith calls t.Error if FakeQualifier.Qualify was not called exactly once with the given values
-func (f_sym9 *FakeQualifier) AssertQualifyCalledOnceWith(t QualifierTestingT, ident1 fmt.Scanner) {
+// AssertQualifyCalledOnceWith calls t.Error if FakeQualifier.Qualify was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) AssertQualifyCalledOnceWith(t QualifierTestingT, ident1 QualifierMatcher[fmt.Scanner]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.QualifyCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.QualifyCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeQualifier.Qualify called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakeQualifier.Qualify called %d times with expected parameters, expected one\n%s", count, f.describeQualifyCalls(ident1))
 	}
 }
 
-// QualifyResultsForCall returns the result values for the first call to FakeQualifier.Qualify with the given values
-func (f_sym10 *FakeQualifier) QualifyResultsForCall(ident1 fmt.Scanner) (ident2 fmt.Scanner, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.QualifyCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+// AssertQualifyEventuallyCalledWith calls t.Error if FakeQualifier.Qualify is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeQualifier) AssertQualifyEventuallyCalledWith(t QualifierTestingT, timeout time.Duration, ident1 QualifierMatcher[fmt.Scanner]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.QualifyCalls)
+		for _, call := range f.QualifyCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeQualifier.Qualify not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeQualifyCalls(ident1))
+	}
+}
+
+// QualifyResultsForCall returns the result values for the first call to FakeQualifier.Qualify with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) QualifyResultsForCall(ident1 QualifierMatcher[fmt.Scanner]) (ident2 fmt.Scanner, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.QualifyCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -295,56 +809,189 @@ func (f_sym10 *FakeQualifier) QualifyResultsForCall(ident1 fmt.Scanner) (ident2
 	return
 }
 
-func (f_sym11 *FakeQualifier) NamedQualify(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
-	if f_sym11.NamedQualifyHook == nil {
+func (f *FakeQualifier) NamedQualify(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
+	f.mutex.Lock()
+	hook := f.NamedQualifyHook
+	expectation, t := f.expectedNamedQualify(a, b, c)
+	var results QualifierNamedQualifyResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsNamedQualify.Lookup(len(f.NamedQualifyCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Qualifier.NamedQualify() called after the results given to FakeQualifier.SetNamedQualifyReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Qualifier.NamedQualify() called but FakeQualifier.NamedQualifyHook is nil")
 	}
 
-	invocation_sym11 := new(QualifierNamedQualifyInvocation)
-	f_sym11.NamedQualifyCalls = append(f_sym11.NamedQualifyCalls, invocation_sym11)
+	invocation := new(QualifierNamedQualifyInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.NamedQualifyCalls = append(f.NamedQualifyCalls, invocation)
+
+	invocation.Parameters.A = a
+	invocation.Parameters.B = b
+	invocation.Parameters.C = c
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+		invocation.Parameters.B = fake.CopyParameter(invocation.Parameters.B, seen)
+		invocation.Parameters.C = fake.CopyParameter(invocation.Parameters.C, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym11.Parameters.A = a
-	invocation_sym11.Parameters.B = b
-	invocation_sym11.Parameters.C = c
+	if t != nil && expectation == nil {
+		t.Errorf("FakeQualifier.NamedQualify called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	d = f_sym11.NamedQualifyHook(a, b, c)
+	if found {
+		d = results.D
+	} else if hook != nil {
+		d = hook(a, b, c)
+	}
 
-	invocation_sym11.Results.D = d
+	f.mutex.Lock()
+	invocation.Results.D = d
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedNamedQualify returns the first unsatisfied expectation of FakeQualifier.NamedQualify matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeQualifier) expectedNamedQualify(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (*QualifierNamedQualifyExpectation, QualifierTestingT) {
+	if len(f.expectationsNamedQualify) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsNamedQualify {
+		if expectation.count < expectation.times && expectation.matches(a, b, c) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsNamedQualify[0].t
+}
+
+// ExpectNamedQualify expects calls of FakeQualifier.NamedQualify, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeQualifier) ExpectNamedQualify(t QualifierTestingT) *QualifierNamedQualifyExpectation {
+	t.Helper()
+	expectation := &QualifierNamedQualifyExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsNamedQualify = append(f.expectationsNamedQualify, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeQualifier.NamedQualify called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetNamedQualifyHook configures Qualifier.NamedQualify to call the given function
+func (f *FakeQualifier) SetNamedQualifyHook(hook func(fmt.Scanner, fmt.Scanner, fmt.Scanner) fmt.Scanner) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.NamedQualifyHook = hook
+}
+
 // SetNamedQualifyStub configures Qualifier.NamedQualify to always return the given values
-func (f_sym12 *FakeQualifier) SetNamedQualifyStub(d fmt.Scanner) {
-	f_sym12.NamedQualifyHook = func(fmt.Scanner, fmt.Scanner, fmt.Scanner) fmt.Scanner {
+func (f *FakeQualifier) SetNamedQualifyStub(d fmt.Scanner) {
+	f.SetNamedQualifyHook(func(fmt.Scanner, fmt.Scanner, fmt.Scanner) fmt.Scanner {
 		return d
-	}
+	})
+}
+
+// SetNamedQualifyReturnsOnCall configures Qualifier.NamedQualify to return the given values from the call with the given index in NamedQualifyCalls, rather than calling the hook
+func (f *FakeQualifier) SetNamedQualifyReturnsOnCall(call int, d fmt.Scanner) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedQualify.Set(call, QualifierNamedQualifyResults{D: d})
+}
+
+// SetNamedQualifyReturnsSequence configures the following calls of Qualifier.NamedQualify to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeQualifier) SetNamedQualifyReturnsSequence(exhausted QualifierExhausted, results ...QualifierNamedQualifyResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedQualify.Sequence(len(f.NamedQualifyCalls), exhausted, results)
 }
 
 // SetNamedQualifyInvocation configures Qualifier.NamedQualify to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeQualifier) SetNamedQualifyInvocation(calls_sym13 []*QualifierNamedQualifyInvocation, fallback_sym13 func() fmt.Scanner) {
-	f_sym13.NamedQualifyHook = func(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) && reflect.DeepEqual(call_sym13.Parameters.B, b) && reflect.DeepEqual(call_sym13.Parameters.C, c) {
-				d = call_sym13.Results.D
+func (f *FakeQualifier) SetNamedQualifyInvocation(calls []*QualifierNamedQualifyInvocation, fallback func() fmt.Scanner) {
+	f.SetNamedQualifyHook(func(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.A, call.Parameters.A, a) && fake.MatchParameter(call.Matchers.B, call.Parameters.B, b) && fake.MatchParameter(call.Matchers.C, call.Parameters.C, c) {
+				d = call.Results.D
 
 				return
 			}
 		}
 
-		return fallback_sym13()
+		return fallback()
+	})
+}
+
+// NamedQualifyCallsSnapshot returns a copy of the calls made to FakeQualifier.NamedQualify
+func (f *FakeQualifier) NamedQualifyCallsSnapshot() []*QualifierNamedQualifyInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*QualifierNamedQualifyInvocation, len(f.NamedQualifyCalls))
+	for i, call := range f.NamedQualifyCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// NamedQualifyCall returns a QualifierCallMatcher selecting the calls of FakeQualifier.NamedQualify with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) NamedQualifyCall(a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) QualifierCallMatcher {
+	return fake.NewCallMatcher("FakeQualifier.NamedQualify(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*QualifierNamedQualifyInvocation(nil), f.NamedQualifyCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // NamedQualifyCalled returns true if FakeQualifier.NamedQualify was called
 func (f *FakeQualifier) NamedQualifyCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedQualifyCalls) != 0
 }
 
 // AssertNamedQualifyCalled calls t.Error if FakeQualifier.NamedQualify was not called
 func (f *FakeQualifier) AssertNamedQualifyCalled(t QualifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedQualifyCalls) == 0 {
 		t.Error("FakeQualifier.NamedQualify not called, expected at least one")
 	}
@@ -352,12 +999,16 @@ func (f *FakeQualifier) AssertNamedQualifyCalled(t QualifierTestingT) {
 
 // NamedQualifyNotCalled returns true if FakeQualifier.NamedQualify was not called
 func (f *FakeQualifier) NamedQualifyNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedQualifyCalls) == 0
 }
 
 // AssertNamedQualifyNotCalled calls t.Error if FakeQualifier.NamedQualify was called
 func (f *FakeQualifier) AssertNamedQualifyNotCalled(t QualifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedQualifyCalls) != 0 {
 		t.Error("FakeQualifier.NamedQualify called, expected none")
 	}
@@ -365,12 +1016,16 @@ func (f *FakeQualifier) AssertNamedQualifyNotCalled(t QualifierTestingT) {
 
 // NamedQualifyCalledOnce returns true if FakeQualifier.NamedQualify was called exactly once
 func (f *FakeQualifier) NamedQualifyCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedQualifyCalls) == 1
 }
 
 // AssertNamedQualifyCalledOnce calls t.Error if FakeQualifier.NamedQualify was not called exactly once
 func (f *FakeQualifier) AssertNamedQualifyCalledOnce(t QualifierTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedQualifyCalls) != 1 {
 		t.Errorf("FakeQualifier.NamedQualify called %d times, expected 1", len(f.NamedQualifyCalls))
 	}
@@ -378,21 +1033,106 @@ func (f *FakeQualifier) AssertNamedQualifyCalledOnce(t QualifierTestingT) {
 
 // NamedQualifyCalledN returns true if FakeQualifier.NamedQualify was called at least n times
 func (f *FakeQualifier) NamedQualifyCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedQualifyCalls) >= n
 }
 
 // AssertNamedQualifyCalledN calls t.Error if FakeQualifier.NamedQualify was called less than n times
 func (f *FakeQualifier) AssertNamedQualifyCalledN(t QualifierTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedQualifyCalls) < n {
 		t.Errorf("FakeQualifier.NamedQualify called %d times, expected >= %d", len(f.NamedQualifyCalls), n)
 	}
 }
 
-// NamedQualifyCalledWith returns true if FakeQualifier.NamedQualify was called with the given values
-func (f_sym14 *FakeQualifier) NamedQualifyCalledWith(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
-	for _, call_sym14 := range f_sym14.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) && reflect.DeepEqual(call_sym14.Parameters.B, b) && reflect.DeepEqual(call_sym14.Parameters.C, c) {
+// WaitForNamedQualifyCalled blocks until FakeQualifier.NamedQualify has been called, returning the error of ctx if it is done first
+func (f *FakeQualifier) WaitForNamedQualifyCalled(ctx context.Context) error {
+	return f.WaitForNamedQualifyCalledN(ctx, 1)
+}
+
+// WaitForNamedQualifyCalledN blocks until FakeQualifier.NamedQualify has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeQualifier) WaitForNamedQualifyCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.NamedQualifyCalls) >= n
+	})
+}
+
+// AssertNamedQualifyEventuallyCalled calls t.Error if FakeQualifier.NamedQualify is not called within the timeout
+func (f *FakeQualifier) AssertNamedQualifyEventuallyCalled(t QualifierTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForNamedQualifyCalled(ctx) != nil {
+		t.Errorf("FakeQualifier.NamedQualify not called within %v", timeout)
+	}
+}
+
+// describeNamedQualifyCalls describes the calls of FakeQualifier.NamedQualify against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeQualifier) describeNamedQualifyCalls(a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) string {
+	var b2 strings.Builder
+	b2.WriteString("expected:")
+	fmt.Fprintf(&b2, "\n\tA: %s", fake.Describe(a))
+	fmt.Fprintf(&b2, "\n\tB: %s", fake.Describe(b))
+	fmt.Fprintf(&b2, "\n\tC: %s", fake.Describe(c))
+
+	if len(f.NamedQualifyCalls) == 0 {
+		b2.WriteString("\nrecorded calls: none")
+		return b2.String()
+	}
+
+	b2.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.NamedQualifyCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+		if fake.Matches(b, call.Parameters.B) {
+			matched++
+		}
+		if fake.Matches(c, call.Parameters.C) {
+			matched++
+		}
+
+		if matched == 3 {
+			found = true
+			fmt.Fprintf(&b2, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b2, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b2.String()
+	}
+
+	call := f.NamedQualifyCalls[closest]
+	fmt.Fprintf(&b2, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b2, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+	if !fake.Matches(b, call.Parameters.B) {
+		fmt.Fprintf(&b2, "\n\tB: got %s, want %s", fake.Format(call.Parameters.B), fake.Describe(b))
+	}
+	if !fake.Matches(c, call.Parameters.C) {
+		fmt.Fprintf(&b2, "\n\tC: got %s, want %s", fake.Format(call.Parameters.C), fake.Describe(c))
+	}
+
+	return b2.String()
+}
+
+// NamedQualifyCalledWith returns true if FakeQualifier.NamedQualify was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) NamedQualifyCalledWith(a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedQualifyCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
 			return true
 		}
 	}
@@ -400,55 +1140,86 @@ func (f_sym14 *FakeQualifier) NamedQualifyCalledWith(a fmt.Scanner, b fmt.Scanne
 	return false
 }
 
-// AssertNamedQualifyCalledWith calls t.Error if FakeQualifier.NamedQualify was not called with the given values
-func (f_sym15 *FakeQualifier) AssertNamedQualifyCalledWith(t QualifierTestingT, a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) {
+// AssertNamedQualifyCalledWith calls t.Error if FakeQualifier.NamedQualify was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) AssertNamedQualifyCalledWith(t QualifierTestingT, a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) && reflect.DeepEqual(call_sym15.Parameters.B, b) && reflect.DeepEqual(call_sym15.Parameters.C, c) {
-			found_sym15 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.NamedQualifyCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym15 {
-		t.Error("FakeQualifier.NamedQualify not called with expected parameters")
+	if !found {
+		t.Errorf("FakeQualifier.NamedQualify not called with expected parameters\n%s", f.describeNamedQualifyCalls(a, b, c))
 	}
 }
 
-// NamedQualifyCalledOnceWith returns true if FakeQualifier.NamedQualify was called exactly once with the given values
-func (f_sym16 *FakeQualifier) NamedQualifyCalledOnceWith(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) && reflect.DeepEqual(call_sym16.Parameters.B, b) && reflect.DeepEqual(call_sym16.Parameters.C, c) {
-			count_sym16++
+// NamedQualifyCalledOnceWith returns true if FakeQualifier.NamedQualify was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) NamedQualifyCalledOnceWith(a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedQualifyCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
+			count++
 		}
 	}
 
-	return count_sym16 == 1
+	return count == 1
 }
 
-// AssertNamedQualifyCalledOnceWith calls t.Error if FakeQualifier.NamedQualify was not called exactly once with the given values
-func (f_sym17 *FakeQualifier) AssertNamedQualifyCalledOnceWith(t QualifierTestingT, a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) {
+// AssertNamedQualifyCalledOnceWith calls t.Error if FakeQualifier.NamedQualify was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) AssertNamedQualifyCalledOnceWith(t QualifierTestingT, a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) && reflect.DeepEqual(call_sym17.Parameters.B, b) && reflect.DeepEqual(call_sym17.Parameters.C, c) {
-			count_sym17++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedQualifyCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
+			count++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeQualifier.NamedQualify called %d times with expected parameters, expected one", count_sym17)
+	if count != 1 {
+		t.Errorf("FakeQualifier.NamedQualify called %d times with expected parameters, expected one\n%s", count, f.describeNamedQualifyCalls(a, b, c))
+	}
+}
+
+// AssertNamedQualifyEventuallyCalledWith calls t.Error if FakeQualifier.NamedQualify is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeQualifier) AssertNamedQualifyEventuallyCalledWith(t QualifierTestingT, timeout time.Duration, a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.NamedQualifyCalls)
+		for _, call := range f.NamedQualifyCalls {
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeQualifier.NamedQualify not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeNamedQualifyCalls(a, b, c))
 	}
 }
 
-// NamedQualifyResultsForCall returns the result values for the first call to FakeQualifier.NamedQualify with the given values
-func (f_sym18 *FakeQualifier) NamedQualifyResultsForCall(a fmt.Scanner, b fmt.Scanner, c fmt.Scanner) (d fmt.Scanner, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedQualifyCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) && reflect.DeepEqual(call_sym18.Parameters.B, b) && reflect.DeepEqual(call_sym18.Parameters.C, c) {
-			d = call_sym18.Results.D
-			found_sym18 = true
+// NamedQualifyResultsForCall returns the result values for the first call to FakeQualifier.NamedQualify with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeQualifier) NamedQualifyResultsForCall(a QualifierMatcher[fmt.Scanner], b QualifierMatcher[fmt.Scanner], c QualifierMatcher[fmt.Scanner]) (d fmt.Scanner, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedQualifyCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) {
+			d = call.Results.D
+			found = true
 			break
 		}
 	}
@@ -0,0 +1,6 @@
+package main
+
+type Racer interface {
+	Lap(n int) (int, error)
+	Finish(string)
+}
@@ -0,0 +1,9 @@
+package main
+
+import "fmt"
+
+type Repository[K comparable, V fmt.Stringer] interface {
+	Get(K) (V, error)
+	Put(key K, value V) error
+	Keys() []K
+}
@@ -0,0 +1,6 @@
+package main
+
+type Sequencer interface {
+	Page(token string) (items []string, next string, err error)
+	Ping() error
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+type Shadower interface {
+	Apply(f func(int) int, t int) int
+	Match(invocation string, call int, expectation bool) error
+	Wait(ctx context.Context, timeout time.Duration, e error) (found bool, err error)
+	Plain(n int) int
+}
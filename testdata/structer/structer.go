@@ -2,7 +2,16 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // StructerStructInvocation represents a single call of FakeStructer.Struct
 type StructerStructInvocation struct {
@@ -12,11 +21,28 @@ type StructerStructInvocation struct {
 			b string
 		}
 	}
-	Results struct {
-		Ident2 struct {
-			c string
-			d string
-		}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetStructInvocation
+	Matchers struct {
+		Ident1 StructerMatcher[struct {
+			a string
+			b string
+		}]
+	}
+	Results StructerStructResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *StructerStructInvocation) String() string {
+	return fmt.Sprintf("FakeStructer.Struct%+v", i.Parameters)
+}
+
+// StructerStructResults holds the results of a single call of FakeStructer.Struct
+type StructerStructResults struct {
+	Ident2 struct {
+		c string
+		d string
 	}
 }
 
@@ -37,6 +63,61 @@ func NewStructerStructInvocation(ident1 struct {
 	return invocation
 }
 
+// StructerStructExpectation is a call of FakeStructer.Struct expected by a test, created by FakeStructer.ExpectStruct
+type StructerStructExpectation struct {
+	fake     *FakeStructer
+	t        StructerTestingT
+	times    int
+	count    int
+	matchers struct {
+		Ident1 StructerMatcher[struct {
+			a string
+			b string
+		}]
+	}
+	returns bool
+	results StructerStructResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *StructerStructExpectation) With(ident1 StructerMatcher[struct {
+	a string
+	b string
+}]) *StructerStructExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Ident1 = ident1
+
+	return e
+}
+
+func (e *StructerStructExpectation) matches(ident1 struct {
+	a string
+	b string
+}) bool {
+	return fake.Matches(e.matchers.Ident1, ident1)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *StructerStructExpectation) Times(n int) *StructerStructExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *StructerStructExpectation) Return(ident2 struct {
+	c string
+	d string
+}) *StructerStructExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = StructerStructResults{Ident2: ident2}
+	return e
+}
+
 // StructerNamedStructInvocation represents a single call of FakeStructer.NamedStruct
 type StructerNamedStructInvocation struct {
 	Parameters struct {
@@ -45,11 +126,28 @@ type StructerNamedStructInvocation struct {
 			b string
 		}
 	}
-	Results struct {
-		Z struct {
-			c string
-			d string
-		}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetNamedStructInvocation
+	Matchers struct {
+		A StructerMatcher[struct {
+			a string
+			b string
+		}]
+	}
+	Results StructerNamedStructResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *StructerNamedStructInvocation) String() string {
+	return fmt.Sprintf("FakeStructer.NamedStruct%+v", i.Parameters)
+}
+
+// StructerNamedStructResults holds the results of a single call of FakeStructer.NamedStruct
+type StructerNamedStructResults struct {
+	Z struct {
+		c string
+		d string
 	}
 }
 
@@ -70,14 +168,132 @@ func NewStructerNamedStructInvocation(a struct {
 	return invocation
 }
 
+// StructerNamedStructExpectation is a call of FakeStructer.NamedStruct expected by a test, created by FakeStructer.ExpectNamedStruct
+type StructerNamedStructExpectation struct {
+	fake     *FakeStructer
+	t        StructerTestingT
+	times    int
+	count    int
+	matchers struct {
+		A StructerMatcher[struct {
+			a string
+			b string
+		}]
+	}
+	returns bool
+	results StructerNamedStructResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *StructerNamedStructExpectation) With(a StructerMatcher[struct {
+	a string
+	b string
+}]) *StructerNamedStructExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+
+	return e
+}
+
+func (e *StructerNamedStructExpectation) matches(a struct {
+	a string
+	b string
+}) bool {
+	return fake.Matches(e.matchers.A, a)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *StructerNamedStructExpectation) Times(n int) *StructerNamedStructExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *StructerNamedStructExpectation) Return(z struct {
+	c string
+	d string
+}) *StructerNamedStructExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = StructerNamedStructResults{Z: z}
+	return e
+}
+
 // StructerTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type StructerTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type StructerTestingT = fake.TestingT
+
+// StructerMatcher matches a parameter of a call to FakeStructer
+type StructerMatcher[T any] interface {
+	Match(T) bool
+}
+
+// StructerMatcherFunc is a StructerMatcher implemented by a predicate function
+type StructerMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m StructerMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// StructerAny returns a StructerMatcher that matches any value
+func StructerAny[T any]() StructerMatcher[T] {
+	return fake.Any[T]()
 }
 
+// StructerEq returns a StructerMatcher that matches values deeply equal to want
+func StructerEq[T any](want T) StructerMatcher[T] {
+	return fake.Eq(want)
+}
+
+// StructerNot returns a StructerMatcher that matches the values the given matcher does not
+func StructerNot[T any](m StructerMatcher[T]) StructerMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// StructerPred returns a StructerMatcher that matches values for which the given predicate returns true
+func StructerPred[T any](pred func(T) bool) StructerMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// StructerAnyOfType returns a StructerMatcher that matches values whose dynamic type is, or implements, U
+func StructerAnyOfType[T, U any]() StructerMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// StructerCallMatcher selects calls of a method of a fake for StructerInOrder and StructerUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeStructer
+type StructerCallMatcher = fake.CallMatcher
+
+// StructerInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func StructerInOrder(t StructerTestingT, calls ...StructerCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// StructerUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func StructerUnordered(t StructerTestingT, calls ...StructerCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// StructerExhausted selects how a method of FakeStructer behaves once the results given to its SetXReturnsSequence method are used up
+type StructerExhausted = fake.Exhausted
+
+const (
+	// StructerRepeatLast returns the last results in the sequence from all later calls
+	StructerRepeatLast = fake.RepeatLast
+	// StructerPanicAfterSequence panics on all later calls
+	StructerPanicAfterSequence = fake.PanicAfterSequence
+	// StructerHookAfterSequence calls the method's hook on all later calls
+	StructerHookAfterSequence = fake.HookAfterSequence
+)
+
 /*
 FakeStructer is a mock implementation of Structer for testing.
 Use it in your tests as in this example:
@@ -86,13 +302,7 @@ Use it in your tests as in this example:
 
 	func TestWithStructer(t *testing.T) {
 		f := &main.FakeStructer{
-			StructHook: func(ident1 struct {
-	a string
-	b string
-}) (ident2 struct {
-	c string
-	d string
-}) {
+			StructHook: func(ident1 struct{a string; b string}) (ident2 struct{c string; d string}) {
 				// ensure parameters meet expectations, signal errors using t, etc
 				return
 			},
@@ -100,13 +310,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeStruct ...
+		// assert state of FakeStructer ...
 		f.AssertStructCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeStruct.
+unexpected calls are made to FakeStructer.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeStructer struct {
 	StructHook func(struct {
@@ -126,6 +340,14 @@ type FakeStructer struct {
 
 	StructCalls      []*StructerStructInvocation
 	NamedStructCalls []*StructerNamedStructInvocation
+
+	returnsStruct           fake.Returns[StructerStructResults]
+	returnsNamedStruct      fake.Returns[StructerNamedStructResults]
+	expectationsStruct      []*StructerStructExpectation
+	expectationsNamedStruct []*StructerNamedStructExpectation
+	mutex                   sync.Mutex
+	recorded                chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters          bool
 }
 
 // NewFakeStructerDefaultPanic returns an instance of FakeStructer with all hooks configured to panic
@@ -153,7 +375,7 @@ func NewFakeStructerDefaultPanic() *FakeStructer {
 }
 
 // NewFakeStructerDefaultFatal returns an instance of FakeStructer with all hooks configured to call t.Fatal
-func NewFakeStructerDefaultFatal(t_sym1 StructerTestingT) *FakeStructer {
+func NewFakeStructerDefaultFatal(t StructerTestingT) *FakeStructer {
 	return &FakeStructer{
 		StructHook: func(struct {
 			a string
@@ -162,7 +384,7 @@ func NewFakeStructerDefaultFatal(t_sym1 StructerTestingT) *FakeStructer {
 			c string
 			d string
 		}) {
-			t_sym1.Fatal("Unexpected call to Structer.Struct")
+			t.Fatal("Unexpected call to Structer.Struct")
 			return
 		},
 		NamedStructHook: func(struct {
@@ -172,14 +394,66 @@ func NewFakeStructerDefaultFatal(t_sym1 StructerTestingT) *FakeStructer {
 			c string
 			d string
 		}) {
-			t_sym1.Fatal("Unexpected call to Structer.NamedStruct")
+			t.Fatal("Unexpected call to Structer.NamedStruct")
 			return
 		},
 	}
 }
 
 // NewFakeStructerDefaultError returns an instance of FakeStructer with all hooks configured to call t.Error
-func NewFakeStructerDefaultError(t_sym2 StructerTestingT) *FakeStructer {
+func NewFakeStructerDefaultError(t StructerTestingT) *FakeStructer {
+	return &FakeStructer{
+		StructHook: func(struct {
+			a string
+			b string
+		}) (ident2 struct {
+			c string
+			d string
+		}) {
+			t.Error("Unexpected call to Structer.Struct")
+			return
+		},
+		NamedStructHook: func(struct {
+			a string
+			b string
+		}) (z struct {
+			c string
+			d string
+		}) {
+			t.Error("Unexpected call to Structer.NamedStruct")
+			return
+		},
+	}
+}
+
+// NewFakeStructerDefaultZero returns an instance of FakeStructer with all hooks configured to return zero values
+func NewFakeStructerDefaultZero() *FakeStructer {
+	return &FakeStructer{
+		StructHook: func(struct {
+			a string
+			b string
+		}) (ident2 struct {
+			c string
+			d string
+		}) {
+			return
+		},
+		NamedStructHook: func(struct {
+			a string
+			b string
+		}) (z struct {
+			c string
+			d string
+		}) {
+			return
+		},
+	}
+}
+
+// NewFakeStructerDefaultFriendly returns an instance of FakeStructer with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeStructer are friendly fakes, one for each method and result, created on first use
+func NewFakeStructerDefaultFriendly() *FakeStructer {
+
 	return &FakeStructer{
 		StructHook: func(struct {
 			a string
@@ -188,7 +462,6 @@ func NewFakeStructerDefaultError(t_sym2 StructerTestingT) *FakeStructer {
 			c string
 			d string
 		}) {
-			t_sym2.Error("Unexpected call to Structer.Struct")
 			return
 		},
 		NamedStructHook: func(struct {
@@ -198,46 +471,176 @@ func NewFakeStructerDefaultError(t_sym2 StructerTestingT) *FakeStructer {
 			c string
 			d string
 		}) {
-			t_sym2.Error("Unexpected call to Structer.NamedStruct")
 			return
 		},
 	}
 }
 
+// NewFakeStructerSpy returns an instance of FakeStructer with all hooks configured to call the given implementation
+func NewFakeStructerSpy(real Structer) *FakeStructer {
+	return &FakeStructer{
+		StructHook:      real.Struct,
+		NamedStructHook: real.NamedStruct,
+	}
+}
+
+// Reset forgets all calls made to FakeStructer
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
 func (f *FakeStructer) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsStruct.Rebase(len(f.StructCalls))
 	f.StructCalls = []*StructerStructInvocation{}
+	f.returnsNamedStruct.Rebase(len(f.NamedStructCalls))
 	f.NamedStructCalls = []*StructerNamedStructInvocation{}
 }
 
-func (f_sym3 *FakeStructer) Struct(ident1 struct {
+// SetCopyParameters configures FakeStructer to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeStructer) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeStructer) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeStructer) Struct(ident1 struct {
 	a string
 	b string
 }) (ident2 struct {
 	c string
 	d string
 }) {
-	if f_sym3.StructHook == nil {
+	f.mutex.Lock()
+	hook := f.StructHook
+	expectation, t := f.expectedStruct(ident1)
+	var results StructerStructResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsStruct.Lookup(len(f.StructCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Structer.Struct() called after the results given to FakeStructer.SetStructReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Structer.Struct() called but FakeStructer.StructHook is nil")
 	}
 
-	invocation_sym3 := new(StructerStructInvocation)
-	f_sym3.StructCalls = append(f_sym3.StructCalls, invocation_sym3)
+	invocation := new(StructerStructInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.StructCalls = append(f.StructCalls, invocation)
+
+	invocation.Parameters.Ident1 = ident1
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Ident1 = fake.CopyParameter(invocation.Parameters.Ident1, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Parameters.Ident1 = ident1
+	if t != nil && expectation == nil {
+		t.Errorf("FakeStructer.Struct called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	ident2 = f_sym3.StructHook(ident1)
+	if found {
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident2 = hook(ident1)
+	}
 
-	invocation_sym3.Results.Ident2 = ident2
+	f.mutex.Lock()
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedStruct returns the first unsatisfied expectation of FakeStructer.Struct matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeStructer) expectedStruct(ident1 struct {
+	a string
+	b string
+}) (*StructerStructExpectation, StructerTestingT) {
+	if len(f.expectationsStruct) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsStruct {
+		if expectation.count < expectation.times && expectation.matches(ident1) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsStruct[0].t
+}
+
+// ExpectStruct expects calls of FakeStructer.Struct, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeStructer) ExpectStruct(t StructerTestingT) *StructerStructExpectation {
+	t.Helper()
+	expectation := &StructerStructExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsStruct = append(f.expectationsStruct, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeStructer.Struct called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetStructHook configures Structer.Struct to call the given function
+func (f *FakeStructer) SetStructHook(hook func(struct {
+	a string
+	b string
+}) struct {
+	c string
+	d string
+}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.StructHook = hook
+}
+
 // SetStructStub configures Structer.Struct to always return the given values
-func (f_sym4 *FakeStructer) SetStructStub(ident2 struct {
+func (f *FakeStructer) SetStructStub(ident2 struct {
 	c string
 	d string
 }) {
-	f_sym4.StructHook = func(struct {
+	f.SetStructHook(func(struct {
 		a string
 		b string
 	}) struct {
@@ -245,42 +648,100 @@ func (f_sym4 *FakeStructer) SetStructStub(ident2 struct {
 		d string
 	} {
 		return ident2
-	}
+	})
+}
+
+// SetStructReturnsOnCall configures Structer.Struct to return the given values from the call with the given index in StructCalls, rather than calling the hook
+func (f *FakeStructer) SetStructReturnsOnCall(call int, ident2 struct {
+	c string
+	d string
+}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsStruct.Set(call, StructerStructResults{Ident2: ident2})
+}
+
+// SetStructReturnsSequence configures the following calls of Structer.Struct to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeStructer) SetStructReturnsSequence(exhausted StructerExhausted, results ...StructerStructResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsStruct.Sequence(len(f.StructCalls), exhausted, results)
 }
 
 // SetStructInvocation configures Structer.Struct to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym5 *FakeStructer) SetStructInvocation(calls_sym5 []*StructerStructInvocation, fallback_sym5 func() struct {
+func (f *FakeStructer) SetStructInvocation(calls []*StructerStructInvocation, fallback func() struct {
 	c string
 	d string
 }) {
-	f_sym5.StructHook = func(ident1 struct {
+	f.SetStructHook(func(ident1 struct {
 		a string
 		b string
 	}) (ident2 struct {
 		c string
 		d string
 	}) {
-		for _, call_sym5 := range calls_sym5 {
-			if reflect.DeepEqual(call_sym5.Parameters.Ident1, ident1) {
-				ident2 = call_sym5.Results.Ident2
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Ident1, call.Parameters.Ident1, ident1) {
+				ident2 = call.Results.Ident2
 
 				return
 			}
 		}
+		return fallback()
+	})
+}
 
-		return fallback_sym5()
+// StructCallsSnapshot returns a copy of the calls made to FakeStructer.Struct
+func (f *FakeStructer) StructCallsSnapshot() []*StructerStructInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*StructerStructInvocation, len(f.StructCalls))
+	for i, call := range f.StructCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// StructCall returns a StructerCallMatcher selecting the calls of FakeStructer.Struct with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) StructCall(ident1 StructerMatcher[struct {
+	a string
+	b string
+}]) StructerCallMatcher {
+	return fake.NewCallMatcher("FakeStructer.Struct(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*StructerStructInvocation(nil), f.StructCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // StructCalled returns true if FakeStructer.Struct was called
 func (f *FakeStructer) StructCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StructCalls) != 0
 }
 
 // AssertStructCalled calls t.Error if FakeStructer.Struct was not called
 func (f *FakeStructer) AssertStructCalled(t StructerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StructCalls) == 0 {
 		t.Error("FakeStructer.Struct not called, expected at least one")
 	}
@@ -288,12 +749,16 @@ func (f *FakeStructer) AssertStructCalled(t StructerTestingT) {
 
 // StructNotCalled returns true if FakeStructer.Struct was not called
 func (f *FakeStructer) StructNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StructCalls) == 0
 }
 
 // AssertStructNotCalled calls t.Error if FakeStructer.Struct was called
 func (f *FakeStructer) AssertStructNotCalled(t StructerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StructCalls) != 0 {
 		t.Error("FakeStructer.Struct called, expected none")
 	}
@@ -301,12 +766,16 @@ func (f *FakeStructer) AssertStructNotCalled(t StructerTestingT) {
 
 // StructCalledOnce returns true if FakeStructer.Struct was called exactly once
 func (f *FakeStructer) StructCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StructCalls) == 1
 }
 
 // AssertStructCalledOnce calls t.Error if FakeStructer.Struct was not called exactly once
 func (f *FakeStructer) AssertStructCalledOnce(t StructerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StructCalls) != 1 {
 		t.Errorf("FakeStructer.Struct called %d times, expected 1", len(f.StructCalls))
 	}
@@ -314,24 +783,98 @@ func (f *FakeStructer) AssertStructCalledOnce(t StructerTestingT) {
 
 // StructCalledN returns true if FakeStructer.Struct was called at least n times
 func (f *FakeStructer) StructCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.StructCalls) >= n
 }
 
 // AssertStructCalledN calls t.Error if FakeStructer.Struct was called less than n times
 func (f *FakeStructer) AssertStructCalledN(t StructerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.StructCalls) < n {
 		t.Errorf("FakeStructer.Struct called %d times, expected >= %d", len(f.StructCalls), n)
 	}
 }
 
-// StructCalledWith returns true if FakeStructer.Struct was called with the given values
-func (f_sym6 *FakeStructer) StructCalledWith(ident1 struct {
+// WaitForStructCalled blocks until FakeStructer.Struct has been called, returning the error of ctx if it is done first
+func (f *FakeStructer) WaitForStructCalled(ctx context.Context) error {
+	return f.WaitForStructCalledN(ctx, 1)
+}
+
+// WaitForStructCalledN blocks until FakeStructer.Struct has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeStructer) WaitForStructCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.StructCalls) >= n
+	})
+}
+
+// AssertStructEventuallyCalled calls t.Error if FakeStructer.Struct is not called within the timeout
+func (f *FakeStructer) AssertStructEventuallyCalled(t StructerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForStructCalled(ctx) != nil {
+		t.Errorf("FakeStructer.Struct not called within %v", timeout)
+	}
+}
+
+// describeStructCalls describes the calls of FakeStructer.Struct against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeStructer) describeStructCalls(ident1 StructerMatcher[struct {
 	a string
 	b string
-}) bool {
-	for _, call_sym6 := range f_sym6.StructCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.Ident1, ident1) {
+}]) string {
+	var b2 strings.Builder
+	b2.WriteString("expected:")
+	fmt.Fprintf(&b2, "\n\tIdent1: %s", fake.Describe(ident1))
+
+	if len(f.StructCalls) == 0 {
+		b2.WriteString("\nrecorded calls: none")
+		return b2.String()
+	}
+
+	b2.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.StructCalls {
+		matched := 0
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b2, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b2, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b2.String()
+	}
+
+	call := f.StructCalls[closest]
+	fmt.Fprintf(&b2, "\nclosest call %s differs in:", call)
+	if !fake.Matches(ident1, call.Parameters.Ident1) {
+		fmt.Fprintf(&b2, "\n\tIdent1: got %s, want %s", fake.Format(call.Parameters.Ident1), fake.Describe(ident1))
+	}
+
+	return b2.String()
+}
+
+// StructCalledWith returns true if FakeStructer.Struct was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) StructCalledWith(ident1 StructerMatcher[struct {
+	a string
+	b string
+}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.StructCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
 			return true
 		}
 	}
@@ -339,70 +882,104 @@ func (f_sym6 *FakeStructer) StructCalledWith(ident1 struct {
 	return false
 }
 
-// AssertStructCalledWith calls t.Error if FakeStructer.Struct was not called with the given values
-func (f_sym7 *FakeStructer) AssertStructCalledWith(t StructerTestingT, ident1 struct {
+// AssertStructCalledWith calls t.Error if FakeStructer.Struct was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) AssertStructCalledWith(t StructerTestingT, ident1 StructerMatcher[struct {
 	a string
 	b string
-}) {
+}]) {
 	t.Helper()
-	var found_sym7 bool
-	for _, call_sym7 := range f_sym7.StructCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.Ident1, ident1) {
-			found_sym7 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.StructCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym7 {
-		t.Error("FakeStructer.Struct not called with expected parameters")
+	if !found {
+		t.Errorf("FakeStructer.Struct not called with expected parameters\n%s", f.describeStructCalls(ident1))
 	}
 }
 
-// StructCalledOnceWith returns true if FakeStructer.Struct was called exactly once with the given values
-func (f_sym8 *FakeStructer) StructCalledOnceWith(ident1 struct {
+// StructCalledOnceWith returns true if FakeStructer.Struct was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) StructCalledOnceWith(ident1 StructerMatcher[struct {
 	a string
 	b string
-}) bool {
-	var count_sym8 int
-	for _, call_sym8 := range f_sym8.StructCalls {
-		if reflect.DeepEqual(call_sym8.Parameters.Ident1, ident1) {
-			count_sym8++
+}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.StructCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	return count_sym8 == 1
+	return count == 1
 }
 
-// AssertStructCalledOnceWith calls t.Error if FakeStructer.Struct was not called exactly once with the given values
-func (f_sym9 *FakeStructer) AssertStructCalledOnceWith(t StructerTestingT, ident1 struct {
+// AssertStructCalledOnceWith calls t.Error if FakeStructer.Struct was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) AssertStructCalledOnceWith(t StructerTestingT, ident1 StructerMatcher[struct {
 	a string
 	b string
-}) {
+}]) {
 	t.Helper()
-	var count_sym9 int
-	for _, call_sym9 := range f_sym9.StructCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.Ident1, ident1) {
-			count_sym9++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.StructCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			count++
 		}
 	}
 
-	if count_sym9 != 1 {
-		t.Errorf("FakeStructer.Struct called %d times with expected parameters, expected one", count_sym9)
+	if count != 1 {
+		t.Errorf("FakeStructer.Struct called %d times with expected parameters, expected one\n%s", count, f.describeStructCalls(ident1))
 	}
 }
 
-// StructResultsForCall returns the result values for the first call to FakeStructer.Struct with the given values
-func (f_sym10 *FakeStructer) StructResultsForCall(ident1 struct {
+// AssertStructEventuallyCalledWith calls t.Error if FakeStructer.Struct is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeStructer) AssertStructEventuallyCalledWith(t StructerTestingT, timeout time.Duration, ident1 StructerMatcher[struct {
 	a string
 	b string
-}) (ident2 struct {
+}]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.StructCalls)
+		for _, call := range f.StructCalls {
+			if fake.Matches(ident1, call.Parameters.Ident1) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeStructer.Struct not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeStructCalls(ident1))
+	}
+}
+
+// StructResultsForCall returns the result values for the first call to FakeStructer.Struct with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) StructResultsForCall(ident1 StructerMatcher[struct {
+	a string
+	b string
+}]) (ident2 struct {
 	c string
 	d string
-}, found_sym10 bool) {
-	for _, call_sym10 := range f_sym10.StructCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.Ident1, ident1) {
-			ident2 = call_sym10.Results.Ident2
-			found_sym10 = true
+}, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.StructCalls {
+		if fake.Matches(ident1, call.Parameters.Ident1) {
+			ident2 = call.Results.Ident2
+			found = true
 			break
 		}
 	}
@@ -410,35 +987,122 @@ func (f_sym10 *FakeStructer) StructResultsForCall(ident1 struct {
 	return
 }
 
-func (f_sym11 *FakeStructer) NamedStruct(a struct {
+func (f *FakeStructer) NamedStruct(a struct {
 	a string
 	b string
 }) (z struct {
 	c string
 	d string
 }) {
-	if f_sym11.NamedStructHook == nil {
+	f.mutex.Lock()
+	hook := f.NamedStructHook
+	expectation, t := f.expectedNamedStruct(a)
+	var results StructerNamedStructResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsNamedStruct.Lookup(len(f.NamedStructCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Structer.NamedStruct() called after the results given to FakeStructer.SetNamedStructReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
 		panic("Structer.NamedStruct() called but FakeStructer.NamedStructHook is nil")
 	}
 
-	invocation_sym11 := new(StructerNamedStructInvocation)
-	f_sym11.NamedStructCalls = append(f_sym11.NamedStructCalls, invocation_sym11)
+	invocation := new(StructerNamedStructInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.NamedStructCalls = append(f.NamedStructCalls, invocation)
 
-	invocation_sym11.Parameters.A = a
+	invocation.Parameters.A = a
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+	}
 
-	z = f_sym11.NamedStructHook(a)
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeStructer.NamedStruct called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	invocation_sym11.Results.Z = z
+	if found {
+		z = results.Z
+	} else if hook != nil {
+		z = hook(a)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Z = z
+	f.mutex.Unlock()
 
 	return
 }
 
+// expectedNamedStruct returns the first unsatisfied expectation of FakeStructer.NamedStruct matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeStructer) expectedNamedStruct(a struct {
+	a string
+	b string
+}) (*StructerNamedStructExpectation, StructerTestingT) {
+	if len(f.expectationsNamedStruct) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsNamedStruct {
+		if expectation.count < expectation.times && expectation.matches(a) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsNamedStruct[0].t
+}
+
+// ExpectNamedStruct expects calls of FakeStructer.NamedStruct, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeStructer) ExpectNamedStruct(t StructerTestingT) *StructerNamedStructExpectation {
+	t.Helper()
+	expectation := &StructerNamedStructExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsNamedStruct = append(f.expectationsNamedStruct, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeStructer.NamedStruct called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetNamedStructHook configures Structer.NamedStruct to call the given function
+func (f *FakeStructer) SetNamedStructHook(hook func(struct {
+	a string
+	b string
+}) struct {
+	c string
+	d string
+}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.NamedStructHook = hook
+}
+
 // SetNamedStructStub configures Structer.NamedStruct to always return the given values
-func (f_sym12 *FakeStructer) SetNamedStructStub(z struct {
+func (f *FakeStructer) SetNamedStructStub(z struct {
 	c string
 	d string
 }) {
-	f_sym12.NamedStructHook = func(struct {
+	f.SetNamedStructHook(func(struct {
 		a string
 		b string
 	}) struct {
@@ -446,42 +1110,100 @@ func (f_sym12 *FakeStructer) SetNamedStructStub(z struct {
 		d string
 	} {
 		return z
-	}
+	})
+}
+
+// SetNamedStructReturnsOnCall configures Structer.NamedStruct to return the given values from the call with the given index in NamedStructCalls, rather than calling the hook
+func (f *FakeStructer) SetNamedStructReturnsOnCall(call int, z struct {
+	c string
+	d string
+}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedStruct.Set(call, StructerNamedStructResults{Z: z})
+}
+
+// SetNamedStructReturnsSequence configures the following calls of Structer.NamedStruct to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeStructer) SetNamedStructReturnsSequence(exhausted StructerExhausted, results ...StructerNamedStructResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsNamedStruct.Sequence(len(f.NamedStructCalls), exhausted, results)
 }
 
 // SetNamedStructInvocation configures Structer.NamedStruct to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
 // If no match is found for an invocation the result(s) of the fallback function are returned
-func (f_sym13 *FakeStructer) SetNamedStructInvocation(calls_sym13 []*StructerNamedStructInvocation, fallback_sym13 func() struct {
+func (f *FakeStructer) SetNamedStructInvocation(calls []*StructerNamedStructInvocation, fallback func() struct {
 	c string
 	d string
 }) {
-	f_sym13.NamedStructHook = func(a struct {
+	f.SetNamedStructHook(func(a struct {
 		a string
 		b string
 	}) (z struct {
 		c string
 		d string
 	}) {
-		for _, call_sym13 := range calls_sym13 {
-			if reflect.DeepEqual(call_sym13.Parameters.A, a) {
-				z = call_sym13.Results.Z
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.A, call.Parameters.A, a) {
+				z = call.Results.Z
 
 				return
 			}
 		}
+		return fallback()
+	})
+}
 
-		return fallback_sym13()
+// NamedStructCallsSnapshot returns a copy of the calls made to FakeStructer.NamedStruct
+func (f *FakeStructer) NamedStructCallsSnapshot() []*StructerNamedStructInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*StructerNamedStructInvocation, len(f.NamedStructCalls))
+	for i, call := range f.NamedStructCalls {
+		invocation := *call
+		calls[i] = &invocation
 	}
+
+	return calls
+}
+
+// NamedStructCall returns a StructerCallMatcher selecting the calls of FakeStructer.NamedStruct with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) NamedStructCall(a StructerMatcher[struct {
+	a string
+	b string
+}]) StructerCallMatcher {
+	return fake.NewCallMatcher("FakeStructer.NamedStruct(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*StructerNamedStructInvocation(nil), f.NamedStructCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
 }
 
 // NamedStructCalled returns true if FakeStructer.NamedStruct was called
 func (f *FakeStructer) NamedStructCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedStructCalls) != 0
 }
 
 // AssertNamedStructCalled calls t.Error if FakeStructer.NamedStruct was not called
 func (f *FakeStructer) AssertNamedStructCalled(t StructerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedStructCalls) == 0 {
 		t.Error("FakeStructer.NamedStruct not called, expected at least one")
 	}
@@ -489,12 +1211,16 @@ func (f *FakeStructer) AssertNamedStructCalled(t StructerTestingT) {
 
 // NamedStructNotCalled returns true if FakeStructer.NamedStruct was not called
 func (f *FakeStructer) NamedStructNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedStructCalls) == 0
 }
 
 // AssertNamedStructNotCalled calls t.Error if FakeStructer.NamedStruct was called
 func (f *FakeStructer) AssertNamedStructNotCalled(t StructerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedStructCalls) != 0 {
 		t.Error("FakeStructer.NamedStruct called, expected none")
 	}
@@ -502,12 +1228,16 @@ func (f *FakeStructer) AssertNamedStructNotCalled(t StructerTestingT) {
 
 // NamedStructCalledOnce returns true if FakeStructer.NamedStruct was called exactly once
 func (f *FakeStructer) NamedStructCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedStructCalls) == 1
 }
 
 // AssertNamedStructCalledOnce calls t.Error if FakeStructer.NamedStruct was not called exactly once
 func (f *FakeStructer) AssertNamedStructCalledOnce(t StructerTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedStructCalls) != 1 {
 		t.Errorf("FakeStructer.NamedStruct called %d times, expected 1", len(f.NamedStructCalls))
 	}
@@ -515,24 +1245,98 @@ func (f *FakeStructer) AssertNamedStructCalledOnce(t StructerTestingT) {
 
 // NamedStructCalledN returns true if FakeStructer.NamedStruct was called at least n times
 func (f *FakeStructer) NamedStructCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.NamedStructCalls) >= n
 }
 
 // AssertNamedStructCalledN calls t.Error if FakeStructer.NamedStruct was called less than n times
 func (f *FakeStructer) AssertNamedStructCalledN(t StructerTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.NamedStructCalls) < n {
 		t.Errorf("FakeStructer.NamedStruct called %d times, expected >= %d", len(f.NamedStructCalls), n)
 	}
 }
 
-// NamedStructCalledWith returns true if FakeStructer.NamedStruct was called with the given values
-func (f_sym14 *FakeStructer) NamedStructCalledWith(a struct {
+// WaitForNamedStructCalled blocks until FakeStructer.NamedStruct has been called, returning the error of ctx if it is done first
+func (f *FakeStructer) WaitForNamedStructCalled(ctx context.Context) error {
+	return f.WaitForNamedStructCalledN(ctx, 1)
+}
+
+// WaitForNamedStructCalledN blocks until FakeStructer.NamedStruct has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeStructer) WaitForNamedStructCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.NamedStructCalls) >= n
+	})
+}
+
+// AssertNamedStructEventuallyCalled calls t.Error if FakeStructer.NamedStruct is not called within the timeout
+func (f *FakeStructer) AssertNamedStructEventuallyCalled(t StructerTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForNamedStructCalled(ctx) != nil {
+		t.Errorf("FakeStructer.NamedStruct not called within %v", timeout)
+	}
+}
+
+// describeNamedStructCalls describes the calls of FakeStructer.NamedStruct against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeStructer) describeNamedStructCalls(a StructerMatcher[struct {
 	a string
 	b string
-}) bool {
-	for _, call_sym14 := range f_sym14.NamedStructCalls {
-		if reflect.DeepEqual(call_sym14.Parameters.A, a) {
+}]) string {
+	var b2 strings.Builder
+	b2.WriteString("expected:")
+	fmt.Fprintf(&b2, "\n\tA: %s", fake.Describe(a))
+
+	if len(f.NamedStructCalls) == 0 {
+		b2.WriteString("\nrecorded calls: none")
+		return b2.String()
+	}
+
+	b2.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.NamedStructCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b2, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b2, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b2.String()
+	}
+
+	call := f.NamedStructCalls[closest]
+	fmt.Fprintf(&b2, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b2, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+
+	return b2.String()
+}
+
+// NamedStructCalledWith returns true if FakeStructer.NamedStruct was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) NamedStructCalledWith(a StructerMatcher[struct {
+	a string
+	b string
+}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedStructCalls {
+		if fake.Matches(a, call.Parameters.A) {
 			return true
 		}
 	}
@@ -540,70 +1344,104 @@ func (f_sym14 *FakeStructer) NamedStructCalledWith(a struct {
 	return false
 }
 
-// AssertNamedStructCalledWith calls t.Error if FakeStructer.NamedStruct was not called with the given values
-func (f_sym15 *FakeStructer) AssertNamedStructCalledWith(t StructerTestingT, a struct {
+// AssertNamedStructCalledWith calls t.Error if FakeStructer.NamedStruct was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) AssertNamedStructCalledWith(t StructerTestingT, a StructerMatcher[struct {
 	a string
 	b string
-}) {
+}]) {
 	t.Helper()
-	var found_sym15 bool
-	for _, call_sym15 := range f_sym15.NamedStructCalls {
-		if reflect.DeepEqual(call_sym15.Parameters.A, a) {
-			found_sym15 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.NamedStructCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym15 {
-		t.Error("FakeStructer.NamedStruct not called with expected parameters")
+	if !found {
+		t.Errorf("FakeStructer.NamedStruct not called with expected parameters\n%s", f.describeNamedStructCalls(a))
 	}
 }
 
-// NamedStructCalledOnceWith returns true if FakeStructer.NamedStruct was called exactly once with the given values
-func (f_sym16 *FakeStructer) NamedStructCalledOnceWith(a struct {
+// NamedStructCalledOnceWith returns true if FakeStructer.NamedStruct was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) NamedStructCalledOnceWith(a StructerMatcher[struct {
 	a string
 	b string
-}) bool {
-	var count_sym16 int
-	for _, call_sym16 := range f_sym16.NamedStructCalls {
-		if reflect.DeepEqual(call_sym16.Parameters.A, a) {
-			count_sym16++
+}]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedStructCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			count++
 		}
 	}
 
-	return count_sym16 == 1
+	return count == 1
 }
 
-// AssertNamedStructCalledOnceWith calls t.Error if FakeStructer.NamedStruct was not called exactly once with the given values
-func (f_sym17 *FakeStructer) AssertNamedStructCalledOnceWith(t StructerTestingT, a struct {
+// AssertNamedStructCalledOnceWith calls t.Error if FakeStructer.NamedStruct was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) AssertNamedStructCalledOnceWith(t StructerTestingT, a StructerMatcher[struct {
 	a string
 	b string
-}) {
+}]) {
 	t.Helper()
-	var count_sym17 int
-	for _, call_sym17 := range f_sym17.NamedStructCalls {
-		if reflect.DeepEqual(call_sym17.Parameters.A, a) {
-			count_sym17++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.NamedStructCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			count++
 		}
 	}
 
-	if count_sym17 != 1 {
-		t.Errorf("FakeStructer.NamedStruct called %d times with expected parameters, expected one", count_sym17)
+	if count != 1 {
+		t.Errorf("FakeStructer.NamedStruct called %d times with expected parameters, expected one\n%s", count, f.describeNamedStructCalls(a))
 	}
 }
 
-// NamedStructResultsForCall returns the result values for the first call to FakeStructer.NamedStruct with the given values
-func (f_sym18 *FakeStructer) NamedStructResultsForCall(a struct {
+// AssertNamedStructEventuallyCalledWith calls t.Error if FakeStructer.NamedStruct is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeStructer) AssertNamedStructEventuallyCalledWith(t StructerTestingT, timeout time.Duration, a StructerMatcher[struct {
 	a string
 	b string
-}) (z struct {
+}]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.NamedStructCalls)
+		for _, call := range f.NamedStructCalls {
+			if fake.Matches(a, call.Parameters.A) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeStructer.NamedStruct not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeNamedStructCalls(a))
+	}
+}
+
+// NamedStructResultsForCall returns the result values for the first call to FakeStructer.NamedStruct with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeStructer) NamedStructResultsForCall(a StructerMatcher[struct {
+	a string
+	b string
+}]) (z struct {
 	c string
 	d string
-}, found_sym18 bool) {
-	for _, call_sym18 := range f_sym18.NamedStructCalls {
-		if reflect.DeepEqual(call_sym18.Parameters.A, a) {
-			z = call_sym18.Results.Z
-			found_sym18 = true
+}, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.NamedStructCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			z = call.Results.Z
+			found = true
 			break
 		}
 	}
@@ -0,0 +1,8 @@
+package main
+
+// Summarizer shortens text without ending its doc comment in a period
+//
+// The summary of the fake's doc comment must not become a heading
+type Summarizer interface {
+	Summarize(text string, words int) string
+}
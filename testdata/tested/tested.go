@@ -0,0 +1,5 @@
+package tested
+
+type Source interface {
+	Next() int
+}
@@ -0,0 +1,9 @@
+package tested
+
+type (
+	Clock interface {
+		Now() int64
+	}
+
+	Empty interface{}
+)
@@ -0,0 +1,12 @@
+package tested_test
+
+import "github.com/percolate/charlatan/testdata/tested"
+
+type Sink interface {
+	Drain(tested.Source) error
+}
+
+// Source is also declared by the package under test
+type Source interface {
+	Next() int64
+}
@@ -0,0 +1,1600 @@
+// generated by "charlatan -dir=testdata/transactor -output=testdata/transactor/transactor.go Transactor".  DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
+
+// TransactorBeginInvocation represents a single call of FakeTransactor.Begin
+type TransactorBeginInvocation struct {
+	Results TransactorBeginResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *TransactorBeginInvocation) String() string {
+	return "FakeTransactor.Begin()"
+}
+
+// TransactorBeginResults holds the results of a single call of FakeTransactor.Begin
+type TransactorBeginResults struct {
+	Ident1 error
+}
+
+// TransactorBeginExpectation is a call of FakeTransactor.Begin expected by a test, created by FakeTransactor.ExpectBegin
+type TransactorBeginExpectation struct {
+	fake    *FakeTransactor
+	t       TransactorTestingT
+	times   int
+	count   int
+	returns bool
+	results TransactorBeginResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *TransactorBeginExpectation) Times(n int) *TransactorBeginExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *TransactorBeginExpectation) Return(ident1 error) *TransactorBeginExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = TransactorBeginResults{Ident1: ident1}
+	return e
+}
+
+// TransactorExecInvocation represents a single call of FakeTransactor.Exec
+type TransactorExecInvocation struct {
+	Parameters struct {
+		Query string
+	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetExecInvocation
+	Matchers struct {
+		Query TransactorMatcher[string]
+	}
+	Results TransactorExecResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *TransactorExecInvocation) String() string {
+	return fmt.Sprintf("FakeTransactor.Exec%+v", i.Parameters)
+}
+
+// TransactorExecResults holds the results of a single call of FakeTransactor.Exec
+type TransactorExecResults struct {
+	Ident1 int64
+	Ident2 error
+}
+
+// NewTransactorExecInvocation creates a new instance of TransactorExecInvocation
+func NewTransactorExecInvocation(query string, ident1 int64, ident2 error) *TransactorExecInvocation {
+	invocation := new(TransactorExecInvocation)
+
+	invocation.Parameters.Query = query
+
+	invocation.Results.Ident1 = ident1
+	invocation.Results.Ident2 = ident2
+
+	return invocation
+}
+
+// TransactorExecExpectation is a call of FakeTransactor.Exec expected by a test, created by FakeTransactor.ExpectExec
+type TransactorExecExpectation struct {
+	fake     *FakeTransactor
+	t        TransactorTestingT
+	times    int
+	count    int
+	matchers struct {
+		Query TransactorMatcher[string]
+	}
+	returns bool
+	results TransactorExecResults
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *TransactorExecExpectation) With(query TransactorMatcher[string]) *TransactorExecExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.Query = query
+
+	return e
+}
+
+func (e *TransactorExecExpectation) matches(query string) bool {
+	return fake.Matches(e.matchers.Query, query)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *TransactorExecExpectation) Times(n int) *TransactorExecExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *TransactorExecExpectation) Return(ident1 int64, ident2 error) *TransactorExecExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = TransactorExecResults{Ident1: ident1, Ident2: ident2}
+	return e
+}
+
+// TransactorCommitInvocation represents a single call of FakeTransactor.Commit
+type TransactorCommitInvocation struct {
+	Results TransactorCommitResults
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *TransactorCommitInvocation) String() string {
+	return "FakeTransactor.Commit()"
+}
+
+// TransactorCommitResults holds the results of a single call of FakeTransactor.Commit
+type TransactorCommitResults struct {
+	Ident1 error
+}
+
+// TransactorCommitExpectation is a call of FakeTransactor.Commit expected by a test, created by FakeTransactor.ExpectCommit
+type TransactorCommitExpectation struct {
+	fake    *FakeTransactor
+	t       TransactorTestingT
+	times   int
+	count   int
+	returns bool
+	results TransactorCommitResults
+}
+
+// Times sets the number of expected calls, one by default
+func (e *TransactorCommitExpectation) Times(n int) *TransactorCommitExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// Return sets the values returned from the expected calls, rather than calling the hook
+func (e *TransactorCommitExpectation) Return(ident1 error) *TransactorCommitExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.returns = true
+	e.results = TransactorCommitResults{Ident1: ident1}
+	return e
+}
+
+// TransactorRollbackInvocation represents a single call of FakeTransactor.Rollback
+type TransactorRollbackInvocation struct {
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *TransactorRollbackInvocation) String() string {
+	return "FakeTransactor.Rollback()"
+}
+
+// TransactorRollbackExpectation is a call of FakeTransactor.Rollback expected by a test, created by FakeTransactor.ExpectRollback
+type TransactorRollbackExpectation struct {
+	fake  *FakeTransactor
+	t     TransactorTestingT
+	times int
+	count int
+}
+
+// Times sets the number of expected calls, one by default
+func (e *TransactorRollbackExpectation) Times(n int) *TransactorRollbackExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
+}
+
+// TransactorTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
+type TransactorTestingT = fake.TestingT
+
+// TransactorMatcher matches a parameter of a call to FakeTransactor
+type TransactorMatcher[T any] interface {
+	Match(T) bool
+}
+
+// TransactorMatcherFunc is a TransactorMatcher implemented by a predicate function
+type TransactorMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m TransactorMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// TransactorAny returns a TransactorMatcher that matches any value
+func TransactorAny[T any]() TransactorMatcher[T] {
+	return fake.Any[T]()
+}
+
+// TransactorEq returns a TransactorMatcher that matches values deeply equal to want
+func TransactorEq[T any](want T) TransactorMatcher[T] {
+	return fake.Eq(want)
+}
+
+// TransactorNot returns a TransactorMatcher that matches the values the given matcher does not
+func TransactorNot[T any](m TransactorMatcher[T]) TransactorMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// TransactorPred returns a TransactorMatcher that matches values for which the given predicate returns true
+func TransactorPred[T any](pred func(T) bool) TransactorMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// TransactorAnyOfType returns a TransactorMatcher that matches values whose dynamic type is, or implements, U
+func TransactorAnyOfType[T, U any]() TransactorMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// TransactorCallMatcher selects calls of a method of a fake for TransactorInOrder and TransactorUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeTransactor
+type TransactorCallMatcher = fake.CallMatcher
+
+// TransactorInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func TransactorInOrder(t TransactorTestingT, calls ...TransactorCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// TransactorUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func TransactorUnordered(t TransactorTestingT, calls ...TransactorCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
+}
+
+// TransactorExhausted selects how a method of FakeTransactor behaves once the results given to its SetXReturnsSequence method are used up
+type TransactorExhausted = fake.Exhausted
+
+const (
+	// TransactorRepeatLast returns the last results in the sequence from all later calls
+	TransactorRepeatLast = fake.RepeatLast
+	// TransactorPanicAfterSequence panics on all later calls
+	TransactorPanicAfterSequence = fake.PanicAfterSequence
+	// TransactorHookAfterSequence calls the method's hook on all later calls
+	TransactorHookAfterSequence = fake.HookAfterSequence
+)
+
+/*
+FakeTransactor is a mock implementation of Transactor for testing.
+Use it in your tests as in this example:
+
+	package example
+
+	func TestWithTransactor(t *testing.T) {
+		f := &main.FakeTransactor{
+			BeginHook: func() (ident1 error) {
+				// ensure parameters meet expectations, signal errors using t, etc
+				return
+			},
+		}
+
+		// test code goes here ...
+
+		// assert state of FakeTransactor ...
+		f.AssertBeginCalledOnce(t)
+	}
+
+Create anonymous function implementations for only those interface methods that
+should be called in the code under test.  This will force a panic if any
+unexpected calls are made to FakeTransactor.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
+*/
+type FakeTransactor struct {
+	BeginHook    func() error
+	ExecHook     func(string) (int64, error)
+	CommitHook   func() error
+	RollbackHook func()
+
+	BeginCalls    []*TransactorBeginInvocation
+	ExecCalls     []*TransactorExecInvocation
+	CommitCalls   []*TransactorCommitInvocation
+	RollbackCalls []*TransactorRollbackInvocation
+
+	returnsBegin         fake.Returns[TransactorBeginResults]
+	returnsExec          fake.Returns[TransactorExecResults]
+	returnsCommit        fake.Returns[TransactorCommitResults]
+	expectationsBegin    []*TransactorBeginExpectation
+	expectationsExec     []*TransactorExecExpectation
+	expectationsCommit   []*TransactorCommitExpectation
+	expectationsRollback []*TransactorRollbackExpectation
+	mutex                sync.Mutex
+	recorded             chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters       bool
+}
+
+// NewFakeTransactorDefaultPanic returns an instance of FakeTransactor with all hooks configured to panic
+func NewFakeTransactorDefaultPanic() *FakeTransactor {
+	return &FakeTransactor{
+		BeginHook: func() (ident1 error) {
+			panic("Unexpected call to Transactor.Begin")
+		},
+		ExecHook: func(string) (ident1 int64, ident2 error) {
+			panic("Unexpected call to Transactor.Exec")
+		},
+		CommitHook: func() (ident1 error) {
+			panic("Unexpected call to Transactor.Commit")
+		},
+		RollbackHook: func() {
+			panic("Unexpected call to Transactor.Rollback")
+		},
+	}
+}
+
+// NewFakeTransactorDefaultFatal returns an instance of FakeTransactor with all hooks configured to call t.Fatal
+func NewFakeTransactorDefaultFatal(t TransactorTestingT) *FakeTransactor {
+	return &FakeTransactor{
+		BeginHook: func() (ident1 error) {
+			t.Fatal("Unexpected call to Transactor.Begin")
+			return
+		},
+		ExecHook: func(string) (ident1 int64, ident2 error) {
+			t.Fatal("Unexpected call to Transactor.Exec")
+			return
+		},
+		CommitHook: func() (ident1 error) {
+			t.Fatal("Unexpected call to Transactor.Commit")
+			return
+		},
+		RollbackHook: func() {
+			t.Fatal("Unexpected call to Transactor.Rollback")
+			return
+		},
+	}
+}
+
+// NewFakeTransactorDefaultError returns an instance of FakeTransactor with all hooks configured to call t.Error
+func NewFakeTransactorDefaultError(t TransactorTestingT) *FakeTransactor {
+	return &FakeTransactor{
+		BeginHook: func() (ident1 error) {
+			t.Error("Unexpected call to Transactor.Begin")
+			return
+		},
+		ExecHook: func(string) (ident1 int64, ident2 error) {
+			t.Error("Unexpected call to Transactor.Exec")
+			return
+		},
+		CommitHook: func() (ident1 error) {
+			t.Error("Unexpected call to Transactor.Commit")
+			return
+		},
+		RollbackHook: func() {
+			t.Error("Unexpected call to Transactor.Rollback")
+			return
+		},
+	}
+}
+
+// NewFakeTransactorDefaultZero returns an instance of FakeTransactor with all hooks configured to return zero values
+func NewFakeTransactorDefaultZero() *FakeTransactor {
+	return &FakeTransactor{
+		BeginHook: func() (ident1 error) {
+			return
+		},
+		ExecHook: func(string) (ident1 int64, ident2 error) {
+			return
+		},
+		CommitHook: func() (ident1 error) {
+			return
+		},
+		RollbackHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeTransactorDefaultFriendly returns an instance of FakeTransactor with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeTransactor are friendly fakes, one for each method and result, created on first use
+func NewFakeTransactorDefaultFriendly() *FakeTransactor {
+
+	return &FakeTransactor{
+		BeginHook: func() (ident1 error) {
+			return
+		},
+		ExecHook: func(string) (ident1 int64, ident2 error) {
+			return
+		},
+		CommitHook: func() (ident1 error) {
+			return
+		},
+		RollbackHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeTransactorSpy returns an instance of FakeTransactor with all hooks configured to call the given implementation
+func NewFakeTransactorSpy(real Transactor) *FakeTransactor {
+	return &FakeTransactor{
+		BeginHook:    real.Begin,
+		ExecHook:     real.Exec,
+		CommitHook:   real.Commit,
+		RollbackHook: real.Rollback,
+	}
+}
+
+// Reset forgets all calls made to FakeTransactor
+// Results set for calls not yet made by the SetXReturnsOnCall and SetXReturnsSequence methods stay with those calls, which are numbered from zero again
+func (f *FakeTransactor) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsBegin.Rebase(len(f.BeginCalls))
+	f.BeginCalls = []*TransactorBeginInvocation{}
+	f.returnsExec.Rebase(len(f.ExecCalls))
+	f.ExecCalls = []*TransactorExecInvocation{}
+	f.returnsCommit.Rebase(len(f.CommitCalls))
+	f.CommitCalls = []*TransactorCommitInvocation{}
+	f.RollbackCalls = []*TransactorRollbackInvocation{}
+}
+
+// SetCopyParameters configures FakeTransactor to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeTransactor) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// FailAll configures every method of FakeTransactor whose last result is of type error to return the given error, with zero values for its other results, as its SetXError method does
+// Calls given results by the SetXReturnsOnCall, SetXReturnsSequence and SetXErrorOnCall methods or by an expectation's Return method still return those, rather than calling the hook
+func (f *FakeTransactor) FailAll(err error) {
+	f.SetBeginError(err)
+	f.SetExecError(err)
+	f.SetCommitError(err)
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeTransactor) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeTransactor) Begin() (ident1 error) {
+	f.mutex.Lock()
+	hook := f.BeginHook
+	expectation, t := f.expectedBegin()
+	var results TransactorBeginResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsBegin.Lookup(len(f.BeginCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Transactor.Begin() called after the results given to FakeTransactor.SetBeginReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
+		panic("Transactor.Begin() called but FakeTransactor.BeginHook is nil")
+	}
+
+	invocation := new(TransactorBeginInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.BeginCalls = append(f.BeginCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeTransactor.Begin called more times than expected")
+	}
+
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
+
+	return
+}
+
+// expectedBegin returns the first unsatisfied expectation of FakeTransactor.Begin matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeTransactor) expectedBegin() (*TransactorBeginExpectation, TransactorTestingT) {
+	if len(f.expectationsBegin) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsBegin {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsBegin[0].t
+}
+
+// ExpectBegin expects calls of FakeTransactor.Begin, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeTransactor) ExpectBegin(t TransactorTestingT) *TransactorBeginExpectation {
+	t.Helper()
+	expectation := &TransactorBeginExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsBegin = append(f.expectationsBegin, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeTransactor.Begin called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetBeginHook configures Transactor.Begin to call the given function
+func (f *FakeTransactor) SetBeginHook(hook func() error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.BeginHook = hook
+}
+
+// SetBeginStub configures Transactor.Begin to always return the given values
+func (f *FakeTransactor) SetBeginStub(ident1 error) {
+	f.SetBeginHook(func() error {
+		return ident1
+	})
+}
+
+// SetBeginReturnsOnCall configures Transactor.Begin to return the given values from the call with the given index in BeginCalls, rather than calling the hook
+func (f *FakeTransactor) SetBeginReturnsOnCall(call int, ident1 error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsBegin.Set(call, TransactorBeginResults{Ident1: ident1})
+}
+
+// SetBeginReturnsSequence configures the following calls of Transactor.Begin to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeTransactor) SetBeginReturnsSequence(exhausted TransactorExhausted, results ...TransactorBeginResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsBegin.Sequence(len(f.BeginCalls), exhausted, results)
+}
+
+// SetBeginError configures Transactor.Begin to always return the given error, with zero values for its other results
+func (f *FakeTransactor) SetBeginError(err error) {
+	f.SetBeginHook(func() (ident1 error) {
+		ident1 = err
+		return
+	})
+}
+
+// SetBeginErrorOnCall configures Transactor.Begin to return the given error, with zero values for its other results, from the call with the given index in BeginCalls, rather than calling the hook
+func (f *FakeTransactor) SetBeginErrorOnCall(call int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsBegin.Set(call, TransactorBeginResults{Ident1: err})
+}
+
+// BeginCallsSnapshot returns a copy of the calls made to FakeTransactor.Begin
+func (f *FakeTransactor) BeginCallsSnapshot() []*TransactorBeginInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*TransactorBeginInvocation, len(f.BeginCalls))
+	for i, call := range f.BeginCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// BeginCall returns a TransactorCallMatcher selecting the calls of FakeTransactor.Begin
+func (f *FakeTransactor) BeginCall() TransactorCallMatcher {
+	return fake.NewCallMatcher("FakeTransactor.Begin()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*TransactorBeginInvocation(nil), f.BeginCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
+}
+
+// BeginCalled returns true if FakeTransactor.Begin was called
+func (f *FakeTransactor) BeginCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.BeginCalls) != 0
+}
+
+// AssertBeginCalled calls t.Error if FakeTransactor.Begin was not called
+func (f *FakeTransactor) AssertBeginCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.BeginCalls) == 0 {
+		t.Error("FakeTransactor.Begin not called, expected at least one")
+	}
+}
+
+// BeginNotCalled returns true if FakeTransactor.Begin was not called
+func (f *FakeTransactor) BeginNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.BeginCalls) == 0
+}
+
+// AssertBeginNotCalled calls t.Error if FakeTransactor.Begin was called
+func (f *FakeTransactor) AssertBeginNotCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.BeginCalls) != 0 {
+		t.Error("FakeTransactor.Begin called, expected none")
+	}
+}
+
+// BeginCalledOnce returns true if FakeTransactor.Begin was called exactly once
+func (f *FakeTransactor) BeginCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.BeginCalls) == 1
+}
+
+// AssertBeginCalledOnce calls t.Error if FakeTransactor.Begin was not called exactly once
+func (f *FakeTransactor) AssertBeginCalledOnce(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.BeginCalls) != 1 {
+		t.Errorf("FakeTransactor.Begin called %d times, expected 1", len(f.BeginCalls))
+	}
+}
+
+// BeginCalledN returns true if FakeTransactor.Begin was called at least n times
+func (f *FakeTransactor) BeginCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.BeginCalls) >= n
+}
+
+// AssertBeginCalledN calls t.Error if FakeTransactor.Begin was called less than n times
+func (f *FakeTransactor) AssertBeginCalledN(t TransactorTestingT, n int) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.BeginCalls) < n {
+		t.Errorf("FakeTransactor.Begin called %d times, expected >= %d", len(f.BeginCalls), n)
+	}
+}
+
+// WaitForBeginCalled blocks until FakeTransactor.Begin has been called, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForBeginCalled(ctx context.Context) error {
+	return f.WaitForBeginCalledN(ctx, 1)
+}
+
+// WaitForBeginCalledN blocks until FakeTransactor.Begin has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForBeginCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.BeginCalls) >= n
+	})
+}
+
+// AssertBeginEventuallyCalled calls t.Error if FakeTransactor.Begin is not called within the timeout
+func (f *FakeTransactor) AssertBeginEventuallyCalled(t TransactorTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForBeginCalled(ctx) != nil {
+		t.Errorf("FakeTransactor.Begin not called within %v", timeout)
+	}
+}
+
+func (f *FakeTransactor) Exec(query string) (ident1 int64, ident2 error) {
+	f.mutex.Lock()
+	hook := f.ExecHook
+	expectation, t := f.expectedExec(query)
+	var results TransactorExecResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsExec.Lookup(len(f.ExecCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Transactor.Exec() called after the results given to FakeTransactor.SetExecReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
+		panic("Transactor.Exec() called but FakeTransactor.ExecHook is nil")
+	}
+
+	invocation := new(TransactorExecInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.ExecCalls = append(f.ExecCalls, invocation)
+
+	invocation.Parameters.Query = query
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.Query = fake.CopyParameter(invocation.Parameters.Query, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Errorf("FakeTransactor.Exec called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if found {
+		ident1 = results.Ident1
+		ident2 = results.Ident2
+	} else if hook != nil {
+		ident1, ident2 = hook(query)
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	invocation.Results.Ident2 = ident2
+	f.mutex.Unlock()
+
+	return
+}
+
+// expectedExec returns the first unsatisfied expectation of FakeTransactor.Exec matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeTransactor) expectedExec(query string) (*TransactorExecExpectation, TransactorTestingT) {
+	if len(f.expectationsExec) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsExec {
+		if expectation.count < expectation.times && expectation.matches(query) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsExec[0].t
+}
+
+// ExpectExec expects calls of FakeTransactor.Exec, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeTransactor) ExpectExec(t TransactorTestingT) *TransactorExecExpectation {
+	t.Helper()
+	expectation := &TransactorExecExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsExec = append(f.expectationsExec, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeTransactor.Exec called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetExecHook configures Transactor.Exec to call the given function
+func (f *FakeTransactor) SetExecHook(hook func(string) (int64, error)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.ExecHook = hook
+}
+
+// SetExecStub configures Transactor.Exec to always return the given values
+func (f *FakeTransactor) SetExecStub(ident1 int64, ident2 error) {
+	f.SetExecHook(func(string) (int64, error) {
+		return ident1, ident2
+	})
+}
+
+// SetExecReturnsOnCall configures Transactor.Exec to return the given values from the call with the given index in ExecCalls, rather than calling the hook
+func (f *FakeTransactor) SetExecReturnsOnCall(call int, ident1 int64, ident2 error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsExec.Set(call, TransactorExecResults{Ident1: ident1, Ident2: ident2})
+}
+
+// SetExecReturnsSequence configures the following calls of Transactor.Exec to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeTransactor) SetExecReturnsSequence(exhausted TransactorExhausted, results ...TransactorExecResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsExec.Sequence(len(f.ExecCalls), exhausted, results)
+}
+
+// SetExecError configures Transactor.Exec to always return the given error, with zero values for its other results
+func (f *FakeTransactor) SetExecError(err error) {
+	f.SetExecHook(func(string) (ident1 int64, ident2 error) {
+		ident2 = err
+		return
+	})
+}
+
+// SetExecErrorOnCall configures Transactor.Exec to return the given error, with zero values for its other results, from the call with the given index in ExecCalls, rather than calling the hook
+func (f *FakeTransactor) SetExecErrorOnCall(call int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsExec.Set(call, TransactorExecResults{Ident2: err})
+}
+
+// SetExecInvocation configures Transactor.Exec to return the given results when called with the given parameters
+// Each parameter is checked with the invocation's matcher if set, or compared to the invocation's parameter otherwise
+// If no match is found for an invocation the result(s) of the fallback function are returned
+func (f *FakeTransactor) SetExecInvocation(calls []*TransactorExecInvocation, fallback func() (int64, error)) {
+	f.SetExecHook(func(query string) (ident1 int64, ident2 error) {
+		for _, call := range calls {
+			if fake.MatchParameter(call.Matchers.Query, call.Parameters.Query, query) {
+				ident1 = call.Results.Ident1
+				ident2 = call.Results.Ident2
+
+				return
+			}
+		}
+
+		return fallback()
+	})
+}
+
+// ExecCallsSnapshot returns a copy of the calls made to FakeTransactor.Exec
+func (f *FakeTransactor) ExecCallsSnapshot() []*TransactorExecInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*TransactorExecInvocation, len(f.ExecCalls))
+	for i, call := range f.ExecCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// ExecCall returns a TransactorCallMatcher selecting the calls of FakeTransactor.Exec with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeTransactor) ExecCall(query TransactorMatcher[string]) TransactorCallMatcher {
+	return fake.NewCallMatcher("FakeTransactor.Exec(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*TransactorExecInvocation(nil), f.ExecCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(query, call.Parameters.Query) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
+// ExecCalled returns true if FakeTransactor.Exec was called
+func (f *FakeTransactor) ExecCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.ExecCalls) != 0
+}
+
+// AssertExecCalled calls t.Error if FakeTransactor.Exec was not called
+func (f *FakeTransactor) AssertExecCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.ExecCalls) == 0 {
+		t.Error("FakeTransactor.Exec not called, expected at least one")
+	}
+}
+
+// ExecNotCalled returns true if FakeTransactor.Exec was not called
+func (f *FakeTransactor) ExecNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.ExecCalls) == 0
+}
+
+// AssertExecNotCalled calls t.Error if FakeTransactor.Exec was called
+func (f *FakeTransactor) AssertExecNotCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.ExecCalls) != 0 {
+		t.Error("FakeTransactor.Exec called, expected none")
+	}
+}
+
+// ExecCalledOnce returns true if FakeTransactor.Exec was called exactly once
+func (f *FakeTransactor) ExecCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.ExecCalls) == 1
+}
+
+// AssertExecCalledOnce calls t.Error if FakeTransactor.Exec was not called exactly once
+func (f *FakeTransactor) AssertExecCalledOnce(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.ExecCalls) != 1 {
+		t.Errorf("FakeTransactor.Exec called %d times, expected 1", len(f.ExecCalls))
+	}
+}
+
+// ExecCalledN returns true if FakeTransactor.Exec was called at least n times
+func (f *FakeTransactor) ExecCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.ExecCalls) >= n
+}
+
+// AssertExecCalledN calls t.Error if FakeTransactor.Exec was called less than n times
+func (f *FakeTransactor) AssertExecCalledN(t TransactorTestingT, n int) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.ExecCalls) < n {
+		t.Errorf("FakeTransactor.Exec called %d times, expected >= %d", len(f.ExecCalls), n)
+	}
+}
+
+// WaitForExecCalled blocks until FakeTransactor.Exec has been called, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForExecCalled(ctx context.Context) error {
+	return f.WaitForExecCalledN(ctx, 1)
+}
+
+// WaitForExecCalledN blocks until FakeTransactor.Exec has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForExecCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.ExecCalls) >= n
+	})
+}
+
+// AssertExecEventuallyCalled calls t.Error if FakeTransactor.Exec is not called within the timeout
+func (f *FakeTransactor) AssertExecEventuallyCalled(t TransactorTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForExecCalled(ctx) != nil {
+		t.Errorf("FakeTransactor.Exec not called within %v", timeout)
+	}
+}
+
+// describeExecCalls describes the calls of FakeTransactor.Exec against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeTransactor) describeExecCalls(query TransactorMatcher[string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tQuery: %s", fake.Describe(query))
+
+	if len(f.ExecCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.ExecCalls {
+		matched := 0
+		if fake.Matches(query, call.Parameters.Query) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.ExecCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(query, call.Parameters.Query) {
+		fmt.Fprintf(&b, "\n\tQuery: got %s, want %s", fake.Format(call.Parameters.Query), fake.Describe(query))
+	}
+
+	return b.String()
+}
+
+// ExecCalledWith returns true if FakeTransactor.Exec was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeTransactor) ExecCalledWith(query TransactorMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ExecCalls {
+		if fake.Matches(query, call.Parameters.Query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertExecCalledWith calls t.Error if FakeTransactor.Exec was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeTransactor) AssertExecCalledWith(t TransactorTestingT, query TransactorMatcher[string]) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.ExecCalls {
+		if fake.Matches(query, call.Parameters.Query) {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		t.Errorf("FakeTransactor.Exec not called with expected parameters\n%s", f.describeExecCalls(query))
+	}
+}
+
+// ExecCalledOnceWith returns true if FakeTransactor.Exec was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeTransactor) ExecCalledOnceWith(query TransactorMatcher[string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ExecCalls {
+		if fake.Matches(query, call.Parameters.Query) {
+			count++
+		}
+	}
+
+	return count == 1
+}
+
+// AssertExecCalledOnceWith calls t.Error if FakeTransactor.Exec was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeTransactor) AssertExecCalledOnceWith(t TransactorTestingT, query TransactorMatcher[string]) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.ExecCalls {
+		if fake.Matches(query, call.Parameters.Query) {
+			count++
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("FakeTransactor.Exec called %d times with expected parameters, expected one\n%s", count, f.describeExecCalls(query))
+	}
+}
+
+// AssertExecEventuallyCalledWith calls t.Error if FakeTransactor.Exec is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeTransactor) AssertExecEventuallyCalledWith(t TransactorTestingT, timeout time.Duration, query TransactorMatcher[string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.ExecCalls)
+		for _, call := range f.ExecCalls {
+			if fake.Matches(query, call.Parameters.Query) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeTransactor.Exec not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeExecCalls(query))
+	}
+}
+
+// ExecResultsForCall returns the result values for the first call to FakeTransactor.Exec with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeTransactor) ExecResultsForCall(query TransactorMatcher[string]) (ident1 int64, ident2 error, found bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.ExecCalls {
+		if fake.Matches(query, call.Parameters.Query) {
+			ident1 = call.Results.Ident1
+			ident2 = call.Results.Ident2
+			found = true
+			break
+		}
+	}
+
+	return
+}
+
+func (f *FakeTransactor) Commit() (ident1 error) {
+	f.mutex.Lock()
+	hook := f.CommitHook
+	expectation, t := f.expectedCommit()
+	var results TransactorCommitResults
+	var found, panics bool
+	if expectation != nil && expectation.returns {
+		results, found = expectation.results, true
+	} else {
+		results, found, panics = f.returnsCommit.Lookup(len(f.CommitCalls))
+	}
+	if panics {
+		f.mutex.Unlock()
+		panic("Transactor.Commit() called after the results given to FakeTransactor.SetCommitReturnsSequence were used up")
+	}
+	if hook == nil && !found && t == nil {
+		f.mutex.Unlock()
+		panic("Transactor.Commit() called but FakeTransactor.CommitHook is nil")
+	}
+
+	invocation := new(TransactorCommitInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.CommitCalls = append(f.CommitCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeTransactor.Commit called more times than expected")
+	}
+
+	if found {
+		ident1 = results.Ident1
+	} else if hook != nil {
+		ident1 = hook()
+	}
+
+	f.mutex.Lock()
+	invocation.Results.Ident1 = ident1
+	f.mutex.Unlock()
+
+	return
+}
+
+// expectedCommit returns the first unsatisfied expectation of FakeTransactor.Commit matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeTransactor) expectedCommit() (*TransactorCommitExpectation, TransactorTestingT) {
+	if len(f.expectationsCommit) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsCommit {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsCommit[0].t
+}
+
+// ExpectCommit expects calls of FakeTransactor.Commit, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeTransactor) ExpectCommit(t TransactorTestingT) *TransactorCommitExpectation {
+	t.Helper()
+	expectation := &TransactorCommitExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsCommit = append(f.expectationsCommit, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeTransactor.Commit called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetCommitHook configures Transactor.Commit to call the given function
+func (f *FakeTransactor) SetCommitHook(hook func() error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.CommitHook = hook
+}
+
+// SetCommitStub configures Transactor.Commit to always return the given values
+func (f *FakeTransactor) SetCommitStub(ident1 error) {
+	f.SetCommitHook(func() error {
+		return ident1
+	})
+}
+
+// SetCommitReturnsOnCall configures Transactor.Commit to return the given values from the call with the given index in CommitCalls, rather than calling the hook
+func (f *FakeTransactor) SetCommitReturnsOnCall(call int, ident1 error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsCommit.Set(call, TransactorCommitResults{Ident1: ident1})
+}
+
+// SetCommitReturnsSequence configures the following calls of Transactor.Commit to return the given results in turn, rather than calling the hook
+// The exhausted argument selects the behavior of calls made after the sequence is used up
+func (f *FakeTransactor) SetCommitReturnsSequence(exhausted TransactorExhausted, results ...TransactorCommitResults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsCommit.Sequence(len(f.CommitCalls), exhausted, results)
+}
+
+// SetCommitError configures Transactor.Commit to always return the given error, with zero values for its other results
+func (f *FakeTransactor) SetCommitError(err error) {
+	f.SetCommitHook(func() (ident1 error) {
+		ident1 = err
+		return
+	})
+}
+
+// SetCommitErrorOnCall configures Transactor.Commit to return the given error, with zero values for its other results, from the call with the given index in CommitCalls, rather than calling the hook
+func (f *FakeTransactor) SetCommitErrorOnCall(call int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.returnsCommit.Set(call, TransactorCommitResults{Ident1: err})
+}
+
+// CommitCallsSnapshot returns a copy of the calls made to FakeTransactor.Commit
+func (f *FakeTransactor) CommitCallsSnapshot() []*TransactorCommitInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*TransactorCommitInvocation, len(f.CommitCalls))
+	for i, call := range f.CommitCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// CommitCall returns a TransactorCallMatcher selecting the calls of FakeTransactor.Commit
+func (f *FakeTransactor) CommitCall() TransactorCallMatcher {
+	return fake.NewCallMatcher("FakeTransactor.Commit()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*TransactorCommitInvocation(nil), f.CommitCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
+}
+
+// CommitCalled returns true if FakeTransactor.Commit was called
+func (f *FakeTransactor) CommitCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.CommitCalls) != 0
+}
+
+// AssertCommitCalled calls t.Error if FakeTransactor.Commit was not called
+func (f *FakeTransactor) AssertCommitCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.CommitCalls) == 0 {
+		t.Error("FakeTransactor.Commit not called, expected at least one")
+	}
+}
+
+// CommitNotCalled returns true if FakeTransactor.Commit was not called
+func (f *FakeTransactor) CommitNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.CommitCalls) == 0
+}
+
+// AssertCommitNotCalled calls t.Error if FakeTransactor.Commit was called
+func (f *FakeTransactor) AssertCommitNotCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.CommitCalls) != 0 {
+		t.Error("FakeTransactor.Commit called, expected none")
+	}
+}
+
+// CommitCalledOnce returns true if FakeTransactor.Commit was called exactly once
+func (f *FakeTransactor) CommitCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.CommitCalls) == 1
+}
+
+// AssertCommitCalledOnce calls t.Error if FakeTransactor.Commit was not called exactly once
+func (f *FakeTransactor) AssertCommitCalledOnce(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.CommitCalls) != 1 {
+		t.Errorf("FakeTransactor.Commit called %d times, expected 1", len(f.CommitCalls))
+	}
+}
+
+// CommitCalledN returns true if FakeTransactor.Commit was called at least n times
+func (f *FakeTransactor) CommitCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.CommitCalls) >= n
+}
+
+// AssertCommitCalledN calls t.Error if FakeTransactor.Commit was called less than n times
+func (f *FakeTransactor) AssertCommitCalledN(t TransactorTestingT, n int) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.CommitCalls) < n {
+		t.Errorf("FakeTransactor.Commit called %d times, expected >= %d", len(f.CommitCalls), n)
+	}
+}
+
+// WaitForCommitCalled blocks until FakeTransactor.Commit has been called, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForCommitCalled(ctx context.Context) error {
+	return f.WaitForCommitCalledN(ctx, 1)
+}
+
+// WaitForCommitCalledN blocks until FakeTransactor.Commit has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForCommitCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.CommitCalls) >= n
+	})
+}
+
+// AssertCommitEventuallyCalled calls t.Error if FakeTransactor.Commit is not called within the timeout
+func (f *FakeTransactor) AssertCommitEventuallyCalled(t TransactorTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForCommitCalled(ctx) != nil {
+		t.Errorf("FakeTransactor.Commit not called within %v", timeout)
+	}
+}
+
+func (f *FakeTransactor) Rollback() {
+	f.mutex.Lock()
+	hook := f.RollbackHook
+	expectation, t := f.expectedRollback()
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
+		panic("Transactor.Rollback() called but FakeTransactor.RollbackHook is nil")
+	}
+
+	invocation := new(TransactorRollbackInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.RollbackCalls = append(f.RollbackCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeTransactor.Rollback called more times than expected")
+	}
+
+	if hook != nil {
+		hook()
+	}
+
+	return
+}
+
+// expectedRollback returns the first unsatisfied expectation of FakeTransactor.Rollback matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeTransactor) expectedRollback() (*TransactorRollbackExpectation, TransactorTestingT) {
+	if len(f.expectationsRollback) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsRollback {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsRollback[0].t
+}
+
+// ExpectRollback expects calls of FakeTransactor.Rollback, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeTransactor) ExpectRollback(t TransactorTestingT) *TransactorRollbackExpectation {
+	t.Helper()
+	expectation := &TransactorRollbackExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsRollback = append(f.expectationsRollback, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeTransactor.Rollback called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetRollbackHook configures Transactor.Rollback to call the given function
+func (f *FakeTransactor) SetRollbackHook(hook func()) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.RollbackHook = hook
+}
+
+// RollbackCallsSnapshot returns a copy of the calls made to FakeTransactor.Rollback
+func (f *FakeTransactor) RollbackCallsSnapshot() []*TransactorRollbackInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*TransactorRollbackInvocation, len(f.RollbackCalls))
+	for i, call := range f.RollbackCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// RollbackCall returns a TransactorCallMatcher selecting the calls of FakeTransactor.Rollback
+func (f *FakeTransactor) RollbackCall() TransactorCallMatcher {
+	return fake.NewCallMatcher("FakeTransactor.Rollback()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*TransactorRollbackInvocation(nil), f.RollbackCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
+}
+
+// RollbackCalled returns true if FakeTransactor.Rollback was called
+func (f *FakeTransactor) RollbackCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.RollbackCalls) != 0
+}
+
+// AssertRollbackCalled calls t.Error if FakeTransactor.Rollback was not called
+func (f *FakeTransactor) AssertRollbackCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.RollbackCalls) == 0 {
+		t.Error("FakeTransactor.Rollback not called, expected at least one")
+	}
+}
+
+// RollbackNotCalled returns true if FakeTransactor.Rollback was not called
+func (f *FakeTransactor) RollbackNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.RollbackCalls) == 0
+}
+
+// AssertRollbackNotCalled calls t.Error if FakeTransactor.Rollback was called
+func (f *FakeTransactor) AssertRollbackNotCalled(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.RollbackCalls) != 0 {
+		t.Error("FakeTransactor.Rollback called, expected none")
+	}
+}
+
+// RollbackCalledOnce returns true if FakeTransactor.Rollback was called exactly once
+func (f *FakeTransactor) RollbackCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.RollbackCalls) == 1
+}
+
+// AssertRollbackCalledOnce calls t.Error if FakeTransactor.Rollback was not called exactly once
+func (f *FakeTransactor) AssertRollbackCalledOnce(t TransactorTestingT) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.RollbackCalls) != 1 {
+		t.Errorf("FakeTransactor.Rollback called %d times, expected 1", len(f.RollbackCalls))
+	}
+}
+
+// RollbackCalledN returns true if FakeTransactor.Rollback was called at least n times
+func (f *FakeTransactor) RollbackCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.RollbackCalls) >= n
+}
+
+// AssertRollbackCalledN calls t.Error if FakeTransactor.Rollback was called less than n times
+func (f *FakeTransactor) AssertRollbackCalledN(t TransactorTestingT, n int) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.RollbackCalls) < n {
+		t.Errorf("FakeTransactor.Rollback called %d times, expected >= %d", len(f.RollbackCalls), n)
+	}
+}
+
+// WaitForRollbackCalled blocks until FakeTransactor.Rollback has been called, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForRollbackCalled(ctx context.Context) error {
+	return f.WaitForRollbackCalledN(ctx, 1)
+}
+
+// WaitForRollbackCalledN blocks until FakeTransactor.Rollback has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeTransactor) WaitForRollbackCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.RollbackCalls) >= n
+	})
+}
+
+// AssertRollbackEventuallyCalled calls t.Error if FakeTransactor.Rollback is not called within the timeout
+func (f *FakeTransactor) AssertRollbackEventuallyCalled(t TransactorTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForRollbackCalled(ctx) != nil {
+		t.Errorf("FakeTransactor.Rollback not called within %v", timeout)
+	}
+}
@@ -0,0 +1,8 @@
+package main
+
+type Transactor interface {
+	Begin() error
+	Exec(query string) (int64, error)
+	Commit() error
+	Rollback()
+}
@@ -2,13 +2,66 @@
 
 package main
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
 
 // VariadicSingleVariadicInvocation represents a single call of FakeVariadic.SingleVariadic
 type VariadicSingleVariadicInvocation struct {
 	Parameters struct {
 		A []string
 	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetSingleVariadicInvocation
+	Matchers struct {
+		A VariadicMatcher[[]string]
+	}
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *VariadicSingleVariadicInvocation) String() string {
+	return fmt.Sprintf("FakeVariadic.SingleVariadic%+v", i.Parameters)
+}
+
+// VariadicSingleVariadicExpectation is a call of FakeVariadic.SingleVariadic expected by a test, created by FakeVariadic.ExpectSingleVariadic
+type VariadicSingleVariadicExpectation struct {
+	fake     *FakeVariadic
+	t        VariadicTestingT
+	times    int
+	count    int
+	matchers struct {
+		A VariadicMatcher[[]string]
+	}
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *VariadicSingleVariadicExpectation) With(a VariadicMatcher[[]string]) *VariadicSingleVariadicExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+
+	return e
+}
+
+func (e *VariadicSingleVariadicExpectation) matches(a []string) bool {
+	return fake.Matches(e.matchers.A, a)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *VariadicSingleVariadicExpectation) Times(n int) *VariadicSingleVariadicExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // VariadicMixedVariadicInvocation represents a single call of FakeVariadic.MixedVariadic
@@ -19,14 +72,118 @@ type VariadicMixedVariadicInvocation struct {
 		C int
 		D []string
 	}
+	// Matchers optionally replace comparison with the corresponding Parameters when used with SetMixedVariadicInvocation
+	Matchers struct {
+		A VariadicMatcher[int]
+		B VariadicMatcher[int]
+		C VariadicMatcher[int]
+		D VariadicMatcher[[]string]
+	}
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *VariadicMixedVariadicInvocation) String() string {
+	return fmt.Sprintf("FakeVariadic.MixedVariadic%+v", i.Parameters)
+}
+
+// VariadicMixedVariadicExpectation is a call of FakeVariadic.MixedVariadic expected by a test, created by FakeVariadic.ExpectMixedVariadic
+type VariadicMixedVariadicExpectation struct {
+	fake     *FakeVariadic
+	t        VariadicTestingT
+	times    int
+	count    int
+	matchers struct {
+		A VariadicMatcher[int]
+		B VariadicMatcher[int]
+		C VariadicMatcher[int]
+		D VariadicMatcher[[]string]
+	}
+}
+
+// With sets the matchers the parameters of the expected calls must match
+func (e *VariadicMixedVariadicExpectation) With(a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) *VariadicMixedVariadicExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.matchers.A = a
+	e.matchers.B = b
+	e.matchers.C = c
+	e.matchers.D = d
+
+	return e
+}
+
+func (e *VariadicMixedVariadicExpectation) matches(a int, b int, c int, d []string) bool {
+	return fake.Matches(e.matchers.A, a) && fake.Matches(e.matchers.B, b) && fake.Matches(e.matchers.C, c) && fake.Matches(e.matchers.D, d)
+}
+
+// Times sets the number of expected calls, one by default
+func (e *VariadicMixedVariadicExpectation) Times(n int) *VariadicMixedVariadicExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // VariadicTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type VariadicTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type VariadicTestingT = fake.TestingT
+
+// VariadicMatcher matches a parameter of a call to FakeVariadic
+type VariadicMatcher[T any] interface {
+	Match(T) bool
+}
+
+// VariadicMatcherFunc is a VariadicMatcher implemented by a predicate function
+type VariadicMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m VariadicMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// VariadicAny returns a VariadicMatcher that matches any value
+func VariadicAny[T any]() VariadicMatcher[T] {
+	return fake.Any[T]()
+}
+
+// VariadicEq returns a VariadicMatcher that matches values deeply equal to want
+func VariadicEq[T any](want T) VariadicMatcher[T] {
+	return fake.Eq(want)
+}
+
+// VariadicNot returns a VariadicMatcher that matches the values the given matcher does not
+func VariadicNot[T any](m VariadicMatcher[T]) VariadicMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// VariadicPred returns a VariadicMatcher that matches values for which the given predicate returns true
+func VariadicPred[T any](pred func(T) bool) VariadicMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// VariadicAnyOfType returns a VariadicMatcher that matches values whose dynamic type is, or implements, U
+func VariadicAnyOfType[T, U any]() VariadicMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// VariadicCallMatcher selects calls of a method of a fake for VariadicInOrder and VariadicUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeVariadic
+type VariadicCallMatcher = fake.CallMatcher
+
+// VariadicInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func VariadicInOrder(t VariadicTestingT, calls ...VariadicCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// VariadicUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func VariadicUnordered(t VariadicTestingT, calls ...VariadicCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
 }
 
 /*
@@ -45,13 +202,17 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeSingleVariadic ...
+		// assert state of FakeVariadic ...
 		f.AssertSingleVariadicCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeSingleVariadic.
+unexpected calls are made to FakeVariadic.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeVariadic struct {
 	SingleVariadicHook func(...string)
@@ -59,6 +220,12 @@ type FakeVariadic struct {
 
 	SingleVariadicCalls []*VariadicSingleVariadicInvocation
 	MixedVariadicCalls  []*VariadicMixedVariadicInvocation
+
+	expectationsSingleVariadic []*VariadicSingleVariadicExpectation
+	expectationsMixedVariadic  []*VariadicMixedVariadicExpectation
+	mutex                      sync.Mutex
+	recorded                   chan struct{} // closed when the next call is recorded, if waited for
+	copyParameters             bool
 }
 
 // NewFakeVariadicDefaultPanic returns an instance of FakeVariadic with all hooks configured to panic
@@ -74,61 +241,228 @@ func NewFakeVariadicDefaultPanic() *FakeVariadic {
 }
 
 // NewFakeVariadicDefaultFatal returns an instance of FakeVariadic with all hooks configured to call t.Fatal
-func NewFakeVariadicDefaultFatal(t_sym1 VariadicTestingT) *FakeVariadic {
+func NewFakeVariadicDefaultFatal(t VariadicTestingT) *FakeVariadic {
 	return &FakeVariadic{
 		SingleVariadicHook: func(...string) {
-			t_sym1.Fatal("Unexpected call to Variadic.SingleVariadic")
+			t.Fatal("Unexpected call to Variadic.SingleVariadic")
 			return
 		},
 		MixedVariadicHook: func(int, int, int, ...string) {
-			t_sym1.Fatal("Unexpected call to Variadic.MixedVariadic")
+			t.Fatal("Unexpected call to Variadic.MixedVariadic")
 			return
 		},
 	}
 }
 
 // NewFakeVariadicDefaultError returns an instance of FakeVariadic with all hooks configured to call t.Error
-func NewFakeVariadicDefaultError(t_sym2 VariadicTestingT) *FakeVariadic {
+func NewFakeVariadicDefaultError(t VariadicTestingT) *FakeVariadic {
 	return &FakeVariadic{
 		SingleVariadicHook: func(...string) {
-			t_sym2.Error("Unexpected call to Variadic.SingleVariadic")
+			t.Error("Unexpected call to Variadic.SingleVariadic")
 			return
 		},
 		MixedVariadicHook: func(int, int, int, ...string) {
-			t_sym2.Error("Unexpected call to Variadic.MixedVariadic")
+			t.Error("Unexpected call to Variadic.MixedVariadic")
 			return
 		},
 	}
 }
 
+// NewFakeVariadicDefaultZero returns an instance of FakeVariadic with all hooks configured to return zero values
+func NewFakeVariadicDefaultZero() *FakeVariadic {
+	return &FakeVariadic{
+		SingleVariadicHook: func(...string) {
+			return
+		},
+		MixedVariadicHook: func(int, int, int, ...string) {
+			return
+		},
+	}
+}
+
+// NewFakeVariadicDefaultFriendly returns an instance of FakeVariadic with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeVariadic are friendly fakes, one for each method and result, created on first use
+func NewFakeVariadicDefaultFriendly() *FakeVariadic {
+
+	return &FakeVariadic{
+		SingleVariadicHook: func(...string) {
+			return
+		},
+		MixedVariadicHook: func(int, int, int, ...string) {
+			return
+		},
+	}
+}
+
+// NewFakeVariadicSpy returns an instance of FakeVariadic with all hooks configured to call the given implementation
+func NewFakeVariadicSpy(real Variadic) *FakeVariadic {
+	return &FakeVariadic{
+		SingleVariadicHook: real.SingleVariadic,
+		MixedVariadicHook:  real.MixedVariadic,
+	}
+}
+
+// Reset forgets all calls made to FakeVariadic
 func (f *FakeVariadic) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.SingleVariadicCalls = []*VariadicSingleVariadicInvocation{}
 	f.MixedVariadicCalls = []*VariadicMixedVariadicInvocation{}
 }
 
-func (f_sym3 *FakeVariadic) SingleVariadic(a ...string) {
-	if f_sym3.SingleVariadicHook == nil {
+// SetCopyParameters configures FakeVariadic to record copies of the parameters of later calls, which keep their values when the code under test reuses or mutates them
+// Slices, maps, arrays, pointed-to values and the exported fields of structs are copied, while interface values, channels, functions and unexported fields are shared
+func (f *FakeVariadic) SetCopyParameters(enabled bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.copyParameters = enabled
+}
+
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeVariadic) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeVariadic) SingleVariadic(a ...string) {
+	f.mutex.Lock()
+	hook := f.SingleVariadicHook
+	expectation, t := f.expectedSingleVariadic(a)
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Variadic.SingleVariadic() called but FakeVariadic.SingleVariadicHook is nil")
 	}
 
-	invocation_sym3 := new(VariadicSingleVariadicInvocation)
-	f_sym3.SingleVariadicCalls = append(f_sym3.SingleVariadicCalls, invocation_sym3)
+	invocation := new(VariadicSingleVariadicInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.SingleVariadicCalls = append(f.SingleVariadicCalls, invocation)
+
+	invocation.Parameters.A = a
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+	}
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	invocation_sym3.Parameters.A = a
+	if t != nil && expectation == nil {
+		t.Errorf("FakeVariadic.SingleVariadic called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
 
-	f_sym3.SingleVariadicHook(a...)
+	if hook != nil {
+		hook(a...)
+	}
 
 	return
 }
 
+// expectedSingleVariadic returns the first unsatisfied expectation of FakeVariadic.SingleVariadic matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeVariadic) expectedSingleVariadic(a []string) (*VariadicSingleVariadicExpectation, VariadicTestingT) {
+	if len(f.expectationsSingleVariadic) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsSingleVariadic {
+		if expectation.count < expectation.times && expectation.matches(a) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsSingleVariadic[0].t
+}
+
+// ExpectSingleVariadic expects calls of FakeVariadic.SingleVariadic, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeVariadic) ExpectSingleVariadic(t VariadicTestingT) *VariadicSingleVariadicExpectation {
+	t.Helper()
+	expectation := &VariadicSingleVariadicExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsSingleVariadic = append(f.expectationsSingleVariadic, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeVariadic.SingleVariadic called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetSingleVariadicHook configures Variadic.SingleVariadic to call the given function
+func (f *FakeVariadic) SetSingleVariadicHook(hook func(...string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.SingleVariadicHook = hook
+}
+
+// SingleVariadicCallsSnapshot returns a copy of the calls made to FakeVariadic.SingleVariadic
+func (f *FakeVariadic) SingleVariadicCallsSnapshot() []*VariadicSingleVariadicInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*VariadicSingleVariadicInvocation, len(f.SingleVariadicCalls))
+	for i, call := range f.SingleVariadicCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// SingleVariadicCall returns a VariadicCallMatcher selecting the calls of FakeVariadic.SingleVariadic with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) SingleVariadicCall(a VariadicMatcher[[]string]) VariadicCallMatcher {
+	return fake.NewCallMatcher("FakeVariadic.SingleVariadic(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*VariadicSingleVariadicInvocation(nil), f.SingleVariadicCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
 // SingleVariadicCalled returns true if FakeVariadic.SingleVariadic was called
 func (f *FakeVariadic) SingleVariadicCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SingleVariadicCalls) != 0
 }
 
 // AssertSingleVariadicCalled calls t.Error if FakeVariadic.SingleVariadic was not called
 func (f *FakeVariadic) AssertSingleVariadicCalled(t VariadicTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SingleVariadicCalls) == 0 {
 		t.Error("FakeVariadic.SingleVariadic not called, expected at least one")
 	}
@@ -136,12 +470,16 @@ func (f *FakeVariadic) AssertSingleVariadicCalled(t VariadicTestingT) {
 
 // SingleVariadicNotCalled returns true if FakeVariadic.SingleVariadic was not called
 func (f *FakeVariadic) SingleVariadicNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SingleVariadicCalls) == 0
 }
 
 // AssertSingleVariadicNotCalled calls t.Error if FakeVariadic.SingleVariadic was called
 func (f *FakeVariadic) AssertSingleVariadicNotCalled(t VariadicTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SingleVariadicCalls) != 0 {
 		t.Error("FakeVariadic.SingleVariadic called, expected none")
 	}
@@ -149,12 +487,16 @@ func (f *FakeVariadic) AssertSingleVariadicNotCalled(t VariadicTestingT) {
 
 // SingleVariadicCalledOnce returns true if FakeVariadic.SingleVariadic was called exactly once
 func (f *FakeVariadic) SingleVariadicCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SingleVariadicCalls) == 1
 }
 
 // AssertSingleVariadicCalledOnce calls t.Error if FakeVariadic.SingleVariadic was not called exactly once
 func (f *FakeVariadic) AssertSingleVariadicCalledOnce(t VariadicTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SingleVariadicCalls) != 1 {
 		t.Errorf("FakeVariadic.SingleVariadic called %d times, expected 1", len(f.SingleVariadicCalls))
 	}
@@ -162,21 +504,92 @@ func (f *FakeVariadic) AssertSingleVariadicCalledOnce(t VariadicTestingT) {
 
 // SingleVariadicCalledN returns true if FakeVariadic.SingleVariadic was called at least n times
 func (f *FakeVariadic) SingleVariadicCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.SingleVariadicCalls) >= n
 }
 
 // AssertSingleVariadicCalledN calls t.Error if FakeVariadic.SingleVariadic was called less than n times
 func (f *FakeVariadic) AssertSingleVariadicCalledN(t VariadicTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.SingleVariadicCalls) < n {
 		t.Errorf("FakeVariadic.SingleVariadic called %d times, expected >= %d", len(f.SingleVariadicCalls), n)
 	}
 }
 
-// SingleVariadicCalledWith returns true if FakeVariadic.SingleVariadic was called with the given values
-func (f_sym4 *FakeVariadic) SingleVariadicCalledWith(a ...string) bool {
-	for _, call_sym4 := range f_sym4.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym4.Parameters.A, a) {
+// WaitForSingleVariadicCalled blocks until FakeVariadic.SingleVariadic has been called, returning the error of ctx if it is done first
+func (f *FakeVariadic) WaitForSingleVariadicCalled(ctx context.Context) error {
+	return f.WaitForSingleVariadicCalledN(ctx, 1)
+}
+
+// WaitForSingleVariadicCalledN blocks until FakeVariadic.SingleVariadic has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeVariadic) WaitForSingleVariadicCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.SingleVariadicCalls) >= n
+	})
+}
+
+// AssertSingleVariadicEventuallyCalled calls t.Error if FakeVariadic.SingleVariadic is not called within the timeout
+func (f *FakeVariadic) AssertSingleVariadicEventuallyCalled(t VariadicTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForSingleVariadicCalled(ctx) != nil {
+		t.Errorf("FakeVariadic.SingleVariadic not called within %v", timeout)
+	}
+}
+
+// describeSingleVariadicCalls describes the calls of FakeVariadic.SingleVariadic against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeVariadic) describeSingleVariadicCalls(a VariadicMatcher[[]string]) string {
+	var b strings.Builder
+	b.WriteString("expected:")
+	fmt.Fprintf(&b, "\n\tA: %s", fake.Describe(a))
+
+	if len(f.SingleVariadicCalls) == 0 {
+		b.WriteString("\nrecorded calls: none")
+		return b.String()
+	}
+
+	b.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.SingleVariadicCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+
+		if matched == 1 {
+			found = true
+			fmt.Fprintf(&b, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b.String()
+	}
+
+	call := f.SingleVariadicCalls[closest]
+	fmt.Fprintf(&b, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+
+	return b.String()
+}
+
+// SingleVariadicCalledWith returns true if FakeVariadic.SingleVariadic was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) SingleVariadicCalledWith(a VariadicMatcher[[]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.SingleVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) {
 			return true
 		}
 	}
@@ -184,75 +597,207 @@ func (f_sym4 *FakeVariadic) SingleVariadicCalledWith(a ...string) bool {
 	return false
 }
 
-// AssertSingleVariadicCalledWith calls t.Error if FakeVariadic.SingleVariadic was not called with the given values
-func (f_sym5 *FakeVariadic) AssertSingleVariadicCalledWith(t VariadicTestingT, a ...string) {
+// AssertSingleVariadicCalledWith calls t.Error if FakeVariadic.SingleVariadic was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) AssertSingleVariadicCalledWith(t VariadicTestingT, a VariadicMatcher[[]string]) {
 	t.Helper()
-	var found_sym5 bool
-	for _, call_sym5 := range f_sym5.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym5.Parameters.A, a) {
-			found_sym5 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.SingleVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym5 {
-		t.Error("FakeVariadic.SingleVariadic not called with expected parameters")
+	if !found {
+		t.Errorf("FakeVariadic.SingleVariadic not called with expected parameters\n%s", f.describeSingleVariadicCalls(a))
 	}
 }
 
-// SingleVariadicCalledOnceWith returns true if FakeVariadic.SingleVariadic was called exactly once with the given values
-func (f_sym6 *FakeVariadic) SingleVariadicCalledOnceWith(a ...string) bool {
-	var count_sym6 int
-	for _, call_sym6 := range f_sym6.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym6.Parameters.A, a) {
-			count_sym6++
+// SingleVariadicCalledOnceWith returns true if FakeVariadic.SingleVariadic was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) SingleVariadicCalledOnceWith(a VariadicMatcher[[]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.SingleVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			count++
 		}
 	}
 
-	return count_sym6 == 1
+	return count == 1
 }
 
-// AssertSingleVariadicCalledOnceWith calls t.Error if FakeVariadic.SingleVariadic was not called exactly once with the given values
-func (f_sym7 *FakeVariadic) AssertSingleVariadicCalledOnceWith(t VariadicTestingT, a ...string) {
+// AssertSingleVariadicCalledOnceWith calls t.Error if FakeVariadic.SingleVariadic was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) AssertSingleVariadicCalledOnceWith(t VariadicTestingT, a VariadicMatcher[[]string]) {
 	t.Helper()
-	var count_sym7 int
-	for _, call_sym7 := range f_sym7.SingleVariadicCalls {
-		if reflect.DeepEqual(call_sym7.Parameters.A, a) {
-			count_sym7++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.SingleVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) {
+			count++
 		}
 	}
 
-	if count_sym7 != 1 {
-		t.Errorf("FakeVariadic.SingleVariadic called %d times with expected parameters, expected one", count_sym7)
+	if count != 1 {
+		t.Errorf("FakeVariadic.SingleVariadic called %d times with expected parameters, expected one\n%s", count, f.describeSingleVariadicCalls(a))
+	}
+}
+
+// AssertSingleVariadicEventuallyCalledWith calls t.Error if FakeVariadic.SingleVariadic is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeVariadic) AssertSingleVariadicEventuallyCalledWith(t VariadicTestingT, timeout time.Duration, a VariadicMatcher[[]string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.SingleVariadicCalls)
+		for _, call := range f.SingleVariadicCalls {
+			if fake.Matches(a, call.Parameters.A) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeVariadic.SingleVariadic not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeSingleVariadicCalls(a))
 	}
 }
 
-func (f_sym8 *FakeVariadic) MixedVariadic(a int, b int, c int, d ...string) {
-	if f_sym8.MixedVariadicHook == nil {
+func (f *FakeVariadic) MixedVariadic(a int, b int, c int, d ...string) {
+	f.mutex.Lock()
+	hook := f.MixedVariadicHook
+	expectation, t := f.expectedMixedVariadic(a, b, c, d)
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Variadic.MixedVariadic() called but FakeVariadic.MixedVariadicHook is nil")
 	}
 
-	invocation_sym8 := new(VariadicMixedVariadicInvocation)
-	f_sym8.MixedVariadicCalls = append(f_sym8.MixedVariadicCalls, invocation_sym8)
+	invocation := new(VariadicMixedVariadicInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.MixedVariadicCalls = append(f.MixedVariadicCalls, invocation)
+
+	invocation.Parameters.A = a
+	invocation.Parameters.B = b
+	invocation.Parameters.C = c
+	invocation.Parameters.D = d
+	if f.copyParameters {
+		seen := make(map[uintptr]reflect.Value)
+		invocation.Parameters.A = fake.CopyParameter(invocation.Parameters.A, seen)
+		invocation.Parameters.B = fake.CopyParameter(invocation.Parameters.B, seen)
+		invocation.Parameters.C = fake.CopyParameter(invocation.Parameters.C, seen)
+		invocation.Parameters.D = fake.CopyParameter(invocation.Parameters.D, seen)
+	}
 
-	invocation_sym8.Parameters.A = a
-	invocation_sym8.Parameters.B = b
-	invocation_sym8.Parameters.C = c
-	invocation_sym8.Parameters.D = d
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
 
-	f_sym8.MixedVariadicHook(a, b, c, d...)
+	if t != nil && expectation == nil {
+		t.Errorf("FakeVariadic.MixedVariadic called with parameters matching no expectation: %+v", invocation.Parameters)
+	}
+
+	if hook != nil {
+		hook(a, b, c, d...)
+	}
 
 	return
 }
 
+// expectedMixedVariadic returns the first unsatisfied expectation of FakeVariadic.MixedVariadic matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeVariadic) expectedMixedVariadic(a int, b int, c int, d []string) (*VariadicMixedVariadicExpectation, VariadicTestingT) {
+	if len(f.expectationsMixedVariadic) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsMixedVariadic {
+		if expectation.count < expectation.times && expectation.matches(a, b, c, d) {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsMixedVariadic[0].t
+}
+
+// ExpectMixedVariadic expects calls of FakeVariadic.MixedVariadic, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeVariadic) ExpectMixedVariadic(t VariadicTestingT) *VariadicMixedVariadicExpectation {
+	t.Helper()
+	expectation := &VariadicMixedVariadicExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsMixedVariadic = append(f.expectationsMixedVariadic, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeVariadic.MixedVariadic called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetMixedVariadicHook configures Variadic.MixedVariadic to call the given function
+func (f *FakeVariadic) SetMixedVariadicHook(hook func(int, int, int, ...string)) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.MixedVariadicHook = hook
+}
+
+// MixedVariadicCallsSnapshot returns a copy of the calls made to FakeVariadic.MixedVariadic
+func (f *FakeVariadic) MixedVariadicCallsSnapshot() []*VariadicMixedVariadicInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*VariadicMixedVariadicInvocation, len(f.MixedVariadicCalls))
+	for i, call := range f.MixedVariadicCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// MixedVariadicCall returns a VariadicCallMatcher selecting the calls of FakeVariadic.MixedVariadic with parameters matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) MixedVariadicCall(a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) VariadicCallMatcher {
+	return fake.NewCallMatcher("FakeVariadic.MixedVariadic(...)", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*VariadicMixedVariadicInvocation(nil), f.MixedVariadicCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) && fake.Matches(d, call.Parameters.D) {
+				matching = append(matching, call.Sequence)
+			}
+		}
+
+		return calls, matching
+	})
+}
+
 // MixedVariadicCalled returns true if FakeVariadic.MixedVariadic was called
 func (f *FakeVariadic) MixedVariadicCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MixedVariadicCalls) != 0
 }
 
 // AssertMixedVariadicCalled calls t.Error if FakeVariadic.MixedVariadic was not called
 func (f *FakeVariadic) AssertMixedVariadicCalled(t VariadicTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MixedVariadicCalls) == 0 {
 		t.Error("FakeVariadic.MixedVariadic not called, expected at least one")
 	}
@@ -260,12 +805,16 @@ func (f *FakeVariadic) AssertMixedVariadicCalled(t VariadicTestingT) {
 
 // MixedVariadicNotCalled returns true if FakeVariadic.MixedVariadic was not called
 func (f *FakeVariadic) MixedVariadicNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MixedVariadicCalls) == 0
 }
 
 // AssertMixedVariadicNotCalled calls t.Error if FakeVariadic.MixedVariadic was called
 func (f *FakeVariadic) AssertMixedVariadicNotCalled(t VariadicTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MixedVariadicCalls) != 0 {
 		t.Error("FakeVariadic.MixedVariadic called, expected none")
 	}
@@ -273,12 +822,16 @@ func (f *FakeVariadic) AssertMixedVariadicNotCalled(t VariadicTestingT) {
 
 // MixedVariadicCalledOnce returns true if FakeVariadic.MixedVariadic was called exactly once
 func (f *FakeVariadic) MixedVariadicCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MixedVariadicCalls) == 1
 }
 
 // AssertMixedVariadicCalledOnce calls t.Error if FakeVariadic.MixedVariadic was not called exactly once
 func (f *FakeVariadic) AssertMixedVariadicCalledOnce(t VariadicTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MixedVariadicCalls) != 1 {
 		t.Errorf("FakeVariadic.MixedVariadic called %d times, expected 1", len(f.MixedVariadicCalls))
 	}
@@ -286,21 +839,113 @@ func (f *FakeVariadic) AssertMixedVariadicCalledOnce(t VariadicTestingT) {
 
 // MixedVariadicCalledN returns true if FakeVariadic.MixedVariadic was called at least n times
 func (f *FakeVariadic) MixedVariadicCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.MixedVariadicCalls) >= n
 }
 
 // AssertMixedVariadicCalledN calls t.Error if FakeVariadic.MixedVariadic was called less than n times
 func (f *FakeVariadic) AssertMixedVariadicCalledN(t VariadicTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.MixedVariadicCalls) < n {
 		t.Errorf("FakeVariadic.MixedVariadic called %d times, expected >= %d", len(f.MixedVariadicCalls), n)
 	}
 }
 
-// MixedVariadicCalledWith returns true if FakeVariadic.MixedVariadic was called with the given values
-func (f_sym9 *FakeVariadic) MixedVariadicCalledWith(a int, b int, c int, d ...string) bool {
-	for _, call_sym9 := range f_sym9.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym9.Parameters.A, a) && reflect.DeepEqual(call_sym9.Parameters.B, b) && reflect.DeepEqual(call_sym9.Parameters.C, c) && reflect.DeepEqual(call_sym9.Parameters.D, d) {
+// WaitForMixedVariadicCalled blocks until FakeVariadic.MixedVariadic has been called, returning the error of ctx if it is done first
+func (f *FakeVariadic) WaitForMixedVariadicCalled(ctx context.Context) error {
+	return f.WaitForMixedVariadicCalledN(ctx, 1)
+}
+
+// WaitForMixedVariadicCalledN blocks until FakeVariadic.MixedVariadic has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeVariadic) WaitForMixedVariadicCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.MixedVariadicCalls) >= n
+	})
+}
+
+// AssertMixedVariadicEventuallyCalled calls t.Error if FakeVariadic.MixedVariadic is not called within the timeout
+func (f *FakeVariadic) AssertMixedVariadicEventuallyCalled(t VariadicTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForMixedVariadicCalled(ctx) != nil {
+		t.Errorf("FakeVariadic.MixedVariadic not called within %v", timeout)
+	}
+}
+
+// describeMixedVariadicCalls describes the calls of FakeVariadic.MixedVariadic against the given matchers for failure messages, with the mutex held
+// It lists the recorded calls, marking those that match, and the fields in which the closest call differs when none do
+func (f *FakeVariadic) describeMixedVariadicCalls(a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) string {
+	var b2 strings.Builder
+	b2.WriteString("expected:")
+	fmt.Fprintf(&b2, "\n\tA: %s", fake.Describe(a))
+	fmt.Fprintf(&b2, "\n\tB: %s", fake.Describe(b))
+	fmt.Fprintf(&b2, "\n\tC: %s", fake.Describe(c))
+	fmt.Fprintf(&b2, "\n\tD: %s", fake.Describe(d))
+
+	if len(f.MixedVariadicCalls) == 0 {
+		b2.WriteString("\nrecorded calls: none")
+		return b2.String()
+	}
+
+	b2.WriteString("\nrecorded calls:")
+	closest, best, found := 0, -1, false
+	for i, call := range f.MixedVariadicCalls {
+		matched := 0
+		if fake.Matches(a, call.Parameters.A) {
+			matched++
+		}
+		if fake.Matches(b, call.Parameters.B) {
+			matched++
+		}
+		if fake.Matches(c, call.Parameters.C) {
+			matched++
+		}
+		if fake.Matches(d, call.Parameters.D) {
+			matched++
+		}
+
+		if matched == 4 {
+			found = true
+			fmt.Fprintf(&b2, "\n\t%s (matches)", call)
+		} else {
+			fmt.Fprintf(&b2, "\n\t%s", call)
+		}
+		if matched > best {
+			closest, best = i, matched
+		}
+	}
+	if found {
+		return b2.String()
+	}
+
+	call := f.MixedVariadicCalls[closest]
+	fmt.Fprintf(&b2, "\nclosest call %s differs in:", call)
+	if !fake.Matches(a, call.Parameters.A) {
+		fmt.Fprintf(&b2, "\n\tA: got %s, want %s", fake.Format(call.Parameters.A), fake.Describe(a))
+	}
+	if !fake.Matches(b, call.Parameters.B) {
+		fmt.Fprintf(&b2, "\n\tB: got %s, want %s", fake.Format(call.Parameters.B), fake.Describe(b))
+	}
+	if !fake.Matches(c, call.Parameters.C) {
+		fmt.Fprintf(&b2, "\n\tC: got %s, want %s", fake.Format(call.Parameters.C), fake.Describe(c))
+	}
+	if !fake.Matches(d, call.Parameters.D) {
+		fmt.Fprintf(&b2, "\n\tD: got %s, want %s", fake.Format(call.Parameters.D), fake.Describe(d))
+	}
+
+	return b2.String()
+}
+
+// MixedVariadicCalledWith returns true if FakeVariadic.MixedVariadic was called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) MixedVariadicCalledWith(a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, call := range f.MixedVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) && fake.Matches(d, call.Parameters.D) {
 			return true
 		}
 	}
@@ -308,45 +953,74 @@ func (f_sym9 *FakeVariadic) MixedVariadicCalledWith(a int, b int, c int, d ...st
 	return false
 }
 
-// AssertMixedVariadicCalledWith calls t.Error if FakeVariadic.MixedVariadic was not called with the given values
-func (f_sym10 *FakeVariadic) AssertMixedVariadicCalledWith(t VariadicTestingT, a int, b int, c int, d ...string) {
+// AssertMixedVariadicCalledWith calls t.Error if FakeVariadic.MixedVariadic was not called with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) AssertMixedVariadicCalledWith(t VariadicTestingT, a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) {
 	t.Helper()
-	var found_sym10 bool
-	for _, call_sym10 := range f_sym10.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym10.Parameters.A, a) && reflect.DeepEqual(call_sym10.Parameters.B, b) && reflect.DeepEqual(call_sym10.Parameters.C, c) && reflect.DeepEqual(call_sym10.Parameters.D, d) {
-			found_sym10 = true
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var found bool
+	for _, call := range f.MixedVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) && fake.Matches(d, call.Parameters.D) {
+			found = true
 			break
 		}
 	}
 
-	if !found_sym10 {
-		t.Error("FakeVariadic.MixedVariadic not called with expected parameters")
+	if !found {
+		t.Errorf("FakeVariadic.MixedVariadic not called with expected parameters\n%s", f.describeMixedVariadicCalls(a, b, c, d))
 	}
 }
 
-// MixedVariadicCalledOnceWith returns true if FakeVariadic.MixedVariadic was called exactly once with the given values
-func (f_sym11 *FakeVariadic) MixedVariadicCalledOnceWith(a int, b int, c int, d ...string) bool {
-	var count_sym11 int
-	for _, call_sym11 := range f_sym11.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym11.Parameters.A, a) && reflect.DeepEqual(call_sym11.Parameters.B, b) && reflect.DeepEqual(call_sym11.Parameters.C, c) && reflect.DeepEqual(call_sym11.Parameters.D, d) {
-			count_sym11++
+// MixedVariadicCalledOnceWith returns true if FakeVariadic.MixedVariadic was called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) MixedVariadicCalledOnceWith(a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.MixedVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) && fake.Matches(d, call.Parameters.D) {
+			count++
 		}
 	}
 
-	return count_sym11 == 1
+	return count == 1
 }
 
-// AssertMixedVariadicCalledOnceWith calls t.Error if FakeVariadic.MixedVariadic was not called exactly once with the given values
-func (f_sym12 *FakeVariadic) AssertMixedVariadicCalledOnceWith(t VariadicTestingT, a int, b int, c int, d ...string) {
+// AssertMixedVariadicCalledOnceWith calls t.Error if FakeVariadic.MixedVariadic was not called exactly once with values matching the given matchers, any of which may be nil to match any value
+func (f *FakeVariadic) AssertMixedVariadicCalledOnceWith(t VariadicTestingT, a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) {
 	t.Helper()
-	var count_sym12 int
-	for _, call_sym12 := range f_sym12.MixedVariadicCalls {
-		if reflect.DeepEqual(call_sym12.Parameters.A, a) && reflect.DeepEqual(call_sym12.Parameters.B, b) && reflect.DeepEqual(call_sym12.Parameters.C, c) && reflect.DeepEqual(call_sym12.Parameters.D, d) {
-			count_sym12++
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var count int
+	for _, call := range f.MixedVariadicCalls {
+		if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) && fake.Matches(d, call.Parameters.D) {
+			count++
 		}
 	}
 
-	if count_sym12 != 1 {
-		t.Errorf("FakeVariadic.MixedVariadic called %d times with expected parameters, expected one", count_sym12)
+	if count != 1 {
+		t.Errorf("FakeVariadic.MixedVariadic called %d times with expected parameters, expected one\n%s", count, f.describeMixedVariadicCalls(a, b, c, d))
+	}
+}
+
+// AssertMixedVariadicEventuallyCalledWith calls t.Error if FakeVariadic.MixedVariadic is not called with values matching the given matchers, any of which may be nil to match any value, within the timeout
+func (f *FakeVariadic) AssertMixedVariadicEventuallyCalledWith(t VariadicTestingT, timeout time.Duration, a VariadicMatcher[int], b VariadicMatcher[int], c VariadicMatcher[int], d VariadicMatcher[[]string]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var count int
+	err := f.waitFor(ctx, func() bool {
+		count = len(f.MixedVariadicCalls)
+		for _, call := range f.MixedVariadicCalls {
+			if fake.Matches(a, call.Parameters.A) && fake.Matches(b, call.Parameters.B) && fake.Matches(c, call.Parameters.C) && fake.Matches(d, call.Parameters.D) {
+				return true
+			}
+		}
+		return false
+	})
+
+	if err != nil {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		t.Errorf("FakeVariadic.MixedVariadic not called with expected parameters within %v, called %d times\n%s", timeout, count, f.describeMixedVariadicCalls(a, b, c, d))
 	}
 }
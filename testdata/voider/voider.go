@@ -2,16 +2,99 @@
 
 package main
 
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/percolate/charlatan/fake"
+)
+
 // VoiderVoidMethodInvocation represents a single call of FakeVoider.VoidMethod
 type VoiderVoidMethodInvocation struct {
+
+	// Sequence orders the call among the calls of all fakes in the program
+	Sequence int64
+}
+
+// String describes the call for failure messages
+func (i *VoiderVoidMethodInvocation) String() string {
+	return "FakeVoider.VoidMethod()"
+}
+
+// VoiderVoidMethodExpectation is a call of FakeVoider.VoidMethod expected by a test, created by FakeVoider.ExpectVoidMethod
+type VoiderVoidMethodExpectation struct {
+	fake  *FakeVoider
+	t     VoiderTestingT
+	times int
+	count int
+}
+
+// Times sets the number of expected calls, one by default
+func (e *VoiderVoidMethodExpectation) Times(n int) *VoiderVoidMethodExpectation {
+	e.fake.mutex.Lock()
+	defer e.fake.mutex.Unlock()
+	e.times = n
+	return e
 }
 
 // VoiderTestingT represents the methods of "testing".T used by charlatan Fakes.  It avoids importing the testing package.
-type VoiderTestingT interface {
-	Error(...interface{})
-	Errorf(string, ...interface{})
-	Fatal(...interface{})
-	Helper()
+type VoiderTestingT = fake.TestingT
+
+// VoiderMatcher matches a parameter of a call to FakeVoider
+type VoiderMatcher[T any] interface {
+	Match(T) bool
+}
+
+// VoiderMatcherFunc is a VoiderMatcher implemented by a predicate function
+type VoiderMatcherFunc[T any] func(T) bool
+
+// Match returns the result of calling the predicate with the given value
+func (m VoiderMatcherFunc[T]) Match(v T) bool {
+	return m(v)
+}
+
+// VoiderAny returns a VoiderMatcher that matches any value
+func VoiderAny[T any]() VoiderMatcher[T] {
+	return fake.Any[T]()
+}
+
+// VoiderEq returns a VoiderMatcher that matches values deeply equal to want
+func VoiderEq[T any](want T) VoiderMatcher[T] {
+	return fake.Eq(want)
+}
+
+// VoiderNot returns a VoiderMatcher that matches the values the given matcher does not
+func VoiderNot[T any](m VoiderMatcher[T]) VoiderMatcher[T] {
+	return fake.Not[T](m)
+}
+
+// VoiderPred returns a VoiderMatcher that matches values for which the given predicate returns true
+func VoiderPred[T any](pred func(T) bool) VoiderMatcher[T] {
+	return fake.Pred(pred)
+}
+
+// VoiderAnyOfType returns a VoiderMatcher that matches values whose dynamic type is, or implements, U
+func VoiderAnyOfType[T, U any]() VoiderMatcher[T] {
+	return fake.AnyOfType[T, U]()
+}
+
+// VoiderCallMatcher selects calls of a method of a fake for VoiderInOrder and VoiderUnordered
+// The matchers of fakes for other interfaces can be used alongside those of FakeVoider
+type VoiderCallMatcher = fake.CallMatcher
+
+// VoiderInOrder calls t.Error unless calls were made matching each of the given matchers in the order given
+// Other calls may have been made before, between and after them
+func VoiderInOrder(t VoiderTestingT, calls ...VoiderCallMatcher) {
+	t.Helper()
+	fake.InOrder(t, calls...)
+}
+
+// VoiderUnordered calls t.Error unless a distinct call was made matching each of the given matchers, in any order
+// Other calls may also have been made
+func VoiderUnordered(t VoiderTestingT, calls ...VoiderCallMatcher) {
+	t.Helper()
+	fake.Unordered(t, calls...)
 }
 
 /*
@@ -30,18 +113,26 @@ Use it in your tests as in this example:
 
 		// test code goes here ...
 
-		// assert state of FakeVoidMethod ...
+		// assert state of FakeVoider ...
 		f.AssertVoidMethodCalledOnce(t)
 	}
 
 Create anonymous function implementations for only those interface methods that
 should be called in the code under test.  This will force a panic if any
-unexpected calls are made to FakeVoidMethod.
+unexpected calls are made to FakeVoider.
+
+The fake may be called from multiple goroutines.  Once it is in use, configure
+hooks with the Set methods and inspect calls with the Snapshot methods rather
+than accessing its fields directly.
 */
 type FakeVoider struct {
 	VoidMethodHook func()
 
 	VoidMethodCalls []*VoiderVoidMethodInvocation
+
+	expectationsVoidMethod []*VoiderVoidMethodExpectation
+	mutex                  sync.Mutex
+	recorded               chan struct{} // closed when the next call is recorded, if waited for
 }
 
 // NewFakeVoiderDefaultPanic returns an instance of FakeVoider with all hooks configured to panic
@@ -54,50 +145,196 @@ func NewFakeVoiderDefaultPanic() *FakeVoider {
 }
 
 // NewFakeVoiderDefaultFatal returns an instance of FakeVoider with all hooks configured to call t.Fatal
-func NewFakeVoiderDefaultFatal(t_sym1 VoiderTestingT) *FakeVoider {
+func NewFakeVoiderDefaultFatal(t VoiderTestingT) *FakeVoider {
 	return &FakeVoider{
 		VoidMethodHook: func() {
-			t_sym1.Fatal("Unexpected call to Voider.VoidMethod")
+			t.Fatal("Unexpected call to Voider.VoidMethod")
 			return
 		},
 	}
 }
 
 // NewFakeVoiderDefaultError returns an instance of FakeVoider with all hooks configured to call t.Error
-func NewFakeVoiderDefaultError(t_sym2 VoiderTestingT) *FakeVoider {
+func NewFakeVoiderDefaultError(t VoiderTestingT) *FakeVoider {
+	return &FakeVoider{
+		VoidMethodHook: func() {
+			t.Error("Unexpected call to Voider.VoidMethod")
+			return
+		},
+	}
+}
+
+// NewFakeVoiderDefaultZero returns an instance of FakeVoider with all hooks configured to return zero values
+func NewFakeVoiderDefaultZero() *FakeVoider {
+	return &FakeVoider{
+		VoidMethodHook: func() {
+			return
+		},
+	}
+}
+
+// NewFakeVoiderDefaultFriendly returns an instance of FakeVoider with all hooks configured to return zero values, except for empty slices and maps
+// Results of interfaces whose fakes were generated alongside FakeVoider are friendly fakes, one for each method and result, created on first use
+func NewFakeVoiderDefaultFriendly() *FakeVoider {
+
 	return &FakeVoider{
 		VoidMethodHook: func() {
-			t_sym2.Error("Unexpected call to Voider.VoidMethod")
 			return
 		},
 	}
 }
 
+// NewFakeVoiderSpy returns an instance of FakeVoider with all hooks configured to call the given implementation
+func NewFakeVoiderSpy(real Voider) *FakeVoider {
+	return &FakeVoider{
+		VoidMethodHook: real.VoidMethod,
+	}
+}
+
+// Reset forgets all calls made to FakeVoider
 func (f *FakeVoider) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.VoidMethodCalls = []*VoiderVoidMethodInvocation{}
 }
 
-func (f_sym3 *FakeVoider) VoidMethod() {
-	if f_sym3.VoidMethodHook == nil {
+// waitFor blocks until done returns true, checking it with the mutex held each time a call is recorded, or until ctx is done
+func (f *FakeVoider) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		f.mutex.Lock()
+		if done() {
+			f.mutex.Unlock()
+			return nil
+		}
+		if f.recorded == nil {
+			f.recorded = make(chan struct{})
+		}
+		recorded := f.recorded
+		f.mutex.Unlock()
+
+		select {
+		case <-recorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *FakeVoider) VoidMethod() {
+	f.mutex.Lock()
+	hook := f.VoidMethodHook
+	expectation, t := f.expectedVoidMethod()
+	if hook == nil && t == nil {
+		f.mutex.Unlock()
 		panic("Voider.VoidMethod() called but FakeVoider.VoidMethodHook is nil")
 	}
 
-	invocation_sym3 := new(VoiderVoidMethodInvocation)
-	f_sym3.VoidMethodCalls = append(f_sym3.VoidMethodCalls, invocation_sym3)
+	invocation := new(VoiderVoidMethodInvocation)
+	invocation.Sequence = fake.NextSequence()
+	f.VoidMethodCalls = append(f.VoidMethodCalls, invocation)
+
+	if f.recorded != nil {
+		close(f.recorded)
+		f.recorded = nil
+	}
+	f.mutex.Unlock()
+
+	if t != nil && expectation == nil {
+		t.Error("FakeVoider.VoidMethod called more times than expected")
+	}
 
-	f_sym3.VoidMethodHook()
+	if hook != nil {
+		hook()
+	}
 
 	return
 }
 
+// expectedVoidMethod returns the first unsatisfied expectation of FakeVoider.VoidMethod matching the given parameters, counting the call against it, and the test to report to if the method has expectations
+func (f *FakeVoider) expectedVoidMethod() (*VoiderVoidMethodExpectation, VoiderTestingT) {
+	if len(f.expectationsVoidMethod) == 0 {
+		return nil, nil
+	}
+	for _, expectation := range f.expectationsVoidMethod {
+		if expectation.count < expectation.times {
+			expectation.count++
+			return expectation, expectation.t
+		}
+	}
+
+	return nil, f.expectationsVoidMethod[0].t
+}
+
+// ExpectVoidMethod expects calls of FakeVoider.VoidMethod, and reports through t when the test ends if they were not all made
+// Once a method has expectations, calls matching none of them are also reported through t
+func (f *FakeVoider) ExpectVoidMethod(t VoiderTestingT) *VoiderVoidMethodExpectation {
+	t.Helper()
+	expectation := &VoiderVoidMethodExpectation{fake: f, t: t, times: 1}
+	f.mutex.Lock()
+	f.expectationsVoidMethod = append(f.expectationsVoidMethod, expectation)
+	f.mutex.Unlock()
+
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if expectation.count != expectation.times {
+			t.Errorf("FakeVoider.VoidMethod called %d times matching an expectation, expected %d", expectation.count, expectation.times)
+		}
+	})
+
+	return expectation
+}
+
+// SetVoidMethodHook configures Voider.VoidMethod to call the given function
+func (f *FakeVoider) SetVoidMethodHook(hook func()) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.VoidMethodHook = hook
+}
+
+// VoidMethodCallsSnapshot returns a copy of the calls made to FakeVoider.VoidMethod
+func (f *FakeVoider) VoidMethodCallsSnapshot() []*VoiderVoidMethodInvocation {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	calls := make([]*VoiderVoidMethodInvocation, len(f.VoidMethodCalls))
+	for i, call := range f.VoidMethodCalls {
+		invocation := *call
+		calls[i] = &invocation
+	}
+
+	return calls
+}
+
+// VoidMethodCall returns a VoiderCallMatcher selecting the calls of FakeVoider.VoidMethod
+func (f *FakeVoider) VoidMethodCall() VoiderCallMatcher {
+	return fake.NewCallMatcher("FakeVoider.VoidMethod()", func() (map[int64]string, []int64) {
+		f.mutex.Lock()
+		snapshot := append([]*VoiderVoidMethodInvocation(nil), f.VoidMethodCalls...)
+		f.mutex.Unlock()
+
+		calls := make(map[int64]string, len(snapshot))
+		var matching []int64
+		for _, call := range snapshot {
+			calls[call.Sequence] = call.String()
+			matching = append(matching, call.Sequence)
+		}
+
+		return calls, matching
+	})
+}
+
 // VoidMethodCalled returns true if FakeVoider.VoidMethod was called
 func (f *FakeVoider) VoidMethodCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.VoidMethodCalls) != 0
 }
 
 // AssertVoidMethodCalled calls t.Error if FakeVoider.VoidMethod was not called
 func (f *FakeVoider) AssertVoidMethodCalled(t VoiderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.VoidMethodCalls) == 0 {
 		t.Error("FakeVoider.VoidMethod not called, expected at least one")
 	}
@@ -105,12 +342,16 @@ func (f *FakeVoider) AssertVoidMethodCalled(t VoiderTestingT) {
 
 // VoidMethodNotCalled returns true if FakeVoider.VoidMethod was not called
 func (f *FakeVoider) VoidMethodNotCalled() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.VoidMethodCalls) == 0
 }
 
 // AssertVoidMethodNotCalled calls t.Error if FakeVoider.VoidMethod was called
 func (f *FakeVoider) AssertVoidMethodNotCalled(t VoiderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.VoidMethodCalls) != 0 {
 		t.Error("FakeVoider.VoidMethod called, expected none")
 	}
@@ -118,12 +359,16 @@ func (f *FakeVoider) AssertVoidMethodNotCalled(t VoiderTestingT) {
 
 // VoidMethodCalledOnce returns true if FakeVoider.VoidMethod was called exactly once
 func (f *FakeVoider) VoidMethodCalledOnce() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.VoidMethodCalls) == 1
 }
 
 // AssertVoidMethodCalledOnce calls t.Error if FakeVoider.VoidMethod was not called exactly once
 func (f *FakeVoider) AssertVoidMethodCalledOnce(t VoiderTestingT) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.VoidMethodCalls) != 1 {
 		t.Errorf("FakeVoider.VoidMethod called %d times, expected 1", len(f.VoidMethodCalls))
 	}
@@ -131,13 +376,39 @@ func (f *FakeVoider) AssertVoidMethodCalledOnce(t VoiderTestingT) {
 
 // VoidMethodCalledN returns true if FakeVoider.VoidMethod was called at least n times
 func (f *FakeVoider) VoidMethodCalledN(n int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	return len(f.VoidMethodCalls) >= n
 }
 
 // AssertVoidMethodCalledN calls t.Error if FakeVoider.VoidMethod was called less than n times
 func (f *FakeVoider) AssertVoidMethodCalledN(t VoiderTestingT, n int) {
 	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.VoidMethodCalls) < n {
 		t.Errorf("FakeVoider.VoidMethod called %d times, expected >= %d", len(f.VoidMethodCalls), n)
 	}
 }
+
+// WaitForVoidMethodCalled blocks until FakeVoider.VoidMethod has been called, returning the error of ctx if it is done first
+func (f *FakeVoider) WaitForVoidMethodCalled(ctx context.Context) error {
+	return f.WaitForVoidMethodCalledN(ctx, 1)
+}
+
+// WaitForVoidMethodCalledN blocks until FakeVoider.VoidMethod has been called at least n times, returning the error of ctx if it is done first
+func (f *FakeVoider) WaitForVoidMethodCalledN(ctx context.Context, n int) error {
+	return f.waitFor(ctx, func() bool {
+		return len(f.VoidMethodCalls) >= n
+	})
+}
+
+// AssertVoidMethodEventuallyCalled calls t.Error if FakeVoider.VoidMethod is not called within the timeout
+func (f *FakeVoider) AssertVoidMethodEventuallyCalled(t VoiderTestingT, timeout time.Duration) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if f.WaitForVoidMethodCalled(ctx) != nil {
+		t.Errorf("FakeVoider.VoidMethod not called within %v", timeout)
+	}
+}
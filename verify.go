@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/percolate/charlatan/generator"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// runGenerated implements the verify and regen commands, which repeat the
+// generations recorded in the headers of the generated files found in the
+// given paths.  verify reports the files that are out of date, with a diff,
+// and regen rewrites them.  It returns the exit status of the command.
+func runGenerated(command string, paths []string) int {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files, err := findGenerated(paths)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	status := 0
+	for _, file := range files {
+		current, err := ioutil.ReadFile(file)
+		if err != nil {
+			log.Print(err)
+			status = 1
+			continue
+		}
+
+		src, err := regenerate(file, current)
+		if err != nil {
+			log.Printf("%s: %s", file, err)
+			status = 1
+			continue
+		}
+		if bytes.Equal(current, src) {
+			continue
+		}
+
+		if command == "regen" {
+			if err := ioutil.WriteFile(file, src, 0644); err != nil {
+				log.Printf("error writing output: %s", err)
+				status = 1
+				continue
+			}
+			log.Printf("wrote %s\n", file)
+			continue
+		}
+
+		diff, err := unifiedDiff(file, current, src)
+		if err != nil {
+			log.Printf("%s: %s", file, err)
+		}
+		fmt.Print(diff)
+		status = 1
+	}
+
+	return status
+}
+
+// unifiedDiff returns the differences between the current and regenerated
+// contents of a file in unified format
+func unifiedDiff(name string, current, regenerated []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(regenerated)),
+		FromFile: name,
+		ToFile:   name + " (regenerated)",
+		Context:  3,
+	})
+}
+
+// regenerate repeats the generation recorded in the header of the given
+// generated file.  Relative paths on the recorded command line are resolved
+// against the directory it was run in, which is found from the -output path
+// or, when that path leaves the directory, recorded in the header too.
+func regenerate(file string, current []byte) ([]byte, error) {
+	line := current
+	if i := bytes.IndexByte(current, '\n'); i >= 0 {
+		line = current[:i]
+	}
+	match := generator.GeneratedHeader.FindSubmatch(line)
+	if match == nil {
+		return nil, fmt.Errorf("error: not a generated file")
+	}
+
+	args, err := splitCommandLine(string(match[1]))
+	if err != nil {
+		return nil, fmt.Errorf("error: invalid command line %q: %s", match[1], err)
+	}
+
+	opts := new(options)
+	flags := newFlagSet(opts, flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	if err := parseOptions(flags, opts, args[1:]); err != nil {
+		return nil, fmt.Errorf("error: invalid command line %q: %s", match[1], err)
+	}
+
+	dir := string(match[2])
+	if strings.HasPrefix(dir, `"`) {
+		if dir, err = strconv.Unquote(dir); err != nil {
+			return nil, fmt.Errorf("error: invalid directory %s: %s", match[2], err)
+		}
+	}
+	base, err := baseDirectory(file, opts.outputPath, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	_, src, err := generate(opts, base, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
+
+// baseDirectory returns the directory in which the command that wrote the
+// given file was run, given its -output path and the directory recorded
+// relative to the file's directory, if any
+func baseDirectory(file, output, dir string) (string, error) {
+	if output == "" {
+		output = "charlatan.go"
+	}
+
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+	if dir != "" && !filepath.IsAbs(output) {
+		base := filepath.Join(filepath.Dir(abs), filepath.FromSlash(dir))
+		if filepath.Join(base, output) != abs {
+			return "", fmt.Errorf("error: recorded output %s is not this file", output)
+		}
+		return base, nil
+	}
+	if filepath.IsAbs(output) {
+		if filepath.Clean(output) != abs {
+			return "", fmt.Errorf("error: recorded output %s is not this file", output)
+		}
+		return filepath.Dir(abs), nil
+	}
+
+	suffix := string(filepath.Separator) + filepath.Clean(output)
+	if !strings.HasSuffix(abs, suffix) {
+		return "", fmt.Errorf("error: recorded output %s is not this file", output)
+	}
+
+	return strings.TrimSuffix(abs, suffix), nil
+}
+
+// findGenerated returns the generated Go files found in the given paths.
+// A path ending in "/..." includes its subdirectories, except for those
+// the go command ignores: testdata, vendor and those whose names begin
+// with "." or "_".
+func findGenerated(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		recursive := false
+		if path == "..." || strings.HasSuffix(path, "/...") {
+			recursive = true
+			path = strings.TrimSuffix(strings.TrimSuffix(path, "..."), "/")
+			if path == "" {
+				path = "."
+			}
+		}
+
+		err := filepath.Walk(path, func(name string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if name == path {
+					return nil
+				}
+				base := info.Name()
+				if !recursive || base == "testdata" || base == "vendor" || strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(name, ".go") {
+				return nil
+			}
+
+			generated, err := isGenerated(name)
+			if generated {
+				files = append(files, name)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// isGenerated returns true if the first line of the given file is a charlatan header
+func isGenerated(name string) (bool, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return false, scanner.Err()
+	}
+
+	return generator.GeneratedHeader.Match(scanner.Bytes()), nil
+}
+
+// splitCommandLine splits a recorded command line into its arguments,
+// which are separated by spaces and may be quoted as Go strings
+func splitCommandLine(line string) ([]string, error) {
+	var args []string
+	for {
+		line = strings.TrimLeft(line, " ")
+		if line == "" {
+			return args, nil
+		}
+
+		end := strings.IndexByte(line, ' ')
+		if end < 0 {
+			end = len(line)
+		}
+		quote := strings.IndexByte(line[:end], '"')
+		if quote < 0 {
+			args = append(args, line[:end])
+			line = line[end:]
+			continue
+		}
+
+		prefix := line[:quote]
+		quoted, err := strconv.QuotedPrefix(line[quote:])
+		if err != nil {
+			return nil, err
+		}
+		arg, err := strconv.Unquote(quoted)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, prefix+arg)
+		line = line[quote+len(quoted):]
+	}
+}
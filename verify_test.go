@@ -0,0 +1,135 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/percolate/charlatan/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitCommandLine(t *testing.T) {
+	args, err := splitCommandLine(`charlatan -dir=testdata/x -include="^A b$" Store`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"charlatan", "-dir=testdata/x", "-include=^A b$", "Store"}, args)
+
+	opts := new(options)
+	flags := newFlagSet(opts, 0)
+	err = parseOptions(flags, opts, []string{"-all", "-include", `^A b\"$`})
+	assert.Nil(t, err)
+	args, err = splitCommandLine(opts.commandLine)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"charlatan", "-all=true", `-include=^A b\"$`}, args)
+
+	_, err = splitCommandLine(`charlatan -include="unterminated`)
+	assert.NotNil(t, err)
+}
+
+func TestGeneratedHeader(t *testing.T) {
+	match := generator.GeneratedHeader.FindStringSubmatch(`// generated by "charlatan -include="^A b$" Store" in "../a b".  DO NOT EDIT.`)
+	assert.Equal(t, []string{`charlatan -include="^A b$" Store`, `"../a b"`}, match[1:])
+
+	match = generator.GeneratedHeader.FindStringSubmatch(`// generated by "charlatan Store".  DO NOT EDIT.`)
+	assert.Equal(t, []string{"charlatan Store", ""}, match[1:])
+}
+
+func TestBaseDirectory(t *testing.T) {
+	base, err := baseDirectory("/src/pkg/fakes/fake.go", "fakes/fake.go", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "/src/pkg", base)
+
+	base, err = baseDirectory("/src/pkg/charlatan.go", "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "/src/pkg", base)
+
+	_, err = baseDirectory("/src/pkg/fake.go", "other.go", "")
+	assert.NotNil(t, err)
+
+	base, err = baseDirectory("/src/fakes/store.go", "../../fakes/store.go", "../pkg/store")
+	assert.Nil(t, err)
+	assert.Equal(t, "/src/pkg/store", base)
+
+	_, err = baseDirectory("/src/fakes/store.go", "../fakes/store.go", "../pkg/store")
+	assert.NotNil(t, err)
+}
+
+func TestVerifyAndRegen(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "charlatan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempdir)
+
+	write := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(tempdir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", "module verify\n")
+	write("store.go", "package verify\n\ntype Store interface {\n\tGet(key string) (string, error)\n}\n")
+
+	opts := new(options)
+	if err := parseOptions(newFlagSet(opts, 0), opts, []string{"-output=fakes_test.go", "Store"}); err != nil {
+		t.Fatal(err)
+	}
+	output, src, err := generate(opts, tempdir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, filepath.Join(tempdir, "fakes_test.go"), output)
+	assert.True(t, strings.HasPrefix(string(src), `// generated by "charlatan -output=fakes_test.go Store".`))
+	write("fakes_test.go", string(src))
+
+	assert.Equal(t, 0, runGenerated("verify", []string{tempdir + "/..."}))
+
+	write("store.go", "package verify\n\ntype Store interface {\n\tGet(key string) (string, error)\n\tPut(key, value string) error\n}\n")
+	assert.Equal(t, 1, runGenerated("verify", []string{tempdir}))
+	stale, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	regenerated, err := regenerate(output, stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := unifiedDiff(output, stale, regenerated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, diff, "+++ "+output+" (regenerated)")
+	assert.Contains(t, diff, "+// StorePutInvocation represents a single call of FakeStore.Put")
+
+	assert.Equal(t, 0, runGenerated("regen", []string{tempdir}))
+	assert.Equal(t, 0, runGenerated("verify", []string{tempdir}))
+
+	// N.B. - the directory the command was run in cannot be found from an
+	// output path leaving it
+	if err := os.MkdirAll(filepath.Join(tempdir, "src", "store"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	write("src/store/store.go", "package store\n\ntype Store interface {\n\tGet(key string) (string, error)\n}\n")
+	opts = new(options)
+	if err := parseOptions(newFlagSet(opts, 0), opts, []string{"-output=../../fakes/store.go", "-package=fakes", "Store"}); err != nil {
+		t.Fatal(err)
+	}
+	output, src, err = generate(opts, filepath.Join(tempdir, "src", "store"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, filepath.Join(tempdir, "fakes", "store.go"), output)
+	assert.True(t, strings.HasPrefix(string(src), `// generated by "charlatan -output=../../fakes/store.go -package=fakes Store" in ../src/store.  DO NOT EDIT.`))
+	if err := os.MkdirAll(filepath.Join(tempdir, "fakes"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	write("fakes/store.go", string(src))
+
+	assert.Equal(t, 0, runGenerated("verify", []string{tempdir + "/..."}))
+	write("src/store/store.go", "package store\n\ntype Store interface {\n\tGet(key string) (string, error)\n\tPut(key, value string) error\n}\n")
+	assert.Equal(t, 1, runGenerated("verify", []string{tempdir + "/..."}))
+	assert.Equal(t, 0, runGenerated("regen", []string{tempdir + "/..."}))
+	assert.Equal(t, 0, runGenerated("verify", []string{tempdir + "/..."}))
+}